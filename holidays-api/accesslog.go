@@ -0,0 +1,49 @@
+package holidaysapi
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/requestid"
+)
+
+// AccessLog returns a handler that logs the requests to h.
+// The request ID set by requestid.Handler is included in the logs.
+func AccessLog(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		lw := &logWriter{ResponseWriter: w}
+		h.ServeHTTP(lw, r)
+		if lw.status == 0 {
+			lw.status = http.StatusOK
+		}
+
+		id, _ := requestid.FromContext(r.Context())
+		log.Printf("%s %s %s %d %d %s request_id=%s",
+			r.RemoteAddr, r.Method, r.URL.RequestURI(), lw.status, lw.size, time.Since(start), id)
+	})
+}
+
+// logWriter records the status code and the size of the response.
+type logWriter struct {
+	http.ResponseWriter
+	status int
+	size   int
+}
+
+func (w *logWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *logWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.size += n
+	return n, err
+}
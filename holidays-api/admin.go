@@ -0,0 +1,22 @@
+package holidaysapi
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+)
+
+// AdminAuth returns a handler that passes only the requests with the bearer token to h.
+// It is for the endpoints for the operators, such as APIKeys.QuotaHandler.
+// If token is empty, all requests are rejected.
+func AdminAuth(token string, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token == "" || !ok || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="holidays-jp admin"`)
+			responseError(w, http.StatusUnauthorized, ErrorCodeUnauthorized, "a valid bearer token is required")
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,276 @@
+package holidaysapi
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// APIKey is a key to access the API.
+type APIKey struct {
+	// Name identifies the consumer, e.g. in the logs.
+	Name string
+
+	// Key is the secret sent by the consumer.
+	Key string
+
+	// RateLimit is the max number of requests per minute. Zero means unlimited.
+	RateLimit int
+
+	// DailyQuota is the max number of requests per day in JST. Zero means unlimited.
+	DailyQuota int
+}
+
+// APIKeys authenticates the requests by API keys, and limits the rate of the requests per key.
+// The key is sent in the X-API-Key header or the api_key query parameter.
+// APIKeys is safe for concurrent use by multiple goroutines.
+type APIKeys struct {
+	// Quotas stores the usage for the daily quotas.
+	// NewAPIKeys sets a MemoryQuotaStore; replace it with a persistent store before use to keep the usage across restarts.
+	Quotas QuotaStore
+
+	// Clock provides the current time for the rate limits and the daily quotas.
+	// If nil, holiday.SystemClock is used.
+	Clock holiday.Clock
+
+	keys map[string]*apiKeyState
+}
+
+func (ks *APIKeys) now() time.Time {
+	if ks.Clock == nil {
+		return holiday.SystemClock.Now()
+	}
+	return ks.Clock.Now()
+}
+
+// apiKeyState is the token bucket of a key.
+type apiKeyState struct {
+	APIKey
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewAPIKeys returns a new APIKeys that accepts the keys.
+func NewAPIKeys(keys ...APIKey) *APIKeys {
+	ks := &APIKeys{
+		Quotas: NewMemoryQuotaStore(),
+		keys:   make(map[string]*apiKeyState, len(keys)),
+	}
+	for _, k := range keys {
+		ks.keys[k.Key] = &apiKeyState{
+			APIKey: k,
+			tokens: float64(k.RateLimit),
+		}
+	}
+	return ks
+}
+
+// LoadAPIKeys loads the API keys from the file at path.
+//
+// The file is a UTF-8 CSV without a header. Each line is "name,key", "name,key,rate limit"
+// or "name,key,rate limit,daily quota":
+//
+//	# name,key,requests per minute,requests per day
+//	example-app,0123456789abcdef,60,10000
+//	internal-batch,fedcba9876543210
+//
+// Zero limits mean unlimited. Lines beginning with # are comments.
+func LoadAPIKeys(path string) (*APIKeys, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	keys, err := parseAPIKeys(f)
+	if err != nil {
+		return nil, fmt.Errorf("holidaysapi: failed to parse %s: %w", path, err)
+	}
+	return NewAPIKeys(keys...), nil
+}
+
+func parseAPIKeys(r io.Reader) ([]APIKey, error) {
+	csvReader := csv.NewReader(r)
+	csvReader.Comment = '#'
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+
+	var keys []APIKey
+	seen := map[string]bool{}
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) < 2 || len(record) > 4 {
+			return nil, fmt.Errorf("unexpected record: %q", record)
+		}
+		key := APIKey{
+			Name: strings.TrimSpace(record[0]),
+			Key:  strings.TrimSpace(record[1]),
+		}
+		if key.Key == "" {
+			return nil, fmt.Errorf("empty key: %q", record)
+		}
+		if seen[key.Key] {
+			return nil, fmt.Errorf("duplicated key: %s", key.Name)
+		}
+		seen[key.Key] = true
+		if len(record) >= 3 {
+			limit, err := strconv.Atoi(strings.TrimSpace(record[2]))
+			if err != nil || limit < 0 {
+				return nil, fmt.Errorf("invalid rate limit: %q", record[2])
+			}
+			key.RateLimit = limit
+		}
+		if len(record) == 4 {
+			quota, err := strconv.Atoi(strings.TrimSpace(record[3]))
+			if err != nil || quota < 0 {
+				return nil, fmt.Errorf("invalid daily quota: %q", record[3])
+			}
+			key.DailyQuota = quota
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Handler returns a handler that passes only the requests with valid API keys to h.
+// It responds 401 Unauthorized for missing or unknown keys,
+// and 429 Too Many Requests for the keys that exceed their rate limits or daily quotas.
+func (ks *APIKeys) Handler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("X-API-Key")
+		if key == "" {
+			key = r.URL.Query().Get("api_key")
+		}
+		state, ok := ks.keys[key]
+		if !ok {
+			w.Header().Set("WWW-Authenticate", `APIKey realm="holidays-jp"`)
+			responseError(w, http.StatusUnauthorized, ErrorCodeUnauthorized, "a valid API key is required in the X-API-Key header or the api_key parameter")
+			return
+		}
+
+		if state.RateLimit > 0 {
+			remaining, wait := state.take(ks.now())
+			w.Header().Set("X-RateLimit-Limit", strconv.Itoa(state.RateLimit))
+			w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+			if wait > 0 {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+				responseError(w, http.StatusTooManyRequests, ErrorCodeRateLimited, fmt.Sprintf("the rate limit of %d requests per minute is exceeded", state.RateLimit))
+				return
+			}
+		}
+
+		if state.DailyQuota > 0 {
+			now := ks.now().In(holiday.JST)
+			today := holiday.Date{Year: now.Year(), Month: now.Month(), Day: now.Day()}
+			used, err := ks.Quotas.Increment(r.Context(), state.Name, today)
+			if err != nil {
+				log.Printf("failed to increment the quota usage of %s: %v", state.Name, err)
+				responseError(w, http.StatusInternalServerError, ErrorCodeInternal, "internal server error")
+				return
+			}
+			reset := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, holiday.JST)
+			w.Header().Set("X-Quota-Limit", strconv.Itoa(state.DailyQuota))
+			w.Header().Set("X-Quota-Remaining", strconv.Itoa(max(state.DailyQuota-used, 0)))
+			w.Header().Set("X-Quota-Reset", reset.Format(time.RFC3339))
+			if used > state.DailyQuota {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(reset.Sub(now).Seconds()))))
+				responseError(w, http.StatusTooManyRequests, ErrorCodeQuotaExceeded, fmt.Sprintf("the daily quota of %d requests is exceeded", state.DailyQuota))
+				return
+			}
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// take takes a token from the bucket.
+// It returns the remaining tokens, or how long to wait for the next token if the bucket is empty.
+func (s *apiKeyState) take(now time.Time) (remaining int, wait time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	limit := float64(s.RateLimit)
+	if !s.last.IsZero() {
+		s.tokens = math.Min(limit, s.tokens+now.Sub(s.last).Minutes()*limit)
+	}
+	s.last = now
+
+	if s.tokens < 1 {
+		return 0, time.Duration((1 - s.tokens) / limit * float64(time.Minute))
+	}
+	s.tokens--
+	return int(s.tokens), 0
+}
+
+// QuotaUsage is the usage of the daily quota of an API key.
+type QuotaUsage struct {
+	Name  string `json:"name"`
+	Used  int    `json:"used"`
+	Quota int    `json:"quota"`
+}
+
+// QuotaResponse is the response of APIKeys.QuotaHandler.
+type QuotaResponse struct {
+	Date  string       `json:"date"`
+	Usage []QuotaUsage `json:"usage"`
+}
+
+// QuotaHandler returns a handler that reports today's usage of the daily quotas of all keys, sorted by name.
+// It is for the operators; protect it with AdminAuth.
+func (ks *APIKeys) QuotaHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		now := ks.now().In(holiday.JST)
+		today := holiday.Date{Year: now.Year(), Month: now.Month(), Day: now.Day()}
+		usage, err := ks.Quotas.Usage(r.Context(), today)
+		if err != nil {
+			log.Printf("failed to get the quota usage: %v", err)
+			responseError(w, http.StatusInternalServerError, ErrorCodeInternal, "internal server error")
+			return
+		}
+
+		res := QuotaResponse{
+			Date:  today.String(),
+			Usage: make([]QuotaUsage, 0, len(ks.keys)),
+		}
+		for _, state := range ks.keys {
+			res.Usage = append(res.Usage, QuotaUsage{
+				Name:  state.Name,
+				Used:  usage[state.Name],
+				Quota: state.DailyQuota,
+			})
+		}
+		sort.Slice(res.Usage, func(i, j int) bool {
+			return res.Usage[i].Name < res.Usage[j].Name
+		})
+		data, err := json.Marshal(res)
+		if err != nil {
+			responseError(w, http.StatusInternalServerError, ErrorCodeInternal, "internal server error")
+			return
+		}
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(http.StatusOK)
+		w.Write(data)
+	})
+}
@@ -0,0 +1,101 @@
+package holidaysapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestParseAPIKeys(t *testing.T) {
+	got, err := parseAPIKeys(strings.NewReader(`# name,key,requests per minute
+example-app, 0123456789abcdef, 60
+internal-batch,fedcba9876543210
+daily,abcdef,0,10000
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []APIKey{
+		{Name: "example-app", Key: "0123456789abcdef", RateLimit: 60},
+		{Name: "internal-batch", Key: "fedcba9876543210"},
+		{Name: "daily", Key: "abcdef", DailyQuota: 10000},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("keys mismatch (-want/+got):\n%s", diff)
+	}
+
+	for _, input := range []string{
+		"example-app\n",
+		"example-app,\n",
+		"example-app,key,-1\n",
+		"example-app,key,x\n",
+		"example-app,key,0,-1\n",
+		"example-app,key,0,0,0\n",
+		"a,key\nb,key\n",
+	} {
+		if _, err := parseAPIKeys(strings.NewReader(input)); err == nil {
+			t.Errorf("%q: want error, got nil", input)
+		}
+	}
+}
+
+func TestAPIKeys(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ks := NewAPIKeys(
+		APIKey{Name: "limited", Key: "limited-key", RateLimit: 2},
+		APIKey{Name: "unlimited", Key: "unlimited-key"},
+	)
+	ks.Clock = holiday.ClockFunc(func() time.Time { return now })
+	h := ks.Handler(NewHandler())
+
+	get := func(header, query string) *http.Response {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/2021/01/01"+query, nil)
+		if header != "" {
+			req.Header.Set("X-API-Key", header)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w.Result()
+	}
+
+	if resp := get("", ""); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("no key: want %d, got %d", http.StatusUnauthorized, resp.StatusCode)
+	}
+	if resp := get("unknown", ""); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("unknown key: want %d, got %d", http.StatusUnauthorized, resp.StatusCode)
+	}
+	for i := 0; i < 5; i++ {
+		if resp := get("", "?api_key=unlimited-key"); resp.StatusCode != http.StatusOK {
+			t.Errorf("unlimited key: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+	}
+
+	// the bucket has 2 tokens.
+	for i, remaining := range []string{"1", "0"} {
+		resp := get("limited-key", "")
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("#%d: want %d, got %d", i, http.StatusOK, resp.StatusCode)
+		}
+		if got := resp.Header.Get("X-RateLimit-Remaining"); got != remaining {
+			t.Errorf("#%d: want remaining %s, got %s", i, remaining, got)
+		}
+	}
+	resp := get("limited-key", "")
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("want %d, got %d", http.StatusTooManyRequests, resp.StatusCode)
+	}
+	if got := resp.Header.Get("Retry-After"); got != "30" {
+		t.Errorf("want Retry-After 30, got %s", got)
+	}
+
+	// a token is added every 30 seconds.
+	now = now.Add(30 * time.Second)
+	if resp := get("limited-key", ""); resp.StatusCode != http.StatusOK {
+		t.Errorf("want %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+}
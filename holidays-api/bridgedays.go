@@ -0,0 +1,63 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// BridgeDaysResponse is the response of the bridge-days endpoint.
+type BridgeDaysResponse struct {
+	BridgeDays []BridgeDay `json:"bridge_days"`
+}
+
+// BridgeDay is a single business day between rest days (飛び石連休).
+// Taking leave on it makes a rest block from RestFrom to RestTo.
+type BridgeDay struct {
+	Date     string `json:"date"`
+	Weekday  string `json:"weekday"`
+	RestFrom string `json:"rest_from"`
+	RestTo   string `json:"rest_to"`
+
+	// Days is the length of the rest block made by taking leave, e.g. 4 for 4連休.
+	Days int `json:"days"`
+}
+
+func (h *Handler) bridgeDays(w http.ResponseWriter, year int) {
+	days := holiday.FindBridgeDaysInRange(
+		holiday.Date{Year: year, Month: time.January, Day: 1},
+		holiday.Date{Year: year, Month: time.December, Day: 31},
+	)
+	res := BridgeDaysResponse{
+		BridgeDays: make([]BridgeDay, 0, len(days)),
+	}
+	for _, d := range days {
+		res.BridgeDays = append(res.BridgeDays, BridgeDay{
+			Date:     d.Date.Format("2006-01-02"),
+			Weekday:  strings.ToLower(d.Date.Weekday().String()),
+			RestFrom: d.Block.Start.Format("2006-01-02"),
+			RestTo:   d.Block.End.Format("2006-01-02"),
+			Days:     d.Block.Days,
+		})
+	}
+	data, err := json.Marshal(res)
+	if err != nil {
+		h.responseInternalServerError(w, err)
+		return
+	}
+
+	now := h.now()
+	maxAge := 24 * time.Hour
+	if year < now.Year() {
+		maxAge = 365 * 24 * time.Hour
+	}
+	setCommonHeaders(w, maxAge)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,35 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestServeHTTP_BridgeDays(t *testing.T) {
+	h := NewHandler()
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/bridge-days/2025", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	resp := w.Result()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	var got BridgeDaysResponse
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	want := []BridgeDay{
+		{Date: "2025-02-10", Weekday: "monday", RestFrom: "2025-02-08", RestTo: "2025-02-11", Days: 4},
+		{Date: "2025-03-21", Weekday: "friday", RestFrom: "2025-03-20", RestTo: "2025-03-23", Days: 4},
+		{Date: "2025-04-28", Weekday: "monday", RestFrom: "2025-04-26", RestTo: "2025-04-29", Days: 4},
+		{Date: "2025-09-22", Weekday: "monday", RestFrom: "2025-09-20", RestTo: "2025-09-23", Days: 4},
+	}
+	if diff := cmp.Diff(want, got.BridgeDays); diff != "" {
+		t.Errorf("bridge days mismatch (-want/+got):\n%s", diff)
+	}
+}
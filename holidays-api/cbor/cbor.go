@@ -0,0 +1,239 @@
+// Package cbor encodes and decodes holidays in CBOR (RFC 8949).
+//
+// Each holiday is a map with the keys "date", "name", and "kind".
+// A stream is a CBOR sequence (RFC 8742) of the maps, so that the holidays can be written and read one by one.
+// Marshal and Unmarshal encode and decode a whole list of holidays as an array of the maps instead.
+package cbor
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// major types.
+const (
+	majorUint   = 0
+	majorNegInt = 1
+	majorBytes  = 2
+	majorText   = 3
+	majorArray  = 4
+	majorMap    = 5
+	majorSimple = 7
+)
+
+// Encoder writes holidays to an output stream.
+type Encoder struct {
+	w   io.Writer
+	buf []byte
+}
+
+// NewEncoder returns a new encoder that writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Encode writes the CBOR encoding of h to the stream.
+func (e *Encoder) Encode(h holiday.Holiday) error {
+	e.buf = appendHoliday(e.buf[:0], h)
+	_, err := e.w.Write(e.buf)
+	return err
+}
+
+// Marshal returns the CBOR encoding of the holidays as an array.
+func Marshal(holidays []holiday.Holiday) []byte {
+	b := appendHead(nil, majorArray, uint64(len(holidays)))
+	for _, h := range holidays {
+		b = appendHoliday(b, h)
+	}
+	return b
+}
+
+func appendHoliday(b []byte, h holiday.Holiday) []byte {
+	b = appendHead(b, majorMap, 3)
+	b = appendText(b, "date")
+	b = appendText(b, h.Date)
+	b = appendText(b, "name")
+	b = appendText(b, h.Name)
+	b = appendText(b, "kind")
+	b = appendHead(b, majorUint, uint64(h.Kind))
+	return b
+}
+
+func appendText(b []byte, s string) []byte {
+	b = appendHead(b, majorText, uint64(len(s)))
+	return append(b, s...)
+}
+
+// appendHead appends the initial byte and the argument of a data item.
+func appendHead(b []byte, major byte, v uint64) []byte {
+	major <<= 5
+	switch {
+	case v < 24:
+		return append(b, major|byte(v))
+	case v < 1<<8:
+		return append(b, major|24, byte(v))
+	case v < 1<<16:
+		return binary.BigEndian.AppendUint16(append(b, major|25), uint16(v))
+	case v < 1<<32:
+		return binary.BigEndian.AppendUint32(append(b, major|26), uint32(v))
+	default:
+		return binary.BigEndian.AppendUint64(append(b, major|27), v)
+	}
+}
+
+// Decoder reads holidays from an input stream.
+type Decoder struct {
+	r *bufio.Reader
+}
+
+// NewDecoder returns a new decoder that reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: bufio.NewReader(r)}
+}
+
+// Decode reads the next holiday from the stream.
+// It returns io.EOF at the end of the stream.
+// Unknown keys are ignored if their values are integers, strings, booleans, or null.
+func (d *Decoder) Decode(h *holiday.Holiday) error {
+	major, n, err := d.readHead()
+	if err != nil {
+		return err
+	}
+	if major != majorMap {
+		return fmt.Errorf("cbor: want a map, got major type %d", major)
+	}
+
+	*h = holiday.Holiday{}
+	for i := uint64(0); i < n; i++ {
+		key, err := d.readValue()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		v, err := d.readValue()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		switch key {
+		case "date":
+			s, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("cbor: date must be a text string, got %T", v)
+			}
+			h.Date = s
+		case "name":
+			s, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("cbor: name must be a text string, got %T", v)
+			}
+			h.Name = s
+		case "kind":
+			k, ok := v.(uint64)
+			if !ok {
+				return fmt.Errorf("cbor: kind must be an unsigned integer, got %T", v)
+			}
+			h.Kind = holiday.Kind(k)
+		}
+	}
+	return nil
+}
+
+// Unmarshal decodes the array of holidays encoded by Marshal.
+func Unmarshal(data []byte) ([]holiday.Holiday, error) {
+	d := NewDecoder(bytes.NewReader(data))
+	major, n, err := d.readHead()
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	if major != majorArray {
+		return nil, fmt.Errorf("cbor: want an array, got major type %d", major)
+	}
+	// each holiday takes at least one byte.
+	if n > uint64(len(data)) {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	holidays := make([]holiday.Holiday, n)
+	for i := range holidays {
+		if err := d.Decode(&holidays[i]); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+	}
+	if _, err := d.r.ReadByte(); err != io.EOF {
+		return nil, errors.New("cbor: unexpected data after the array")
+	}
+	return holidays, nil
+}
+
+// readHead reads the initial byte and the argument of a data item.
+// Indefinite-length items are not supported.
+func (d *Decoder) readHead() (byte, uint64, error) {
+	c, err := d.r.ReadByte()
+	if err != nil {
+		return 0, 0, err
+	}
+	major, info := c>>5, c&0x1f
+	switch {
+	case info < 24:
+		return major, uint64(info), nil
+	case info <= 27:
+		size := 1 << (info - 24)
+		var buf [8]byte
+		if _, err := io.ReadFull(d.r, buf[8-size:]); err != nil {
+			return 0, 0, unexpectedEOF(err)
+		}
+		return major, binary.BigEndian.Uint64(buf[:]), nil
+	}
+	return 0, 0, fmt.Errorf("cbor: unsupported additional information %d", info)
+}
+
+// maxStringLength limits the length of strings to avoid huge allocations by broken input.
+const maxStringLength = 1 << 20
+
+// readValue reads a scalar value.
+func (d *Decoder) readValue() (any, error) {
+	major, v, err := d.readHead()
+	if err != nil {
+		return nil, err
+	}
+	switch major {
+	case majorUint:
+		return v, nil
+	case majorNegInt:
+		return -1 - int64(v), nil
+	case majorBytes, majorText:
+		if v > maxStringLength {
+			return nil, fmt.Errorf("cbor: string too long: %d bytes", v)
+		}
+		buf := make([]byte, v)
+		if _, err := io.ReadFull(d.r, buf); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		if major == majorBytes {
+			return buf, nil
+		}
+		return string(buf), nil
+	case majorSimple:
+		switch v {
+		case 20:
+			return false, nil
+		case 21:
+			return true, nil
+		case 22, 23: // null, undefined
+			return nil, nil
+		}
+	}
+	return nil, fmt.Errorf("cbor: unsupported data item: major type %d", major)
+}
+
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,124 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+	"github.com/shogo82148/holidays-jp/holidays-api/wareki"
+)
+
+var errInvalidTimeFormat = errors.New("holidaysapi: invalid time format")
+
+// checkLayouts are the layouts of the at parameter without a UTC offset.
+// They are interpreted in the time zone of the tz parameter.
+var checkLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+}
+
+// CheckResponse is the response of the check endpoint.
+// The date fields describe the date in Japan at the time.
+type CheckResponse struct {
+	// Time is the time in the time zone of the request, e.g. 2025-05-05T09:00:00-07:00.
+	Time string `json:"time"`
+
+	// TimeZone is the IANA name of the time zone of the request.
+	TimeZone string `json:"time_zone"`
+
+	// JapanTime is the same time in Japan, e.g. 2025-05-06T01:00:00+09:00.
+	JapanTime string `json:"japan_time"`
+
+	DateResponse
+}
+
+// check reports whether it is a holiday in Japan at the time.
+//
+// The at parameter is the time in RFC 3339, e.g. 2025-05-05T09:00:00-07:00, or without the UTC offset,
+// e.g. 2025-05-05T09:00, which is interpreted in the tz parameter.
+// A date in the Japanese calendar, e.g. 令和7年5月5日 or R7.5.5, is also accepted as 00:00 of the day.
+// The tz parameter is an IANA time zone name such as America/Los_Angeles, and Asia/Tokyo by default.
+// The time is now if at is not specified.
+func (h *Handler) check(w http.ResponseWriter, u *url.URL) *apiError {
+	q := u.Query()
+	loc := holiday.JST
+	if q.Has("tz") {
+		var err error
+		loc, err = loadLocation(q.Get("tz"))
+		if err != nil {
+			return invalidParameter("tz %q is not a valid IANA time zone name such as America/Los_Angeles", q.Get("tz"))
+		}
+	}
+
+	now := !q.Has("at")
+	var t time.Time
+	if now {
+		t = h.now().In(loc)
+	} else {
+		var err error
+		t, err = parseCheckTime(q.Get("at"), loc)
+		if err != nil {
+			return invalidParameter("at %q is not a valid time; use RFC 3339 such as 2006-01-02T15:04:05-07:00, or 2006-01-02T15:04:05 in tz", q.Get("at"))
+		}
+	}
+
+	japan := t.In(holiday.JST)
+	res := CheckResponse{
+		Time:         t.Format(time.RFC3339),
+		TimeZone:     loc.String(),
+		JapanTime:    japan.Format(time.RFC3339),
+		DateResponse: newDateResponse(holiday.Date{Year: japan.Year(), Month: japan.Month(), Day: japan.Day()}),
+	}
+	data, err := json.Marshal(res)
+	if err != nil {
+		h.responseInternalServerError(w, err)
+		return nil
+	}
+
+	maxAge := 24 * time.Hour
+	if now {
+		// the answer changes at midnight in Japan.
+		midnight := time.Date(japan.Year(), japan.Month(), japan.Day()+1, 0, 0, 0, 0, holiday.JST)
+		maxAge = midnight.Sub(japan)
+	} else if japan.Before(h.now().AddDate(0, 0, -1)) {
+		maxAge = 365 * 24 * time.Hour
+	}
+	setCommonHeaders(w, maxAge)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+	return nil
+}
+
+// loadLocation loads the time zone by the IANA name.
+// Unlike time.LoadLocation, the empty name and "Local" are rejected,
+// because they depend on the server, not on the client.
+func loadLocation(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("holidaysapi: unknown time zone %q", name)
+	}
+	return time.LoadLocation(name)
+}
+
+func parseCheckTime(s string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.In(loc), nil
+	}
+	for _, layout := range checkLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+
+	// a date in the Japanese calendar, e.g. 令和7年1月1日, means 00:00 of the day.
+	if d, err := wareki.Parse(s); err == nil {
+		return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc), nil
+	}
+	return time.Time{}, errInvalidTimeFormat
+}
@@ -0,0 +1,69 @@
+// Package closure provides customary closures in Japan, such as お盆 and 年末年始.
+//
+// They are NOT legal holidays. No law defines them, and some businesses stay open on them.
+// However, most companies close on them, so business-day calculations usually need them.
+// The results have the kind holiday.KindCustomary so that callers can tell them apart from legal holidays.
+package closure
+
+import (
+	"slices"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// Period is an annual closure period.
+type Period struct {
+	Name string
+
+	// Month and Day is the first day of the period.
+	Month time.Month
+	Day   int
+
+	// Days is the length of the period.
+	Days int
+}
+
+var periods = []Period{
+	{Name: "お盆休み", Month: time.August, Day: 13, Days: 3},
+	{Name: "年末年始休み", Month: time.December, Day: 29, Days: 6},
+}
+
+// Periods returns the customary closures.
+func Periods() []Period {
+	return slices.Clone(periods)
+}
+
+// FindClosuresInRange returns the customary closures between from and to (inclusive).
+func FindClosuresInRange(from, to holiday.Date) []holiday.Holiday {
+	if from.Compare(to) > 0 {
+		from, to = to, from
+	}
+
+	var result []holiday.Holiday
+	// the periods that start in the previous year may continue in the range.
+	for year := from.Year - 1; year <= to.Year; year++ {
+		for _, p := range periods {
+			for i := 0; i < p.Days; i++ {
+				t := time.Date(year, p.Month, p.Day+i, 0, 0, 0, 0, time.UTC)
+				d := holiday.Date{Year: t.Year(), Month: t.Month(), Day: t.Day()}
+				if d.Compare(from) < 0 || d.Compare(to) > 0 {
+					continue
+				}
+				result = append(result, holiday.Holiday{
+					Date: d.String(),
+					Name: p.Name,
+					Kind: holiday.KindCustomary,
+				})
+			}
+		}
+	}
+	holiday.SortHolidays(result)
+	return result
+}
+
+// FindHolidaysInRange returns the national holidays and the customary closures between from and to (inclusive).
+// National holidays take precedence over the closures on the same day, e.g. January 1st is 元日.
+func FindHolidaysInRange(from, to holiday.Date) []holiday.Holiday {
+	return holiday.Merge(holiday.FindHolidaysInRange(from, to), FindClosuresInRange(from, to))
+}
@@ -0,0 +1,44 @@
+package closure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestFindClosuresInRange(t *testing.T) {
+	from := holiday.Date{Year: 2022, Month: time.January, Day: 1}
+	to := holiday.Date{Year: 2022, Month: time.December, Day: 29}
+	got := FindClosuresInRange(from, to)
+	want := []holiday.Holiday{
+		{Date: "2022-01-01", Name: "年末年始休み", Kind: holiday.KindCustomary},
+		{Date: "2022-01-02", Name: "年末年始休み", Kind: holiday.KindCustomary},
+		{Date: "2022-01-03", Name: "年末年始休み", Kind: holiday.KindCustomary},
+		{Date: "2022-08-13", Name: "お盆休み", Kind: holiday.KindCustomary},
+		{Date: "2022-08-14", Name: "お盆休み", Kind: holiday.KindCustomary},
+		{Date: "2022-08-15", Name: "お盆休み", Kind: holiday.KindCustomary},
+		{Date: "2022-12-29", Name: "年末年始休み", Kind: holiday.KindCustomary},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FindClosuresInRange() mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestFindHolidaysInRange(t *testing.T) {
+	from := holiday.Date{Year: 2022, Month: time.December, Day: 30}
+	to := holiday.Date{Year: 2023, Month: time.January, Day: 9}
+	got := FindHolidaysInRange(from, to)
+	want := []holiday.Holiday{
+		{Date: "2022-12-30", Name: "年末年始休み", Kind: holiday.KindCustomary},
+		{Date: "2022-12-31", Name: "年末年始休み", Kind: holiday.KindCustomary},
+		{Date: "2023-01-01", Name: "元日"},
+		{Date: "2023-01-02", Name: "休日", Kind: holiday.KindSubstitute},
+		{Date: "2023-01-03", Name: "年末年始休み", Kind: holiday.KindCustomary},
+		{Date: "2023-01-09", Name: "成人の日"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FindHolidaysInRange() mismatch (-want/+got):\n%s", diff)
+	}
+}
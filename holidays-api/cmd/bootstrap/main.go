@@ -1,14 +1,292 @@
 package main
 
 import (
+	"context"
+	"crypto/ed25519"
+	"crypto/tls"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
+	"github.com/quic-go/quic-go/http3"
 	holidays "github.com/shogo82148/holidays-jp/holidays-api"
+	"github.com/shogo82148/holidays-jp/holidays-api/notify/discord"
+	"github.com/shogo82148/holidays-jp/holidays-api/requestid"
+	"github.com/shogo82148/holidays-jp/holidays-api/webhook"
 	"github.com/shogo82148/ridgenative"
+	"golang.org/x/crypto/acme/autocert"
+	_ "modernc.org/sqlite"
 )
 
 func main() {
-	h := holidays.NewHandler()
+	if err := _main(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err)
+	}
+}
+
+func _main() error {
+	var listen, certFile, keyFile, acmeHosts, acmeCache, socketMode, apiKeys, quotaFile, adminToken, webhookFile, sqlitePath, discordKey string
+	var enableHTTP3 bool
+	flag.StringVar(&listen, "listen", ":8080", "address to listen on, or unix:/path/to/app.sock for a unix domain socket")
+	flag.StringVar(&certFile, "tls-cert", "", "path to the TLS certificate file; HTTPS and HTTP/2 are enabled if set")
+	flag.StringVar(&keyFile, "tls-key", "", "path to the TLS private key file")
+	flag.StringVar(&acmeHosts, "acme-hosts", "", "comma-separated host names to get the TLS certificates for from Let's Encrypt; the other hosts are rejected")
+	flag.StringVar(&acmeCache, "acme-cache", "", "directory to cache the certificates and the account key of -acme-hosts; required with -acme-hosts")
+	flag.BoolVar(&enableHTTP3, "http3", false, "serve HTTP/3 over QUIC on the UDP port of -listen as well; requires TLS")
+	flag.StringVar(&socketMode, "socket-mode", "0660", "permission of the unix domain socket in octal")
+	flag.StringVar(&apiKeys, "api-keys", os.Getenv("HOLIDAYS_JP_API_KEYS"), "path to the CSV of API keys; the API requires keys if set")
+	flag.StringVar(&quotaFile, "quota-file", "", "path to the JSON file to persist the usage of the daily quotas; in memory if empty")
+	flag.StringVar(&adminToken, "admin-token", os.Getenv("HOLIDAYS_JP_ADMIN_TOKEN"), "bearer token for the admin dashboard and endpoints under /admin/; disabled if empty")
+	flag.StringVar(&webhookFile, "webhook-file", "", "path to the JSON file to persist the webhooks managed under /admin/webhooks; in memory if empty")
+	flag.StringVar(&sqlitePath, "sqlite", "", "path to the SQLite database to persist the quotas and the webhooks, but not the holiday data; takes precedence over -quota-file and -webhook-file")
+	flag.StringVar(&discordKey, "discord-public-key", os.Getenv("DISCORD_PUBLIC_KEY"), "hex-encoded public key of the Discord application; /discord/interactions answers the /holiday command if set")
+	flag.Parse()
+
+	onLambda := os.Getenv("AWS_LAMBDA_RUNTIME_API") != ""
+
+	var db *sql.DB
+	if sqlitePath != "" {
+		var err error
+		db, err = sql.Open("sqlite", sqlitePath)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+	}
+
+	var h http.Handler = holidays.NewHandler()
+
+	// ridgenative encodes JSON responses as text for AWS Lambda, so compressed bodies get broken.
+	// Compress the responses only when running as a normal HTTP server;
+	// CloudFront compresses them on AWS Lambda.
+	if !onLambda {
+		h = holidays.Compress(h)
+	}
+	if apiKeys != "" {
+		keys, err := holidays.LoadAPIKeys(apiKeys)
+		if err != nil {
+			return err
+		}
+		switch {
+		case db != nil:
+			keys.Quotas, err = holidays.NewSQLQuotaStore(context.Background(), db)
+			if err != nil {
+				return err
+			}
+		case quotaFile != "":
+			keys.Quotas, err = holidays.NewFileQuotaStore(quotaFile)
+			if err != nil {
+				return err
+			}
+		}
+		h = keys.Handler(h)
+		http.Handle("/admin/quotas", requestid.Handler(holidays.AccessLog(holidays.AdminAuth(adminToken, keys.QuotaHandler()))))
+	}
+	if adminToken != "" {
+		var store webhook.Store = webhook.NewMemoryStore()
+		switch {
+		case db != nil:
+			var err error
+			store, err = webhook.NewSQLStore(context.Background(), db)
+			if err != nil {
+				return err
+			}
+		case webhookFile != "":
+			var err error
+			store, err = webhook.NewFileStore(webhookFile)
+			if err != nil {
+				return err
+			}
+		}
+		// AWS Lambda freezes the process between requests, so the webhooks are notified only by normal HTTP servers.
+		if !onLambda {
+			dispatcher := &webhook.Dispatcher{Store: store}
+			go dispatcher.Run(context.Background(), 10*time.Minute)
+		}
+		dashboard := &holidays.Dashboard{Token: adminToken, Webhooks: store}
+		http.Handle("/admin/", requestid.Handler(holidays.AccessLog(http.StripPrefix("/admin", dashboard))))
+	}
+	if discordKey != "" {
+		key, err := hex.DecodeString(discordKey)
+		if err != nil || len(key) != ed25519.PublicKeySize {
+			return errors.New("-discord-public-key must be a hex-encoded Ed25519 public key")
+		}
+		interactions := &discord.Interactions{PublicKey: ed25519.PublicKey(key)}
+		http.Handle("/discord/interactions", requestid.Handler(holidays.AccessLog(interactions)))
+	}
+	h = requestid.Handler(holidays.AccessLog(h))
 	http.Handle("/", h)
-	ridgenative.ListenAndServe(":8080", nil)
+
+	if onLambda {
+		return ridgenative.ListenAndServe(listen, nil)
+	}
+	tlsConfig, err := newTLSConfig(certFile, keyFile, acmeHosts, acmeCache)
+	if err != nil {
+		return err
+	}
+	if enableHTTP3 && tlsConfig == nil {
+		return errors.New("-http3 requires -tls-cert and -tls-key, or -acme-hosts")
+	}
+	if enableHTTP3 && strings.HasPrefix(listen, "unix:") {
+		return errors.New("-http3 is not available on a unix domain socket")
+	}
+	mode, err := strconv.ParseUint(socketMode, 8, 32)
+	if err != nil {
+		return fmt.Errorf("invalid -socket-mode %q: %w", socketMode, err)
+	}
+
+	l, err := newListener(listen, os.FileMode(mode))
+	if err != nil {
+		return err
+	}
+	return serve(l, tlsConfig, enableHTTP3)
+}
+
+// newTLSConfig returns the TLS configuration with the certificate in certFile and keyFile,
+// or with the certificates of acmeHosts issued by Let's Encrypt.
+// It returns nil if TLS is not enabled.
+func newTLSConfig(certFile, keyFile, acmeHosts, acmeCache string) (*tls.Config, error) {
+	if (certFile == "") != (keyFile == "") {
+		return nil, errors.New("both -tls-cert and -tls-key are required to enable TLS")
+	}
+	if acmeHosts == "" && acmeCache != "" {
+		return nil, errors.New("-acme-cache requires -acme-hosts")
+	}
+
+	switch {
+	case acmeHosts != "" && certFile != "":
+		return nil, errors.New("-acme-hosts and -tls-cert are mutually exclusive")
+	case acmeHosts != "":
+		// the certificates are issued on the first request, and Let's Encrypt limits the issuance,
+		// so they must survive restarts.
+		if acmeCache == "" {
+			return nil, errors.New("-acme-hosts requires -acme-cache")
+		}
+		var hosts []string
+		for _, host := range strings.Split(acmeHosts, ",") {
+			if host = strings.TrimSpace(host); host != "" {
+				hosts = append(hosts, host)
+			}
+		}
+		m := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(hosts...),
+			Cache:      autocert.DirCache(acmeCache),
+		}
+		// it answers the TLS-ALPN-01 challenges, so the server must be reachable on port 443.
+		config := m.TLSConfig()
+		config.MinVersion = tls.VersionTLS12
+		return config, nil
+	case certFile != "":
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		return &tls.Config{
+			MinVersion:   tls.VersionTLS12,
+			Certificates: []tls.Certificate{cert},
+			NextProtos:   []string{"h2", "http/1.1"},
+		}, nil
+	}
+	return nil, nil
+}
+
+// newListener listens on addr.
+// If addr starts with "unix:", it listens on the unix domain socket at the path,
+// e.g. for deployments that front the API with nginx over a local socket.
+func newListener(addr string, mode os.FileMode) (net.Listener, error) {
+	path, ok := strings.CutPrefix(addr, "unix:")
+	if !ok {
+		return net.Listen("tcp", addr)
+	}
+
+	// remove the socket left by the previous process.
+	if fi, err := os.Lstat(path); err == nil && fi.Mode()&os.ModeSocket != 0 {
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		l.Close()
+		return nil, err
+	}
+	return l, nil
+}
+
+// serve serves HTTP on l until SIGINT or SIGTERM.
+// If tlsConfig is not nil, it terminates TLS by itself with HTTP/2 enabled,
+// so small deployments don't need a reverse proxy in front.
+// If enableHTTP3 is true, it also serves HTTP/3 on the UDP port of the same address,
+// and announces it to the clients by Alt-Svc.
+func serve(l net.Listener, tlsConfig *tls.Config, enableHTTP3 bool) error {
+	srv := &http.Server{
+		TLSConfig:         tlsConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+		IdleTimeout:       120 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	var h3 *http3.Server
+	if enableHTTP3 {
+		pc, err := net.ListenPacket("udp", l.Addr().String())
+		if err != nil {
+			return err
+		}
+		defer pc.Close()
+		h3 = &http3.Server{
+			Handler:   http.DefaultServeMux,
+			TLSConfig: srv.TLSConfig,
+		}
+		srv.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			h3.SetQuicHeaders(w.Header())
+			http.DefaultServeMux.ServeHTTP(w, r)
+		})
+		go func() {
+			err := h3.Serve(pc)
+			if ctx.Err() == nil {
+				// shut down the whole server, so that the process manager restarts it.
+				log.Printf("failed to serve HTTP/3: %v", err)
+				stop()
+			}
+		}()
+		log.Printf("listening on %s with HTTP/3", pc.LocalAddr())
+	}
+
+	go func() {
+		<-ctx.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if h3 != nil {
+			if err := h3.Close(); err != nil {
+				log.Printf("failed to shutdown HTTP/3: %v", err)
+			}
+		}
+		if err := srv.Shutdown(ctx); err != nil {
+			log.Printf("failed to shutdown: %v", err)
+		}
+	}()
+
+	if tlsConfig == nil {
+		log.Printf("listening on %s", l.Addr())
+		return srv.Serve(l)
+	}
+	log.Printf("listening on %s with TLS", l.Addr())
+	return srv.ServeTLS(l, "", "")
 }
@@ -0,0 +1,25 @@
+// Command holidays-mcp is a Model Context Protocol server that provides tools to query Japanese holidays.
+//
+// It communicates over stdio. Register it in an MCP client like:
+//
+//	{
+//	  "mcpServers": {
+//	    "holidays-jp": {
+//	      "command": "holidays-mcp"
+//	    }
+//	  }
+//	}
+package main
+
+import (
+	"log"
+	"os"
+)
+
+func main() {
+	// stdout is used by the protocol, so logs go to stderr.
+	log.SetOutput(os.Stderr)
+	if err := serve(os.Stdin, os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,279 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+const protocolVersion = "2024-11-05"
+
+// JSON-RPC error codes.
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeInternalError  = -32603
+)
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type tool struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	InputSchema map[string]any `json:"inputSchema"`
+	call        func(args map[string]string) (any, error)
+}
+
+type content struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type callResult struct {
+	Content []content `json:"content"`
+	IsError bool      `json:"isError,omitempty"`
+}
+
+func dateSchema(description string) map[string]any {
+	return map[string]any{
+		"type":        "string",
+		"pattern":     `^\d{4}-\d{2}-\d{2}$`,
+		"description": description,
+	}
+}
+
+var tools = []tool{
+	{
+		Name:        "is_holiday",
+		Description: "Reports whether the date is a holiday in Japan, and returns its name if so.",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"date": dateSchema("the date in YYYY-MM-DD, YYYY/M/D or YYYYMMDD"),
+			},
+			"required": []string{"date"},
+		},
+		call: isHoliday,
+	},
+	{
+		Name:        "holidays_in_range",
+		Description: "Lists the holidays in Japan between from and to (inclusive).",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"from": dateSchema("the first date in YYYY-MM-DD, YYYY/M/D or YYYYMMDD"),
+				"to":   dateSchema("the last date in YYYY-MM-DD, YYYY/M/D or YYYYMMDD"),
+			},
+			"required": []string{"from", "to"},
+		},
+		call: holidaysInRange,
+	},
+	{
+		Name:        "next_business_day",
+		Description: "Returns the first business day in Japan after the date. Weekends and holidays are not business days.",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"date": dateSchema("the date in YYYY-MM-DD, YYYY/M/D or YYYYMMDD"),
+			},
+			"required": []string{"date"},
+		},
+		call: nextBusinessDay,
+	},
+}
+
+type holidayResult struct {
+	Date string `json:"date"`
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+}
+
+func toResult(h holiday.Holiday) holidayResult {
+	return holidayResult{Date: h.Date, Name: h.Name, Kind: h.Kind.String()}
+}
+
+func parseDate(args map[string]string, key string) (time.Time, error) {
+	s, ok := args[key]
+	if !ok {
+		return time.Time{}, fmt.Errorf("%s is required", key)
+	}
+	d, err := holiday.ParseDate(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s: %q", key, s)
+	}
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, holiday.JST), nil
+}
+
+func isHoliday(args map[string]string) (any, error) {
+	t, err := parseDate(args, "date")
+	if err != nil {
+		return nil, err
+	}
+	h, ok := holiday.FindHoliday(t.Year(), t.Month(), t.Day())
+	if !ok {
+		return map[string]any{"date": t.Format("2006-01-02"), "holiday": false}, nil
+	}
+	return map[string]any{"date": h.Date, "holiday": true, "name": h.Name, "kind": h.Kind.String()}, nil
+}
+
+// maxRangeDays limits the range of holidays_in_range.
+const maxRangeDays = 366 * 10
+
+func holidaysInRange(args map[string]string) (any, error) {
+	from, err := parseDate(args, "from")
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseDate(args, "to")
+	if err != nil {
+		return nil, err
+	}
+	if to.Before(from) {
+		return nil, errors.New("from must not be after to")
+	}
+	if to.Sub(from) > maxRangeDays*24*time.Hour {
+		return nil, errors.New("the range must be within 10 years")
+	}
+
+	holidays := holiday.FindHolidaysInRange(
+		holiday.Date{Year: from.Year(), Month: from.Month(), Day: from.Day()},
+		holiday.Date{Year: to.Year(), Month: to.Month(), Day: to.Day()},
+	)
+	result := make([]holidayResult, 0, len(holidays))
+	for _, h := range holidays {
+		result = append(result, toResult(h))
+	}
+	return map[string]any{"holidays": result}, nil
+}
+
+func nextBusinessDay(args map[string]string) (any, error) {
+	t, err := parseDate(args, "date")
+	if err != nil {
+		return nil, err
+	}
+	next := holiday.NextBusinessDay(t)
+	return map[string]any{"date": next.Format("2006-01-02"), "weekday": next.Weekday().String()}, nil
+}
+
+// serve reads JSON-RPC messages from r line by line, and writes the responses to w.
+func serve(r io.Reader, w io.Writer) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+	enc := json.NewEncoder(w)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		res := handle(line)
+		if res == nil {
+			// notifications have no responses.
+			continue
+		}
+		if err := enc.Encode(res); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+func handle(line []byte) *response {
+	var req request
+	if err := json.Unmarshal(line, &req); err != nil {
+		return errorResponse(json.RawMessage("null"), codeParseError, "parse error")
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return errorResponse(idOrNull(req.ID), codeInvalidRequest, "invalid request")
+	}
+	if req.ID == nil {
+		// it is a notification, e.g. notifications/initialized.
+		return nil
+	}
+
+	switch req.Method {
+	case "initialize":
+		return result(req.ID, map[string]any{
+			"protocolVersion": protocolVersion,
+			"capabilities": map[string]any{
+				"tools": map[string]any{},
+			},
+			"serverInfo": map[string]any{
+				"name":    "holidays-jp",
+				"version": "1.0.0",
+			},
+		})
+	case "ping":
+		return result(req.ID, map[string]any{})
+	case "tools/list":
+		return result(req.ID, map[string]any{"tools": tools})
+	case "tools/call":
+		var params struct {
+			Name      string            `json:"name"`
+			Arguments map[string]string `json:"arguments"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return errorResponse(req.ID, codeInvalidParams, "invalid params")
+		}
+		for _, t := range tools {
+			if t.Name != params.Name {
+				continue
+			}
+			v, err := t.call(params.Arguments)
+			if err != nil {
+				// tool errors are reported in the result, so that the model can see them.
+				return result(req.ID, callResult{
+					Content: []content{{Type: "text", Text: err.Error()}},
+					IsError: true,
+				})
+			}
+			text, err := json.Marshal(v)
+			if err != nil {
+				return errorResponse(req.ID, codeInternalError, err.Error())
+			}
+			return result(req.ID, callResult{
+				Content: []content{{Type: "text", Text: string(text)}},
+			})
+		}
+		return errorResponse(req.ID, codeInvalidParams, fmt.Sprintf("unknown tool: %q", params.Name))
+	}
+	return errorResponse(req.ID, codeMethodNotFound, fmt.Sprintf("method not found: %q", req.Method))
+}
+
+func result(id json.RawMessage, v any) *response {
+	return &response{JSONRPC: "2.0", ID: id, Result: v}
+}
+
+func errorResponse(id json.RawMessage, code int, message string) *response {
+	return &response{JSONRPC: "2.0", ID: id, Error: &rpcError{Code: code, Message: message}}
+}
+
+func idOrNull(id json.RawMessage) json.RawMessage {
+	if id == nil {
+		return json.RawMessage("null")
+	}
+	return id
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func call(t *testing.T, input string) []map[string]any {
+	t.Helper()
+	var out strings.Builder
+	if err := serve(strings.NewReader(input), &out); err != nil {
+		t.Fatal(err)
+	}
+	var responses []map[string]any
+	dec := json.NewDecoder(strings.NewReader(out.String()))
+	for dec.More() {
+		var v map[string]any
+		if err := dec.Decode(&v); err != nil {
+			t.Fatal(err)
+		}
+		responses = append(responses, v)
+	}
+	return responses
+}
+
+func TestServe_Initialize(t *testing.T) {
+	responses := call(t, `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05","capabilities":{},"clientInfo":{"name":"test","version":"0"}}}
+{"jsonrpc":"2.0","method":"notifications/initialized"}
+{"jsonrpc":"2.0","id":2,"method":"tools/list"}
+`)
+	if len(responses) != 2 {
+		t.Fatalf("want 2 responses, got %d", len(responses))
+	}
+
+	result := responses[0]["result"].(map[string]any)
+	if result["protocolVersion"] != protocolVersion {
+		t.Errorf("unexpected protocol version: %v", result["protocolVersion"])
+	}
+
+	var names []string
+	for _, tool := range responses[1]["result"].(map[string]any)["tools"].([]any) {
+		names = append(names, tool.(map[string]any)["name"].(string))
+	}
+	want := []string{"is_holiday", "holidays_in_range", "next_business_day"}
+	if diff := cmp.Diff(want, names); diff != "" {
+		t.Errorf("tools mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestServe_ToolsCall(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		want    any
+		isError bool
+	}{
+		{
+			name: "is_holiday",
+			args: `{"date":"2024-08-12"}`,
+			want: map[string]any{"date": "2024-08-12", "holiday": true, "name": "休日", "kind": "substitute"},
+		},
+		{
+			name: "is_holiday",
+			args: `{"date":"2024-08-13"}`,
+			want: map[string]any{"date": "2024-08-13", "holiday": false},
+		},
+		{
+			name: "holidays_in_range",
+			args: `{"from":"2024-08-01","to":"2024-08-31"}`,
+			want: map[string]any{"holidays": []any{
+				map[string]any{"date": "2024-08-11", "name": "山の日", "kind": "national"},
+				map[string]any{"date": "2024-08-12", "name": "休日", "kind": "substitute"},
+			}},
+		},
+		{
+			name: "next_business_day",
+			args: `{"date":"2024-08-09"}`,
+			want: map[string]any{"date": "2024-08-13", "weekday": "Tuesday"},
+		},
+		{
+			name:    "is_holiday",
+			args:    `{"date":"2024-08-32"}`,
+			want:    `invalid date: "2024-08-32"`,
+			isError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			responses := call(t, `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"`+tt.name+`","arguments":`+tt.args+`}}`+"\n")
+			if len(responses) != 1 {
+				t.Fatalf("want 1 response, got %d", len(responses))
+			}
+			result := responses[0]["result"].(map[string]any)
+			isError, _ := result["isError"].(bool)
+			if isError != tt.isError {
+				t.Errorf("isError: want %t, got %t", tt.isError, isError)
+			}
+
+			text := result["content"].([]any)[0].(map[string]any)["text"].(string)
+			var got any = text
+			if !tt.isError {
+				if err := json.Unmarshal([]byte(text), &got); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("result mismatch (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServe_Errors(t *testing.T) {
+	responses := call(t, `not json
+{"jsonrpc":"2.0","id":1,"method":"resources/list"}
+{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"unknown","arguments":{}}}
+`)
+	var codes []float64
+	for _, res := range responses {
+		codes = append(codes, res["error"].(map[string]any)["code"].(float64))
+	}
+	want := []float64{codeParseError, codeMethodNotFound, codeInvalidParams}
+	if diff := cmp.Diff(want, codes); diff != "" {
+		t.Errorf("error codes mismatch (-want/+got):\n%s", diff)
+	}
+}
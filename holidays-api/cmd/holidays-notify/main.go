@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/smtp"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/notify"
+	"github.com/shogo82148/holidays-jp/holidays-api/notify/discord"
+	"github.com/shogo82148/holidays-jp/holidays-api/notify/email"
+	"github.com/shogo82148/holidays-jp/holidays-api/notify/line"
+	"github.com/shogo82148/holidays-jp/holidays-api/notify/slack"
+)
+
+func main() {
+	if err := _main(); err != nil && !errors.Is(err, context.Canceled) {
+		log.Fatal(err)
+	}
+}
+
+func _main() error {
+	var slackURL, channel, lang, digest string
+	var smtpAddr, smtpUser, smtpPassword, mailFrom, mailTo string
+	var lineToken, lineTo, discordURL string
+	var lead int
+	var longWeekends bool
+	var at time.Duration
+	flag.StringVar(&slackURL, "slack-webhook", os.Getenv("SLACK_WEBHOOK_URL"), "Slack incoming webhook URL")
+	flag.StringVar(&channel, "channel", "", "Slack channel to post")
+	flag.StringVar(&discordURL, "discord-webhook", os.Getenv("DISCORD_WEBHOOK_URL"), "Discord webhook URL")
+	flag.StringVar(&lineToken, "line-token", os.Getenv("LINE_CHANNEL_ACCESS_TOKEN"), "channel access token of the LINE Messaging API")
+	flag.StringVar(&lineTo, "line-to", "", "ID of the LINE user, group or room to post; broadcast to all friends if empty")
+	flag.StringVar(&smtpAddr, "smtp-addr", "", "address of the SMTP server to send emails, e.g. smtp.example.com:587")
+	flag.StringVar(&smtpUser, "smtp-user", "", "user name of the SMTP server")
+	flag.StringVar(&smtpPassword, "smtp-password", os.Getenv("SMTP_PASSWORD"), "password of the SMTP server")
+	flag.StringVar(&mailFrom, "mail-from", "", "sender address of emails")
+	flag.StringVar(&mailTo, "mail-to", "", "comma-separated recipient addresses of emails")
+	flag.StringVar(&digest, "digest", "", "send a weekly or monthly digest instead of reminders (weekly or monthly)")
+	flag.StringVar(&lang, "lang", "ja", "language of messages (ja or en)")
+	flag.IntVar(&lead, "lead", 1, "how many days before holidays to post")
+	flag.BoolVar(&longWeekends, "long-weekends", false, "also remind of long weekends such as Golden Week")
+	flag.DurationVar(&at, "at", 9*time.Hour, "time of day in JST to post")
+	flag.Parse()
+
+	var sender notify.Sender
+	switch {
+	case slackURL != "":
+		sender = &slack.Webhook{
+			URL:     slackURL,
+			Channel: channel,
+		}
+	case discordURL != "":
+		sender = &discord.Webhook{
+			URL: discordURL,
+		}
+	case lineToken != "":
+		sender = &line.Bot{
+			ChannelAccessToken: lineToken,
+			To:                 lineTo,
+		}
+	case smtpAddr != "":
+		if mailFrom == "" || mailTo == "" {
+			return errors.New("-mail-from and -mail-to are required to send emails")
+		}
+		s := &email.SMTP{
+			Addr: smtpAddr,
+			From: mailFrom,
+			To:   strings.Split(mailTo, ","),
+		}
+		if smtpUser != "" {
+			host, _, err := net.SplitHostPort(smtpAddr)
+			if err != nil {
+				return err
+			}
+			s.Auth = smtp.PlainAuth("", smtpUser, smtpPassword, host)
+		}
+		sender = s
+	default:
+		return errors.New("one of -slack-webhook, -discord-webhook, -line-token or -smtp-addr is required")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	switch digest {
+	case "":
+	case "weekly", "monthly":
+		period := notify.Weekly
+		if digest == "monthly" {
+			period = notify.Monthly
+		}
+		d := &notify.Digest{
+			Sender: sender,
+			Period: period,
+			Lang:   lang,
+			At:     at,
+		}
+		return d.Run(ctx)
+	default:
+		return fmt.Errorf("unknown -digest %q; use weekly or monthly", digest)
+	}
+
+	n := &notify.Notifier{
+		Sender:       sender,
+		LeadDays:     lead,
+		Lang:         lang,
+		At:           at,
+		LongWeekends: longWeekends,
+	}
+	return n.Run(ctx)
+}
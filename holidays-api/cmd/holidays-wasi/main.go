@@ -0,0 +1,28 @@
+// Command holidays-wasi serves the API on WebAssembly runtimes with WASI,
+// such as Spin, wasmtime or Cloudflare Workers with a WASI adapter.
+//
+// WASI has no sockets, so it talks CGI (WAGI) over stdin and stdout:
+// the runtime starts the module per request with the request in the CGI environment variables and stdin,
+// and the module writes the response to stdout.
+// The holidays and the time zone database are embedded in the binary.
+//
+// Build it with:
+//
+//	GOOS=wasip1 GOARCH=wasm go build -o holidays.wasm ./cmd/holidays-wasi
+package main
+
+import (
+	"log"
+	"net/http/cgi"
+
+	// WASI runtimes don't provide the time zone database.
+	_ "time/tzdata"
+
+	holidays "github.com/shogo82148/holidays-jp/holidays-api"
+)
+
+func main() {
+	if err := cgi.Serve(holidays.NewHandler()); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+	"github.com/shogo82148/holidays-jp/holidays-api/pdf"
+	"github.com/shogo82148/holidays-jp/holidays-api/render"
+)
+
+const usage = `usage:
+	holidays list [year]
+	holidays show date
+	holidays calendar [-format html|markdown|pdf] [year]
+`
+
+func main() {
+	log.SetFlags(0)
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(args []string, w io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%s", usage)
+	}
+
+	switch args[0] {
+	case "list":
+		year, err := parseYear(args[1:])
+		if err != nil {
+			return err
+		}
+		for _, h := range holiday.FindHolidaysInYear(year) {
+			fmt.Fprintf(w, "%s\t%s\n", h.Date, h.Name)
+		}
+		return nil
+
+	case "show":
+		if len(args) != 2 {
+			return fmt.Errorf("%s", usage)
+		}
+		h, ok, err := holiday.FindHolidayString(args[1])
+		if err != nil {
+			return err
+		}
+		if !ok {
+			d, _ := holiday.ParseDate(args[1])
+			fmt.Fprintf(w, "%s\tnot a holiday\n", d)
+			return nil
+		}
+		fmt.Fprintf(w, "%s\t%s\n", h.Date, h.Name)
+		return nil
+
+	case "calendar":
+		fs := flag.NewFlagSet("calendar", flag.ContinueOnError)
+		format := fs.String("format", "markdown", "output format: html, markdown or pdf")
+		if err := fs.Parse(args[1:]); err != nil {
+			return err
+		}
+		year, err := parseYear(fs.Args())
+		if err != nil {
+			return err
+		}
+		switch *format {
+		case "html":
+			return render.HTML(w, year)
+		case "markdown", "md":
+			return render.Markdown(w, year)
+		case "pdf":
+			return pdf.Calendar(w, year)
+		}
+		return fmt.Errorf("unknown format: %q", *format)
+	}
+	return fmt.Errorf("unknown command: %q\n%s", args[0], usage)
+}
+
+// parseYear returns the year in args, or the current year if args is empty.
+func parseYear(args []string) (int, error) {
+	if len(args) == 0 {
+		return time.Now().In(holiday.JST).Year(), nil
+	}
+	year, err := strconv.Atoi(args[0])
+	if err != nil || year < 1 || year > 9999 {
+		return 0, fmt.Errorf("invalid year: %q", args[0])
+	}
+	return year, nil
+}
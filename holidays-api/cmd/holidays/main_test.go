@@ -0,0 +1,69 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	t.Run("list", func(t *testing.T) {
+		var buf strings.Builder
+		if err := run([]string{"list", "2000"}, &buf); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(buf.String(), "2000-01-01\t元日\n2000-01-10\t成人の日\n") {
+			t.Errorf("unexpected output: %s", buf.String())
+		}
+	})
+
+	t.Run("show", func(t *testing.T) {
+		for _, date := range []string{"2000-01-01", "2000/1/1", "20000101"} {
+			var buf strings.Builder
+			if err := run([]string{"show", date}, &buf); err != nil {
+				t.Fatal(err)
+			}
+			if got, want := buf.String(), "2000-01-01\t元日\n"; got != want {
+				t.Errorf("%s: want %q, got %q", date, want, got)
+			}
+		}
+
+		var buf strings.Builder
+		if err := run([]string{"show", "2000/1/2"}, &buf); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := buf.String(), "2000-01-02\tnot a holiday\n"; got != want {
+			t.Errorf("want %q, got %q", want, got)
+		}
+
+		if err := run([]string{"show", "2000/2/30"}, &buf); err == nil {
+			t.Error("want error, but got nil")
+		}
+	})
+
+	t.Run("calendar", func(t *testing.T) {
+		var buf strings.Builder
+		if err := run([]string{"calendar", "-format", "markdown", "2000"}, &buf); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(buf.String(), "# 2000年\n") {
+			t.Errorf("unexpected output: %s", buf.String())
+		}
+	})
+
+	t.Run("calendar pdf", func(t *testing.T) {
+		var buf strings.Builder
+		if err := run([]string{"calendar", "-format", "pdf", "2000"}, &buf); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(buf.String(), "%PDF-") {
+			t.Errorf("unexpected output: %q", buf.String()[:16])
+		}
+	})
+
+	t.Run("unknown command", func(t *testing.T) {
+		var buf strings.Builder
+		if err := run([]string{"foo"}, &buf); err == nil {
+			t.Error("want error, but got nil")
+		}
+	})
+}
@@ -0,0 +1,154 @@
+package holidaysapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// minCompressSize is the minimum size of a response body to compress.
+// Smaller bodies don't get smaller enough to pay the cost.
+const minCompressSize = 1024
+
+// Compress returns a handler that compresses the responses of h with gzip or deflate,
+// negotiated by the Accept-Encoding header of the request.
+//
+// The response is buffered until h returns,
+// and it is sent as is if it is small or already has Content-Encoding.
+func Compress(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+		encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+		if encoding == "" {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		cw := &compressWriter{ResponseWriter: w}
+		h.ServeHTTP(cw, r)
+		cw.flush(encoding)
+	})
+}
+
+// negotiateEncoding returns the preferred encoding in the Accept-Encoding header.
+// It returns "" if neither gzip nor deflate is acceptable.
+func negotiateEncoding(accept string) string {
+	var best string
+	var bestQ float64
+	for _, v := range strings.Split(accept, ",") {
+		coding, params, _ := strings.Cut(v, ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		q := 1.0
+		for _, p := range strings.Split(params, ";") {
+			name, value, ok := strings.Cut(p, "=")
+			if !ok || strings.TrimSpace(name) != "q" {
+				continue
+			}
+			f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				q = 0
+				break
+			}
+			q = f
+		}
+		if q <= 0 {
+			continue
+		}
+
+		switch coding {
+		case "gzip", "x-gzip", "*":
+			coding = "gzip"
+		case "deflate":
+		default:
+			continue
+		}
+
+		// prefer gzip if the qualities are same.
+		if q > bestQ || (q == bestQ && coding == "gzip") {
+			best, bestQ = coding, q
+		}
+	}
+	return best
+}
+
+// compressWriter buffers the response to decide whether to compress it.
+// The header is shared with the underlying ResponseWriter.
+type compressWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	buf         bytes.Buffer
+}
+
+func (w *compressWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.status = status
+	w.wroteHeader = true
+}
+
+func (w *compressWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.buf.Write(b)
+}
+
+// flush writes the buffered response to the underlying ResponseWriter,
+// compressing it with encoding if it is worth.
+func (w *compressWriter) flush(encoding string) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	header := w.Header()
+	body := w.buf.Bytes()
+	if len(body) >= minCompressSize && header.Get("Content-Encoding") == "" {
+		var compressed bytes.Buffer
+		if err := compress(&compressed, encoding, body); err == nil {
+			if header.Get("Content-Type") == "" {
+				header.Set("Content-Type", http.DetectContentType(body))
+			}
+			body = compressed.Bytes()
+			header.Set("Content-Encoding", encoding)
+			header.Set("Content-Length", strconv.Itoa(len(body)))
+			weakenETag(header)
+		}
+	}
+	if w.status == http.StatusNotModified {
+		// keep the ETag same as the one of the compressed 200 response.
+		weakenETag(header)
+	}
+
+	w.ResponseWriter.WriteHeader(w.status)
+	w.ResponseWriter.Write(body)
+}
+
+// weakenETag makes the strong ETag weak,
+// because the compressed body is a different representation from the original.
+func weakenETag(header http.Header) {
+	if etag := header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+		header.Set("ETag", "W/"+etag)
+	}
+}
+
+func compress(w io.Writer, encoding string, data []byte) error {
+	var zw io.WriteCloser
+	switch encoding {
+	case "gzip":
+		zw = gzip.NewWriter(w)
+	default:
+		// "deflate" in HTTP is the zlib format, not the raw deflate stream.
+		// ref. https://www.rfc-editor.org/rfc/rfc9110#name-deflate-coding
+		zw = zlib.NewWriter(w)
+	}
+	if _, err := zw.Write(data); err != nil {
+		return err
+	}
+	return zw.Close()
+}
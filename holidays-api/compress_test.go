@@ -0,0 +1,124 @@
+package holidaysapi
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   string
+	}{
+		{"", ""},
+		{"identity", ""},
+		{"br", ""},
+		{"gzip", "gzip"},
+		{"deflate", "deflate"},
+		{"gzip, deflate, br", "gzip"},
+		{"deflate, gzip", "gzip"},
+		{"gzip;q=0.5, deflate", "deflate"},
+		{"gzip;q=0, deflate;q=0.1", "deflate"},
+		{"GZIP", "gzip"},
+		{"*", "gzip"},
+		{"gzip;q=0", ""},
+	}
+	for _, tt := range tests {
+		if got := negotiateEncoding(tt.accept); got != tt.want {
+			t.Errorf("negotiateEncoding(%q): want %q, got %q", tt.accept, tt.want, got)
+		}
+	}
+}
+
+func TestCompress(t *testing.T) {
+	h := Compress(NewHandler())
+	uncompressed := func() []byte {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/holidays?from=2000-01-01&to=2019-12-31", nil)
+		w := httptest.NewRecorder()
+		NewHandler().ServeHTTP(w, req)
+		return w.Body.Bytes()
+	}()
+
+	tests := []struct {
+		accept   string
+		encoding string
+		decode   func(io.Reader) (io.Reader, error)
+	}{
+		{
+			accept:   "",
+			encoding: "",
+			decode:   func(r io.Reader) (io.Reader, error) { return r, nil },
+		},
+		{
+			accept:   "gzip",
+			encoding: "gzip",
+			decode:   func(r io.Reader) (io.Reader, error) { return gzip.NewReader(r) },
+		},
+		{
+			accept:   "deflate",
+			encoding: "deflate",
+			decode:   func(r io.Reader) (io.Reader, error) { return zlib.NewReader(r) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "http://example.com/holidays?from=2000-01-01&to=2019-12-31", nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept-Encoding", tt.accept)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			resp := w.Result()
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+			}
+			if got := resp.Header.Get("Content-Encoding"); got != tt.encoding {
+				t.Errorf("unexpected Content-Encoding: want %q, got %q", tt.encoding, got)
+			}
+			if got := resp.Header.Get("Vary"); got != "Accept-Encoding" {
+				t.Errorf("unexpected Vary: want %q, got %q", "Accept-Encoding", got)
+			}
+			if got := resp.Header.Get("Content-Type"); got != "application/json" {
+				t.Errorf("unexpected Content-Type: want %q, got %q", "application/json", got)
+			}
+			if got, want := resp.Header.Get("Content-Length"), strconv.Itoa(w.Body.Len()); got != want {
+				t.Errorf("unexpected Content-Length: want %s, got %s", want, got)
+			}
+
+			r, err := tt.decode(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(string(uncompressed), string(body)); diff != "" {
+				t.Errorf("body mismatch (-want/+got):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("small response", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/2019/01/01", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if got := resp.Header.Get("Content-Encoding"); got != "" {
+			t.Errorf("small response must not be compressed, got Content-Encoding %q", got)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// countdownMaxAge is the max age of the countdown.
+// The countdown is in minutes, so it may be cached for a minute.
+const countdownMaxAge = time.Minute
+
+// CountdownResponse is the response of the countdown endpoint.
+type CountdownResponse struct {
+	// Now is the current time in JST that the countdown is calculated from.
+	Now string `json:"now"`
+
+	// Date, Name and Kind describe the next holiday after today.
+	Date string `json:"date"`
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+
+	// Days, Hours and Minutes are the time until 00:00 JST of the next holiday,
+	// e.g. 2 days 5 hours 30 minutes.
+	Days    int `json:"days"`
+	Hours   int `json:"hours"`
+	Minutes int `json:"minutes"`
+
+	// Seconds is the total time until 00:00 JST of the next holiday in seconds.
+	Seconds int64 `json:"seconds"`
+
+	// Today is the name of the holiday today. It is empty if today is not a holiday.
+	Today string `json:"today,omitempty"`
+}
+
+// countdown serves the time until the next holiday.
+func (h *Handler) countdown(w http.ResponseWriter) {
+	now := h.now()
+	next, ok := holiday.NextHoliday(now)
+	if !ok {
+		// it never happens because there are holidays every year.
+		h.responseInternalServerError(w, fmt.Errorf("holidaysapi: no holiday after %s", now.Format(time.RFC3339)))
+		return
+	}
+	date, err := holiday.ParseDate(next.Date)
+	if err != nil {
+		h.responseInternalServerError(w, err)
+		return
+	}
+	until := time.Date(date.Year, date.Month, date.Day, 0, 0, 0, 0, holiday.JST).Sub(now)
+
+	res := CountdownResponse{
+		Now:     now.Format(time.RFC3339),
+		Date:    next.Date,
+		Name:    next.Name,
+		Kind:    next.Kind.String(),
+		Days:    int(until / (24 * time.Hour)),
+		Hours:   int(until % (24 * time.Hour) / time.Hour),
+		Minutes: int(until % time.Hour / time.Minute),
+		Seconds: int64(until / time.Second),
+	}
+	if today, ok := holiday.FindHoliday(now.Year(), now.Month(), now.Day()); ok {
+		res.Today = today.Name
+	}
+	data, err := json.Marshal(res)
+	if err != nil {
+		h.responseInternalServerError(w, err)
+		return
+	}
+
+	setCommonHeaders(w, countdownMaxAge)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
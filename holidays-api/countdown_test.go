@@ -0,0 +1,80 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestServeHTTP_Countdown(t *testing.T) {
+	tests := []struct {
+		now  time.Time
+		want CountdownResponse
+	}{
+		{
+			// 2025-04-29 18:30 JST, 昭和の日
+			now: time.Date(2025, time.April, 29, 9, 30, 0, 0, time.UTC),
+			want: CountdownResponse{
+				Now:     "2025-04-29T18:30:00+09:00",
+				Date:    "2025-05-03",
+				Name:    "憲法記念日",
+				Kind:    "national",
+				Days:    3,
+				Hours:   5,
+				Minutes: 30,
+				Seconds: 3*24*60*60 + 5*60*60 + 30*60,
+				Today:   "昭和の日",
+			},
+		},
+		{
+			// 2025-05-05 23:59:30 JST, the next day is a substitute holiday.
+			now: time.Date(2025, time.May, 5, 14, 59, 30, 0, time.UTC),
+			want: CountdownResponse{
+				Now:     "2025-05-05T23:59:30+09:00",
+				Date:    "2025-05-06",
+				Name:    "休日",
+				Kind:    "substitute",
+				Seconds: 30,
+				Today:   "こどもの日",
+			},
+		},
+		{
+			// 2025-06-01 00:00 JST
+			now: time.Date(2025, time.May, 31, 15, 0, 0, 0, time.UTC),
+			want: CountdownResponse{
+				Now:     "2025-06-01T00:00:00+09:00",
+				Date:    "2025-07-21",
+				Name:    "海の日",
+				Kind:    "national",
+				Days:    50,
+				Seconds: 50 * 24 * 60 * 60,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want.Now, func(t *testing.T) {
+			h := NewHandler()
+			h.Clock = holiday.ClockFunc(func() time.Time { return tt.now })
+			req := httptest.NewRequest(http.MethodGet, "http://example.com/countdown", nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			resp := w.Result()
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+			}
+			var got CountdownResponse
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("response mismatch (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package holidaysapi
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+	"github.com/shogo82148/holidays-jp/holidays-api/webhook"
+)
+
+// maxDashboardDeliveries is the max number of the delivery logs per webhook in the dashboard.
+const maxDashboardDeliveries = 20
+
+// adminHTML is the dashboard page for the operators.
+// The page itself has no data; it asks the bearer token and fetches the status with it.
+//
+//go:embed ui/admin.html
+var adminHTML []byte
+
+// Dashboard is the admin UI for the operators.
+// It serves the page at "/", the status at "/status", the refresh at "/refresh",
+// the difference made by the last refresh at "/diff" and the management of the webhooks under "/webhooks";
+// mount it with http.StripPrefix, e.g. under "/admin".
+// All of them except the page require the bearer token in the same way as AdminAuth.
+type Dashboard struct {
+	// Token is the bearer token of the operators. If empty, all requests for the data are rejected.
+	Token string
+
+	// Webhooks is the store of the webhooks. If nil, the dashboard has no webhooks.
+	Webhooks webhook.Store
+
+	// refresh is replaced in tests.
+	refresh func(ctx context.Context) error
+}
+
+// DashboardStatus is the response of the status of Dashboard.
+type DashboardStatus struct {
+	DataVersion     string          `json:"data_version"`
+	DataGeneratedAt string          `json:"data_generated_at,omitempty"`
+	DataSourceURL   string          `json:"data_source_url"`
+	CoveredFrom     int             `json:"covered_from"`
+	CoveredTo       int             `json:"covered_to"`
+	LastRefresh     *RefreshStatus  `json:"last_refresh,omitempty"`
+	Webhooks        []WebhookStatus `json:"webhooks"`
+}
+
+// RefreshStatus is the result of the last refresh of the data.
+type RefreshStatus struct {
+	Time    string `json:"time"`
+	Changed bool   `json:"changed"`
+	Error   string `json:"error,omitempty"`
+}
+
+// DiffResponse is the difference made by the last refresh, in the response of Dashboard.
+type DiffResponse struct {
+	FromVersion string           `json:"from_version"`
+	ToVersion   string           `json:"to_version"`
+	Added       []DiffHoliday    `json:"added"`
+	Removed     []DiffHoliday    `json:"removed"`
+	Renamed     []RenamedHoliday `json:"renamed"`
+}
+
+// DiffHoliday is a holiday added or removed in DiffResponse.
+type DiffHoliday struct {
+	Date string `json:"date"`
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+}
+
+// RenamedHoliday is a holiday whose name or kind changed in DiffResponse.
+type RenamedHoliday struct {
+	Date    string `json:"date"`
+	OldName string `json:"old_name"`
+	NewName string `json:"new_name"`
+	OldKind string `json:"old_kind"`
+	NewKind string `json:"new_kind"`
+}
+
+// WebhookStatus is a registered webhook and its latest delivery logs, newest first.
+type WebhookStatus struct {
+	Subscription webhook.Subscription `json:"subscription"`
+	Deliveries   []webhook.Delivery   `json:"deliveries"`
+}
+
+// ServeHTTP implements http.Handler.
+func (d *Dashboard) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "", "/":
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", http.MethodGet)
+			responseError(w, http.StatusMethodNotAllowed, ErrorCodeMethodNotAllowed, "only GET is allowed")
+			return
+		}
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Content-Length", strconv.Itoa(len(adminHTML)))
+		w.WriteHeader(http.StatusOK)
+		w.Write(adminHTML)
+	case "/status":
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", http.MethodGet)
+			responseError(w, http.StatusMethodNotAllowed, ErrorCodeMethodNotAllowed, "only GET is allowed")
+			return
+		}
+		AdminAuth(d.Token, http.HandlerFunc(d.status)).ServeHTTP(w, r)
+	case "/diff":
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", http.MethodGet)
+			responseError(w, http.StatusMethodNotAllowed, ErrorCodeMethodNotAllowed, "only GET is allowed")
+			return
+		}
+		AdminAuth(d.Token, http.HandlerFunc(d.diff)).ServeHTTP(w, r)
+	case "/refresh":
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			responseError(w, http.StatusMethodNotAllowed, ErrorCodeMethodNotAllowed, "only POST is allowed")
+			return
+		}
+		AdminAuth(d.Token, http.HandlerFunc(d.refreshData)).ServeHTTP(w, r)
+	case "/webhooks":
+		if r.Method != http.MethodGet && r.Method != http.MethodHead && r.Method != http.MethodPost {
+			w.Header().Set("Allow", "GET, POST")
+			responseError(w, http.StatusMethodNotAllowed, ErrorCodeMethodNotAllowed, "only GET and POST are allowed")
+			return
+		}
+		AdminAuth(d.Token, http.HandlerFunc(d.webhooks)).ServeHTTP(w, r)
+	default:
+		if strings.HasPrefix(r.URL.Path, "/webhooks/") {
+			AdminAuth(d.Token, http.HandlerFunc(d.serveWebhook)).ServeHTTP(w, r)
+			return
+		}
+		responseError(w, http.StatusNotFound, ErrorCodeNotFound, "not found")
+	}
+}
+
+func (d *Dashboard) status(w http.ResponseWriter, r *http.Request) {
+	res := DashboardStatus{
+		DataVersion:   holiday.DataVersion(),
+		DataSourceURL: holiday.DataSourceURL(),
+		Webhooks:      []WebhookStatus{},
+	}
+	if t := holiday.DataGeneratedAt(); !t.IsZero() {
+		res.DataGeneratedAt = t.Format(time.RFC3339)
+	}
+	res.CoveredFrom, res.CoveredTo = holiday.CoveredYears()
+	if last, ok := holiday.LastUpdate(); ok {
+		res.LastRefresh = &RefreshStatus{
+			Time:    last.Time.Format(time.RFC3339),
+			Changed: last.Changed,
+		}
+		if last.Err != nil {
+			res.LastRefresh.Error = last.Err.Error()
+		}
+	}
+
+	if d.Webhooks != nil {
+		subs, err := d.Webhooks.Subscriptions(r.Context())
+		if err != nil {
+			log.Printf("failed to get the webhooks: %v", err)
+			responseError(w, http.StatusInternalServerError, ErrorCodeInternal, "internal server error")
+			return
+		}
+		for _, sub := range subs {
+			deliveries, err := d.Webhooks.Deliveries(r.Context(), sub.ID)
+			if err != nil {
+				log.Printf("failed to get the deliveries of %s: %v", sub.ID, err)
+				responseError(w, http.StatusInternalServerError, ErrorCodeInternal, "internal server error")
+				return
+			}
+			latest := make([]webhook.Delivery, 0, min(len(deliveries), maxDashboardDeliveries))
+			for i := len(deliveries) - 1; i >= 0 && len(latest) < maxDashboardDeliveries; i-- {
+				latest = append(latest, deliveries[i])
+			}
+			// never show the secrets, even to the operators.
+			sub.Secret = ""
+			res.Webhooks = append(res.Webhooks, WebhookStatus{
+				Subscription: sub,
+				Deliveries:   latest,
+			})
+		}
+	}
+	responseAdminJSON(w, res)
+}
+
+func (d *Dashboard) refreshData(w http.ResponseWriter, r *http.Request) {
+	refresh := d.refresh
+	if refresh == nil {
+		refresh = holiday.Update
+	}
+	if err := refresh(r.Context()); err != nil {
+		log.Printf("failed to refresh the holidays: %v", err)
+		responseError(w, http.StatusBadGateway, ErrorCodeRefreshFailed, "failed to refresh the holidays: "+err.Error())
+		return
+	}
+	d.status(w, r)
+}
+
+func (d *Dashboard) diff(w http.ResponseWriter, r *http.Request) {
+	diff, ok := holiday.LastDiff()
+	if !ok {
+		responseError(w, http.StatusNotFound, ErrorCodeNotFound, "no refresh has changed the data since the server started")
+		return
+	}
+
+	res := DiffResponse{
+		FromVersion: diff.FromVersion,
+		ToVersion:   diff.ToVersion,
+		Added:       diffHolidays(diff.Added),
+		Removed:     diffHolidays(diff.Removed),
+		Renamed:     make([]RenamedHoliday, 0, len(diff.Renamed)),
+	}
+	for _, c := range diff.Renamed {
+		res.Renamed = append(res.Renamed, RenamedHoliday{
+			Date:    c.New.Date,
+			OldName: c.Old.Name,
+			NewName: c.New.Name,
+			OldKind: c.Old.Kind.String(),
+			NewKind: c.New.Kind.String(),
+		})
+	}
+	responseAdminJSON(w, res)
+}
+
+func diffHolidays(holidays []holiday.Holiday) []DiffHoliday {
+	ret := make([]DiffHoliday, 0, len(holidays))
+	for _, h := range holidays {
+		ret = append(ret, DiffHoliday{
+			Date: h.Date,
+			Name: h.Name,
+			Kind: h.Kind.String(),
+		})
+	}
+	return ret
+}
+
+func responseAdminJSON(w http.ResponseWriter, v any) {
+	responseAdminJSONWithStatus(w, http.StatusOK, v)
+}
+
+func responseAdminJSONWithStatus(w http.ResponseWriter, status int, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		responseError(w, http.StatusInternalServerError, ErrorCodeInternal, "internal server error")
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(status)
+	w.Write(data)
+}
@@ -0,0 +1,141 @@
+package holidaysapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+	"github.com/shogo82148/holidays-jp/holidays-api/webhook"
+)
+
+func TestDashboard(t *testing.T) {
+	ctx := context.Background()
+	store := webhook.NewMemoryStore()
+	if err := store.AddSubscription(ctx, webhook.Subscription{
+		ID:     "sub-1",
+		URL:    "https://example.com/hook",
+		Secret: "very-secret",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= maxDashboardDeliveries+1; i++ {
+		if err := store.RecordDelivery(ctx, webhook.Delivery{
+			SubscriptionID: "sub-1",
+			HolidayDate:    "2024-01-01",
+			Attempt:        i,
+			Time:           time.Date(2024, time.January, 1, 0, i, 0, 0, time.UTC),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var refreshed int
+	d := &Dashboard{
+		Token:    "secret",
+		Webhooks: store,
+		refresh: func(ctx context.Context) error {
+			refreshed++
+			if refreshed > 1 {
+				return errors.New("upstream is down")
+			}
+			return nil
+		},
+	}
+	serve := func(method, path string, auth bool) *http.Response {
+		req := httptest.NewRequest(method, "http://example.com"+path, nil)
+		if auth {
+			req.Header.Set("Authorization", "Bearer secret")
+		}
+		w := httptest.NewRecorder()
+		d.ServeHTTP(w, req)
+		return w.Result()
+	}
+
+	t.Run("page", func(t *testing.T) {
+		resp := serve(http.MethodGet, "/", false)
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		if got := resp.Header.Get("Content-Type"); !strings.HasPrefix(got, "text/html") {
+			t.Errorf("unexpected Content-Type: %q", got)
+		}
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		if resp := serve(http.MethodGet, "/status", false); resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusUnauthorized, resp.StatusCode)
+		}
+		if resp := serve(http.MethodPost, "/refresh", false); resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusUnauthorized, resp.StatusCode)
+		}
+		if refreshed != 0 {
+			t.Errorf("refreshed without the token")
+		}
+	})
+
+	t.Run("status", func(t *testing.T) {
+		resp := serve(http.MethodGet, "/status", true)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		var got DashboardStatus
+		if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+			t.Fatal(err)
+		}
+		if got.DataVersion != holiday.DataVersion() {
+			t.Errorf("want version %s, got %s", holiday.DataVersion(), got.DataVersion)
+		}
+		if len(got.Webhooks) != 1 {
+			t.Fatalf("want 1 webhook, got %d", len(got.Webhooks))
+		}
+		if got.Webhooks[0].Subscription.Secret != "" {
+			t.Error("the secret must not be shown")
+		}
+		var attempts []int
+		for _, d := range got.Webhooks[0].Deliveries {
+			attempts = append(attempts, d.Attempt)
+		}
+		want := []int{21, 20, 19, 18, 17, 16, 15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2}
+		if diff := cmp.Diff(want, attempts); diff != "" {
+			t.Errorf("deliveries mismatch (-want/+got):\n%s", diff)
+		}
+	})
+
+	t.Run("diff", func(t *testing.T) {
+		if resp := serve(http.MethodGet, "/diff", false); resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusUnauthorized, resp.StatusCode)
+		}
+		// the data has never been refreshed in the tests.
+		if resp := serve(http.MethodGet, "/diff", true); resp.StatusCode != http.StatusNotFound {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusNotFound, resp.StatusCode)
+		}
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		if resp := serve(http.MethodGet, "/refresh", true); resp.StatusCode != http.StatusMethodNotAllowed {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusMethodNotAllowed, resp.StatusCode)
+		}
+		if resp := serve(http.MethodPost, "/refresh", true); resp.StatusCode != http.StatusOK {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+
+		resp := serve(http.MethodPost, "/refresh", true)
+		if resp.StatusCode != http.StatusBadGateway {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusBadGateway, resp.StatusCode)
+		}
+		var got ErrorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+			t.Fatal(err)
+		}
+		if got.Error.Code != ErrorCodeRefreshFailed {
+			t.Errorf("want code %s, got %s", ErrorCodeRefreshFailed, got.Error.Code)
+		}
+	})
+}
@@ -0,0 +1,59 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// EquinoxResponse is the response of the equinox endpoint.
+type EquinoxResponse struct {
+	Year     int     `json:"year"`
+	Vernal   Equinox `json:"vernal"`
+	Autumnal Equinox `json:"autumnal"`
+}
+
+// Equinox is the calculated moment of an equinox.
+type Equinox struct {
+	// Time is the moment in RFC 3339 in JST.
+	Time string `json:"time"`
+	Date string `json:"date"`
+
+	// Uncertainty is the estimated error of Time in seconds.
+	Uncertainty int64 `json:"uncertainty"`
+
+	// Reliable is false if the equinox is so close to midnight that the date may change within Uncertainty.
+	Reliable bool `json:"reliable"`
+}
+
+func newEquinox(e holiday.Equinox) Equinox {
+	return Equinox{
+		Time:        e.Time.Format(time.RFC3339),
+		Date:        e.Date().String(),
+		Uncertainty: int64(e.Uncertainty / time.Second),
+		Reliable:    e.Reliable(),
+	}
+}
+
+func (h *Handler) equinox(w http.ResponseWriter, year int) {
+	res := EquinoxResponse{
+		Year:     year,
+		Vernal:   newEquinox(holiday.VernalEquinox(year)),
+		Autumnal: newEquinox(holiday.AutumnalEquinox(year)),
+	}
+	data, err := json.Marshal(res)
+	if err != nil {
+		h.responseInternalServerError(w, err)
+		return
+	}
+
+	// the calculation never changes.
+	setCommonHeaders(w, 365*24*time.Hour)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
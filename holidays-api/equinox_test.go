@@ -0,0 +1,47 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestServeHTTP_Equinox(t *testing.T) {
+	h := NewHandler()
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/equinox/2100", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	resp := w.Result()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	var got EquinoxResponse
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Year != 2100 {
+		t.Errorf("unexpected year: want %d, got %d", 2100, got.Year)
+	}
+	if got.Vernal.Date != "2100-03-20" || got.Autumnal.Date != "2100-09-23" {
+		t.Errorf("unexpected dates: %s, %s", got.Vernal.Date, got.Autumnal.Date)
+	}
+	if got.Vernal.Time != "2100-03-20T22:04:26+09:00" {
+		t.Errorf("unexpected time: want %q, got %q", "2100-03-20T22:04:26+09:00", got.Vernal.Time)
+	}
+	if got.Vernal.Uncertainty < 60 || !got.Vernal.Reliable {
+		t.Errorf("unexpected uncertainty: %d, %t", got.Vernal.Uncertainty, got.Vernal.Reliable)
+	}
+}
+
+func TestServeHTTP_EquinoxNotFound(t *testing.T) {
+	h := NewHandler()
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/equinox/abc", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("unexpected status code: want %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
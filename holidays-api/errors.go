@@ -0,0 +1,128 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/requestid"
+)
+
+// The codes of the errors in ErrorResponse.
+// Clients can branch on them; they never change once documented.
+const (
+	// ErrorCodeNotFound means that no resource is at the path. The status is 404.
+	ErrorCodeNotFound = "not_found"
+
+	// ErrorCodeMethodNotAllowed means that the method is not GET. The status is 405.
+	ErrorCodeMethodNotAllowed = "method_not_allowed"
+
+	// ErrorCodeInvalidDate means that a date in the path or the query doesn't exist or is malformed. The status is 400.
+	ErrorCodeInvalidDate = "invalid_date"
+
+	// ErrorCodeInvalidParameter means that a query parameter other than dates is invalid. The status is 400.
+	ErrorCodeInvalidParameter = "invalid_parameter"
+
+	// ErrorCodeUnauthorized means that the API key is missing or unknown. The status is 401.
+	ErrorCodeUnauthorized = "unauthorized"
+
+	// ErrorCodeRateLimited means that the API key exceeds its rate limit. The status is 429.
+	ErrorCodeRateLimited = "rate_limited"
+
+	// ErrorCodeQuotaExceeded means that the API key exceeds its daily quota. The status is 429.
+	ErrorCodeQuotaExceeded = "quota_exceeded"
+
+	// ErrorCodeRefreshFailed means that the refresh of the data requested in Dashboard failed. The status is 502.
+	ErrorCodeRefreshFailed = "refresh_failed"
+
+	// ErrorCodeInternal means that the server failed. The status is 500.
+	ErrorCodeInternal = "internal_error"
+)
+
+// ErrorResponse is the response of Handler on errors.
+type ErrorResponse struct {
+	Error ErrorDetail `json:"error"`
+}
+
+// ErrorDetail describes the error in ErrorResponse.
+type ErrorDetail struct {
+	// Code is one of the ErrorCode constants.
+	Code string `json:"code"`
+
+	// Message is a human-readable description. It may change.
+	Message string `json:"message"`
+
+	// RequestID is the ID set by requestid.Handler, to be reported by users.
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// apiError is an error reported to the clients.
+type apiError struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("holidaysapi: %s: %s", e.code, e.message)
+}
+
+func invalidDate(name, value string) *apiError {
+	return &apiError{
+		status:  http.StatusBadRequest,
+		code:    ErrorCodeInvalidDate,
+		message: fmt.Sprintf("%s %q is not a valid date; use a format such as 2006-01-02", name, value),
+	}
+}
+
+func invalidParameter(format string, args ...any) *apiError {
+	return &apiError{
+		status:  http.StatusBadRequest,
+		code:    ErrorCodeInvalidParameter,
+		message: fmt.Sprintf(format, args...),
+	}
+}
+
+func (h *Handler) responseAPIError(w http.ResponseWriter, err *apiError) {
+	// the errors are caused by the requests, so they don't change soon.
+	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 24*60*60))
+	responseError(w, err.status, err.code, err.message)
+}
+
+func (h *Handler) responseInternalServerError(w http.ResponseWriter, err error) {
+	log.Printf("internal server error: %v (request_id=%s)", err, w.Header().Get(requestid.Header))
+	responseError(w, http.StatusInternalServerError, ErrorCodeInternal, "internal server error")
+}
+
+func (h *Handler) responseNotFound(w http.ResponseWriter) {
+	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 24*60*60))
+	responseError(w, http.StatusNotFound, ErrorCodeNotFound, "not found; see https://github.com/shogo82148/holidays-jp/ for more information.")
+}
+
+func (h *Handler) responseMethodNotAllowed(w http.ResponseWriter) {
+	w.Header().Set("Allow", http.MethodGet)
+	responseError(w, http.StatusMethodNotAllowed, ErrorCodeMethodNotAllowed, "only GET is allowed")
+}
+
+func responseError(w http.ResponseWriter, status int, code, message string) {
+	data, err := json.Marshal(ErrorResponse{
+		Error: ErrorDetail{
+			Code:      code,
+			Message:   message,
+			RequestID: w.Header().Get(requestid.Header),
+		},
+	})
+	if err != nil {
+		// it never happens because ErrorResponse has only strings.
+		panic(err)
+	}
+
+	// the callers set Cache-Control, because it depends on the error.
+	setCommonHeaders(w, 0)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(status)
+	w.Write(data)
+}
@@ -0,0 +1,54 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestServeHTTP_Errors(t *testing.T) {
+	h := NewHandler()
+	tests := []struct {
+		method string
+		path   string
+		status int
+		code   string
+	}{
+		{http.MethodGet, "/unknown", http.StatusNotFound, ErrorCodeNotFound},
+		{http.MethodGet, "/stats/abcd", http.StatusNotFound, ErrorCodeNotFound},
+		{http.MethodPost, "/2021", http.StatusMethodNotAllowed, ErrorCodeMethodNotAllowed},
+		{http.MethodGet, "/2021/13", http.StatusBadRequest, ErrorCodeInvalidDate},
+		{http.MethodGet, "/2021/02/29", http.StatusBadRequest, ErrorCodeInvalidDate},
+		{http.MethodGet, "/date/2021-02-29", http.StatusBadRequest, ErrorCodeInvalidDate},
+		{http.MethodGet, "/holidays?from=2021-13-01&to=2021-12-31", http.StatusBadRequest, ErrorCodeInvalidDate},
+		{http.MethodGet, "/holidays?from=2021-01-01&to=2021-12-31&limit=x", http.StatusBadRequest, ErrorCodeInvalidParameter},
+		{http.MethodGet, "/calendar/2021?format=pdf", http.StatusBadRequest, ErrorCodeInvalidParameter},
+		{http.MethodGet, "/2021?callback=alert(1)", http.StatusBadRequest, ErrorCodeInvalidParameter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "http://example.com"+tt.path, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.status {
+				t.Errorf("unexpected status code: want %d, got %d", tt.status, resp.StatusCode)
+			}
+			if got := resp.Header.Get("Content-Type"); got != "application/json" {
+				t.Errorf("unexpected Content-Type: %q", got)
+			}
+			var got ErrorResponse
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if got.Error.Code != tt.code {
+				t.Errorf("unexpected code: want %q, got %q", tt.code, got.Error.Code)
+			}
+			if got.Error.Message == "" {
+				t.Error("message is empty")
+			}
+		})
+	}
+}
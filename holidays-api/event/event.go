@@ -0,0 +1,166 @@
+// Package event provides the registry of one-off national events designated by the government,
+// such as 大喪の礼, 即位礼正殿の儀, and state funerals (国葬儀).
+//
+// Some of them are holidays established by special laws, which are also in the national holidays as holiday.KindSpecial.
+// The others are days of mourning. They are NOT holidays; government offices stay open,
+// but the national flags fly at half-mast and some events are canceled.
+// The events are separate from the annual statutory holidays, so callers choose which of them to layer into their calendars.
+package event
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// Category is the category of an event.
+type Category int
+
+const (
+	// CategoryHoliday is a day off established by a special law, e.g. 即位礼正殿の儀.
+	CategoryHoliday Category = iota
+
+	// CategoryMourning is a day of national mourning, e.g. a state funeral.
+	// It is not a day off.
+	CategoryMourning
+)
+
+var categoryNames = [...]string{
+	CategoryHoliday:  "holiday",
+	CategoryMourning: "mourning",
+}
+
+func (c Category) String() string {
+	if 0 <= c && int(c) < len(categoryNames) {
+		return categoryNames[c]
+	}
+	return fmt.Sprintf("Category(%d)", int(c))
+}
+
+// Kind returns the kind of the holidays of the category.
+func (c Category) Kind() holiday.Kind {
+	if c == CategoryHoliday {
+		return holiday.KindSpecial
+	}
+	return holiday.KindMourning
+}
+
+// Event is a one-off national event.
+type Event struct {
+	Date     holiday.Date
+	Name     string
+	Category Category
+
+	// Basis is the law or the cabinet decision (閣議決定) that designated the event.
+	Basis string
+}
+
+// Holiday returns the event as a holiday with the kind of its category.
+func (e Event) Holiday() holiday.Holiday {
+	return holiday.Holiday{
+		Date: e.Date.String(),
+		Name: e.Name,
+		Kind: e.Category.Kind(),
+	}
+}
+
+// events are the one-off national events sorted by date.
+// Add a new event only after the government officially designates it.
+var events = []Event{
+	{
+		Date:     holiday.Date{Year: 1959, Month: time.April, Day: 10},
+		Name:     "皇太子明仁親王の結婚の儀",
+		Category: CategoryHoliday,
+		Basis:    "皇太子明仁親王の結婚の儀の行われる日を休日とする法律（昭和三十四年法律第十六号）",
+	},
+	{
+		Date:     holiday.Date{Year: 1967, Month: time.October, Day: 31},
+		Name:     "故吉田茂国葬儀",
+		Category: CategoryMourning,
+		Basis:    "故吉田茂国葬儀の実施について（閣議決定）",
+	},
+	{
+		Date:     holiday.Date{Year: 1989, Month: time.February, Day: 24},
+		Name:     "昭和天皇の大喪の礼",
+		Category: CategoryHoliday,
+		Basis:    "昭和天皇の大喪の礼の行われる日を休日とする法律（平成元年法律第四号）",
+	},
+	{
+		Date:     holiday.Date{Year: 1990, Month: time.November, Day: 12},
+		Name:     "即位礼正殿の儀",
+		Category: CategoryHoliday,
+		Basis:    "即位礼正殿の儀の行われる日を休日とする法律（平成二年法律第二十四号）",
+	},
+	{
+		Date:     holiday.Date{Year: 1993, Month: time.June, Day: 9},
+		Name:     "皇太子徳仁親王の結婚の儀",
+		Category: CategoryHoliday,
+		Basis:    "皇太子徳仁親王の結婚の儀の行われる日を休日とする法律（平成五年法律第三十二号）",
+	},
+	{
+		Date:     holiday.Date{Year: 2019, Month: time.May, Day: 1},
+		Name:     "天皇の即位の日",
+		Category: CategoryHoliday,
+		Basis:    "天皇の即位の日及び即位礼正殿の儀の行われる日を休日とする法律（平成三十年法律第九十九号）",
+	},
+	{
+		Date:     holiday.Date{Year: 2019, Month: time.October, Day: 22},
+		Name:     "即位礼正殿の儀",
+		Category: CategoryHoliday,
+		Basis:    "天皇の即位の日及び即位礼正殿の儀の行われる日を休日とする法律（平成三十年法律第九十九号）",
+	},
+	{
+		Date:     holiday.Date{Year: 2022, Month: time.September, Day: 27},
+		Name:     "故安倍晋三国葬儀",
+		Category: CategoryMourning,
+		Basis:    "故安倍晋三国葬儀の実施について（令和四年七月二十二日閣議決定）",
+	},
+}
+
+// Events returns all the events sorted by date.
+func Events() []Event {
+	return slices.Clone(events)
+}
+
+// FindEventsInRange returns the events of the categories between from and to (inclusive) as holidays.
+// If no categories are given, the events of all categories are returned.
+func FindEventsInRange(from, to holiday.Date, categories ...Category) []holiday.Holiday {
+	if from.Compare(to) > 0 {
+		from, to = to, from
+	}
+
+	var result []holiday.Holiday
+	for _, e := range events {
+		if len(categories) > 0 && !slices.Contains(categories, e.Category) {
+			continue
+		}
+		if e.Date.Compare(from) < 0 || e.Date.Compare(to) > 0 {
+			continue
+		}
+		result = append(result, e.Holiday())
+	}
+	return result
+}
+
+// Provider returns a holiday.HolidayProvider of the events of the categories,
+// to layer them into a holiday.Calendar, e.g.
+//
+//	holiday.NewCalendar(holiday.Japan, event.Provider(event.CategoryMourning))
+//
+// If no categories are given, the events of all categories are provided.
+// The days of mourning are holidays of KindMourning, which are not days off,
+// so the calendar reports them by FindHoliday, but they stay business days.
+func Provider(categories ...Category) holiday.HolidayProvider {
+	categories = slices.Clone(categories)
+	return holiday.ProviderFunc(func(from, to holiday.Date) []holiday.Holiday {
+		return FindEventsInRange(from, to, categories...)
+	})
+}
+
+// FindHolidaysInRange returns the national holidays and the days of mourning between from and to (inclusive).
+// National holidays take precedence over the events on the same day.
+func FindHolidaysInRange(from, to holiday.Date) []holiday.Holiday {
+	return holiday.Merge(holiday.FindHolidaysInRange(from, to), FindEventsInRange(from, to, CategoryMourning))
+}
@@ -0,0 +1,136 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestEvents(t *testing.T) {
+	list := Events()
+	for i, e := range list {
+		if i > 0 && list[i-1].Date.Compare(e.Date) >= 0 {
+			t.Errorf("%s: the events are not sorted", e.Date)
+		}
+		if e.Name == "" || e.Basis == "" {
+			t.Errorf("%s: the name and the basis are required", e.Date)
+		}
+	}
+
+	// the registry is owned by the package.
+	list[0].Name = "modified"
+	if Events()[0].Name == "modified" {
+		t.Error("the registry is modified")
+	}
+}
+
+// The holidays established by special laws must be consistent with the national holidays.
+func TestEvents_SpecialHolidays(t *testing.T) {
+	var got []string
+	for _, e := range Events() {
+		if e.Category != CategoryHoliday {
+			continue
+		}
+		got = append(got, e.Date.String())
+		h, ok := holiday.FindHoliday(e.Date.Year, e.Date.Month, e.Date.Day)
+		if !ok || h.Kind != holiday.KindSpecial {
+			t.Errorf("%s: want a special holiday, got %v", e.Date, h)
+			continue
+		}
+		if h.LegalBasis() != e.Basis {
+			t.Errorf("%s: want basis %q, got %q", e.Date, h.LegalBasis(), e.Basis)
+		}
+	}
+
+	var want []string
+	from := holiday.Date{Year: 1948, Month: time.January, Day: 1}
+	to := holiday.Date{Year: 2030, Month: time.December, Day: 31}
+	for _, h := range holiday.FilterByKind(holiday.FindHolidaysInRange(from, to), holiday.KindSpecial) {
+		want = append(want, h.Date)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("special holidays mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestFindEventsInRange(t *testing.T) {
+	from := holiday.Date{Year: 2019, Month: time.January, Day: 1}
+	to := holiday.Date{Year: 2022, Month: time.December, Day: 31}
+
+	got := FindEventsInRange(to, from)
+	want := []holiday.Holiday{
+		{Date: "2019-05-01", Name: "天皇の即位の日", Kind: holiday.KindSpecial},
+		{Date: "2019-10-22", Name: "即位礼正殿の儀", Kind: holiday.KindSpecial},
+		{Date: "2022-09-27", Name: "故安倍晋三国葬儀", Kind: holiday.KindMourning},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FindEventsInRange() mismatch (-want/+got):\n%s", diff)
+	}
+
+	got = FindEventsInRange(from, to, CategoryMourning)
+	want = []holiday.Holiday{
+		{Date: "2022-09-27", Name: "故安倍晋三国葬儀", Kind: holiday.KindMourning},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FindEventsInRange(CategoryMourning) mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestProvider(t *testing.T) {
+	cal := holiday.NewCalendar(holiday.Japan, Provider(CategoryMourning))
+	funeral := time.Date(2022, time.September, 27, 12, 0, 0, 0, time.UTC)
+	if !cal.IsHoliday(funeral) {
+		t.Errorf("%s: want a holiday on the layered calendar", funeral)
+	}
+	if holiday.IsHoliday(funeral) {
+		t.Errorf("%s: want not a holiday on the national calendar", funeral)
+	}
+}
+
+func TestFindHolidaysInRange(t *testing.T) {
+	from := holiday.Date{Year: 2022, Month: time.September, Day: 1}
+	to := holiday.Date{Year: 2022, Month: time.September, Day: 30}
+	got := FindHolidaysInRange(from, to)
+	want := []holiday.Holiday{
+		{Date: "2022-09-19", Name: "敬老の日"},
+		{Date: "2022-09-23", Name: "秋分の日"},
+		{Date: "2022-09-27", Name: "故安倍晋三国葬儀", Kind: holiday.KindMourning},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FindHolidaysInRange() mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestCategory_String(t *testing.T) {
+	if got, want := CategoryMourning.String(), "mourning"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+	if got, want := Category(100).String(), "Category(100)"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
+
+func TestProvider_Mourning(t *testing.T) {
+	cal := holiday.NewCalendar(holiday.Japan, Provider(CategoryMourning))
+
+	// 2022-09-27 12:00 JST
+	day := time.Date(2022, time.September, 27, 3, 0, 0, 0, time.UTC)
+	h, ok := cal.FindHoliday(day)
+	if !ok || h.Kind != holiday.KindMourning {
+		t.Errorf("want a day of mourning, got %v, %t", h, ok)
+	}
+	if !cal.IsBusinessDay(day) {
+		t.Error("a day of mourning must be a business day")
+	}
+	if n, ok := cal.BusinessDayOfMonth(day); !ok || n != 17 {
+		t.Errorf("want the 17th business day, got %d, %t", n, ok)
+	}
+
+	// 2019-10-22 is a holiday established by a special law.
+	cal = holiday.NewCalendar(Provider())
+	if cal.IsBusinessDay(time.Date(2019, time.October, 22, 3, 0, 0, 0, time.UTC)) {
+		t.Error("即位礼正殿の儀 must not be a business day")
+	}
+}
@@ -0,0 +1,98 @@
+package holiday
+
+import "slices"
+
+type historicalName struct {
+	Name string
+
+	// the years when the name was used. Until is zero if it is still used.
+	Since, Until int
+}
+
+func (n historicalName) covers(year int) bool {
+	return year >= n.Since && (n.Until == 0 || year <= n.Until)
+}
+
+// lineages are the holidays that were renamed.
+var lineages = [][]historicalName{
+	// 昭和四十一年法律第八十六号, 平成三十年法律第五十七号
+	{
+		{Name: "体育の日", Since: 1966, Until: 2019},
+		// the name in syukujitsu.csv
+		{Name: "体育の日（スポーツの日）", Since: 2019, Until: 2019},
+		{Name: "スポーツの日", Since: 2020},
+	},
+	// April 29th. 平成元年法律第五号, 平成十七年法律第四十三号
+	{
+		{Name: "天皇誕生日", Since: 1949, Until: 1988},
+		{Name: "みどりの日", Since: 1989, Until: 2006},
+		{Name: "昭和の日", Since: 2007},
+	},
+}
+
+// Aliases returns the other names of the holiday, e.g. "スポーツの日" for "体育の日".
+func Aliases(name string) []string {
+	var result []string
+	for _, lineage := range lineages {
+		if !slices.ContainsFunc(lineage, func(n historicalName) bool { return EqualName(n.Name, name) }) {
+			continue
+		}
+		for _, n := range lineage {
+			if !EqualName(n.Name, name) && !slices.Contains(result, n.Name) {
+				result = append(result, n.Name)
+			}
+		}
+	}
+	return result
+}
+
+// CanonicalName returns the name of the holiday in the year.
+// For example, CanonicalName("体育の日", 2024) returns "スポーツの日", and CanonicalName("昭和の日", 2000) returns "みどりの日".
+// If name is used in the year, it is returned as is.
+// It returns false if the holiday does not exist in the year.
+func CanonicalName(name string, year int) (string, bool) {
+	if defaultRuleSet.hasHoliday(year, name) {
+		return name, true
+	}
+	for _, lineage := range lineages {
+		if !slices.ContainsFunc(lineage, func(n historicalName) bool { return EqualName(n.Name, name) }) {
+			continue
+		}
+		for _, n := range lineage {
+			if n.covers(year) {
+				return n.Name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// MatchName reports whether h is the holiday named name, or the holiday was once or later named name.
+// For example, both 体育の日 and スポーツの日 match "体育の日".
+// The names are compared after Unicode NFKC normalization, so full-width and half-width variants match.
+func MatchName(h Holiday, name string) bool {
+	if EqualName(h.Name, name) {
+		return true
+	}
+	year := mustParseDate(h.Date).Year()
+	for _, lineage := range lineages {
+		if !slices.ContainsFunc(lineage, func(n historicalName) bool { return EqualName(n.Name, name) }) {
+			continue
+		}
+		if slices.ContainsFunc(lineage, func(n historicalName) bool { return n.Name == h.Name && n.covers(year) }) {
+			return true
+		}
+	}
+	return false
+}
+
+// FindHolidaysByName returns the holidays between from and to (inclusive) that match the name or its aliases.
+func FindHolidaysByName(name string, from, to Date) []Holiday {
+	var result []Holiday
+	for _, h := range FindHolidaysInRange(from, to) {
+		if MatchName(h, name) {
+			result = append(result, h)
+		}
+	}
+	return result
+}
@@ -0,0 +1,83 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAliases(t *testing.T) {
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{"体育の日", []string{"体育の日（スポーツの日）", "スポーツの日"}},
+		{"昭和の日", []string{"天皇誕生日", "みどりの日"}},
+		{"元日", nil},
+	}
+	for _, tt := range tests {
+		if diff := cmp.Diff(tt.want, Aliases(tt.name)); diff != "" {
+			t.Errorf("Aliases(%q) mismatch (-want/+got):\n%s", tt.name, diff)
+		}
+	}
+}
+
+func TestCanonicalName(t *testing.T) {
+	tests := []struct {
+		name string
+		year int
+		want string
+		ok   bool
+	}{
+		{"体育の日", 2024, "スポーツの日", true},
+		{"スポーツの日", 2000, "体育の日", true},
+		{"スポーツの日", 1960, "", false},
+		{"昭和の日", 2000, "みどりの日", true},
+		{"昭和の日", 1980, "天皇誕生日", true},
+		// みどりの日 is May 4th since 2007.
+		{"みどりの日", 2024, "みどりの日", true},
+		// 天皇誕生日 is December 23rd in 2000.
+		{"天皇誕生日", 2000, "天皇誕生日", true},
+		{"元日", 2024, "元日", true},
+		{"存在しない日", 2024, "", false},
+	}
+	for _, tt := range tests {
+		got, ok := CanonicalName(tt.name, tt.year)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("CanonicalName(%q, %d): want (%q, %t), got (%q, %t)", tt.name, tt.year, tt.want, tt.ok, got, ok)
+		}
+	}
+}
+
+func TestFindHolidaysByName(t *testing.T) {
+	got := FindHolidaysByName("体育の日", Date{2018, time.January, 1}, Date{2021, time.December, 31})
+	want := []Holiday{
+		{Date: "2018-10-08", Name: "体育の日"},
+		{Date: "2019-10-14", Name: "体育の日（スポーツの日）"},
+		{Date: "2020-07-24", Name: "スポーツの日"},
+		{Date: "2021-07-23", Name: "スポーツの日"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FindHolidaysByName() mismatch (-want/+got):\n%s", diff)
+	}
+
+	// the half-width parentheses of the name in syukujitsu.csv
+	got = FindHolidaysByName("体育の日(スポーツの日)", Date{2019, time.January, 1}, Date{2020, time.December, 31})
+	want = []Holiday{
+		{Date: "2019-10-14", Name: "体育の日（スポーツの日）"},
+		{Date: "2020-07-24", Name: "スポーツの日"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FindHolidaysByName() mismatch (-want/+got):\n%s", diff)
+	}
+
+	got = FindHolidaysByName("昭和の日", Date{1988, time.April, 1}, Date{1989, time.April, 30})
+	want = []Holiday{
+		{Date: "1988-04-29", Name: "天皇誕生日"},
+		{Date: "1989-04-29", Name: "みどりの日"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FindHolidaysByName() mismatch (-want/+got):\n%s", diff)
+	}
+}
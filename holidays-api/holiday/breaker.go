@@ -0,0 +1,108 @@
+package holiday
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrUpdateSuspended is returned by Update while the fetches are suspended after repeated failures.
+var ErrUpdateSuspended = errors.New("holiday: the updates are suspended after repeated failures")
+
+// updateBreakerThreshold is the number of the consecutive failed fetches that open the circuit breaker.
+const updateBreakerThreshold = 3
+
+// updateCooldown is how long the circuit breaker stays open before it lets a trial fetch through.
+const updateCooldown = 30 * time.Minute
+
+// updateBreaker guards the fetches of Update. It is replaced in tests.
+var updateBreaker = newUpdateBreaker(nil)
+
+func newUpdateBreaker(clock Clock) *breaker {
+	return &breaker{
+		threshold: updateBreakerThreshold,
+		cooldown:  updateCooldown,
+		clock:     clock,
+	}
+}
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// breaker is a circuit breaker for the fetches of the source CSV,
+// so that an outage of the Cabinet Office is not hammered by the retries of every update.
+// It opens after threshold consecutive failures, and rejects the fetches for cooldown.
+// Then it is half-open: one trial fetch is let through,
+// and the breaker closes if the fetch succeeds, or opens again if it fails.
+type breaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	// clock provides the current time. If nil, SystemClock is used.
+	clock Clock
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+}
+
+func (b *breaker) now() time.Time {
+	if b.clock == nil {
+		return SystemClock.Now()
+	}
+	return b.clock.Now()
+}
+
+// allow reports whether a fetch may start.
+// The caller must report the result of the allowed fetch by done or abort.
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return false
+		}
+		b.state = breakerHalfOpen
+		return true
+	case breakerHalfOpen:
+		// the trial fetch is in progress.
+		return false
+	}
+	return true
+}
+
+// done records the result of a fetch.
+func (b *breaker) done(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err == nil {
+		b.state = breakerClosed
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.state == breakerHalfOpen || b.failures >= b.threshold {
+		b.state = breakerOpen
+		b.openedAt = b.now()
+	}
+}
+
+// abort records a fetch that is canceled by the caller, which is not a failure of the source.
+// A canceled trial fetch lets the next one through.
+func (b *breaker) abort() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == breakerHalfOpen {
+		b.state = breakerOpen
+	}
+}
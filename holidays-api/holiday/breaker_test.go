@@ -0,0 +1,157 @@
+package holiday
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeClock is a Clock that returns the time set by the test.
+type fakeClock struct {
+	now atomic.Pointer[time.Time]
+}
+
+func newFakeClock(t time.Time) *fakeClock {
+	c := &fakeClock{}
+	c.set(t)
+	return c
+}
+
+func (c *fakeClock) Now() time.Time {
+	return *c.now.Load()
+}
+
+func (c *fakeClock) set(t time.Time) {
+	c.now.Store(&t)
+}
+
+func TestBreaker(t *testing.T) {
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, JST)
+	clock := newFakeClock(start)
+	b := newUpdateBreaker(clock)
+	errFetch := errors.New("fetch failed")
+
+	// closed: the failures under the threshold keep it closed.
+	for i := 0; i < updateBreakerThreshold-1; i++ {
+		if !b.allow() {
+			t.Fatalf("#%d: want allowed, got rejected", i)
+		}
+		b.done(errFetch)
+	}
+	// a success resets the count.
+	if !b.allow() {
+		t.Fatal("want allowed, got rejected")
+	}
+	b.done(nil)
+
+	// open: the consecutive failures open it.
+	for i := 0; i < updateBreakerThreshold; i++ {
+		if !b.allow() {
+			t.Fatalf("#%d: want allowed, got rejected", i)
+		}
+		b.done(errFetch)
+	}
+	if b.allow() {
+		t.Fatal("want rejected while open, got allowed")
+	}
+	clock.set(start.Add(updateCooldown - time.Second))
+	if b.allow() {
+		t.Fatal("want rejected before the cooldown, got allowed")
+	}
+
+	// half-open: only one trial fetch is let through, and its failure opens it again.
+	clock.set(start.Add(updateCooldown))
+	if !b.allow() {
+		t.Fatal("want the trial fetch allowed, got rejected")
+	}
+	if b.allow() {
+		t.Fatal("want rejected during the trial fetch, got allowed")
+	}
+	b.done(errFetch)
+	if b.allow() {
+		t.Fatal("want rejected after the failed trial fetch, got allowed")
+	}
+
+	// a canceled trial fetch lets the next one through.
+	clock.set(start.Add(2 * updateCooldown))
+	if !b.allow() {
+		t.Fatal("want the trial fetch allowed, got rejected")
+	}
+	b.abort()
+	if !b.allow() {
+		t.Fatal("want the trial fetch allowed after the canceled one, got rejected")
+	}
+
+	// a successful trial fetch closes it.
+	b.done(nil)
+	if !b.allow() {
+		t.Fatal("want allowed after the successful trial fetch, got rejected")
+	}
+	b.done(errFetch)
+	if !b.allow() {
+		t.Fatal("want allowed after a single failure, got rejected")
+	}
+}
+
+func TestUpdate_Breaker(t *testing.T) {
+	rawData, err := os.ReadFile("syukujitsu.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, JST)
+	clock := newFakeClock(start)
+	updateBreaker = newUpdateBreaker(clock)
+
+	var reqs atomic.Int32
+	var healthy atomic.Bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqs.Add(1)
+		if !healthy.Load() {
+			// 404 is not retried, so each update makes a single request.
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(rawData)
+	}))
+	origURL := updateURL
+	updateURL = ts.URL
+	t.Cleanup(func() {
+		ts.Close()
+		updateURL = origURL
+		fetchedDataset.Store(nil)
+		previousDataset.Store(nil)
+		reloadDataset()
+		updateBreaker = newUpdateBreaker(nil)
+	})
+
+	ctx := context.Background()
+	for i := 0; i < updateBreakerThreshold; i++ {
+		if err := Update(ctx); err == nil || errors.Is(err, ErrUpdateSuspended) {
+			t.Fatalf("#%d: want the fetch error, got %v", i, err)
+		}
+	}
+	if err := Update(ctx); !errors.Is(err, ErrUpdateSuspended) {
+		t.Errorf("want ErrUpdateSuspended, got %v", err)
+	}
+	if got := reqs.Load(); got != updateBreakerThreshold {
+		t.Errorf("want %d requests, got %d", updateBreakerThreshold, got)
+	}
+
+	// the source recovers after the cooldown.
+	healthy.Store(true)
+	clock.set(start.Add(updateCooldown))
+	if err := Update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := Update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := reqs.Load(); got != updateBreakerThreshold+2 {
+		t.Errorf("want %d requests, got %d", updateBreakerThreshold+2, got)
+	}
+}
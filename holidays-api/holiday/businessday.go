@@ -0,0 +1,88 @@
+package holiday
+
+import "time"
+
+// FindHolidayAt returns the holiday on the day of t in JST.
+func FindHolidayAt(t time.Time) (Holiday, bool) {
+	return defaultCalendar.FindHoliday(t)
+}
+
+// IsHoliday reports whether the day of t in JST is a holiday.
+func IsHoliday(t time.Time) bool {
+	return defaultCalendar.IsHoliday(t)
+}
+
+// IsBusinessDay reports whether the day of t in JST is neither a weekend nor a holiday.
+func IsBusinessDay(t time.Time) bool {
+	return defaultCalendar.IsBusinessDay(t)
+}
+
+// NextBusinessDay returns the first business day after t.
+// The clock time of t in JST is kept.
+func NextBusinessDay(t time.Time) time.Time {
+	next, _ := defaultCalendar.NextBusinessDay(t)
+	return next
+}
+
+// PreviousBusinessDay returns the last business day before t.
+// The clock time of t in JST is kept.
+func PreviousBusinessDay(t time.Time) time.Time {
+	prev, _ := defaultCalendar.PreviousBusinessDay(t)
+	return prev
+}
+
+// NextHoliday returns the first holiday after the day of t in JST.
+func NextHoliday(t time.Time) (Holiday, bool) {
+	return defaultCalendar.NextHoliday(t)
+}
+
+// UntilNextHoliday returns the duration until 00:00 JST of the next holiday after t.
+func UntilNextHoliday(t time.Time) time.Duration {
+	return defaultCalendar.UntilNextHoliday(t)
+}
+
+// DaysUntilNextHoliday returns the number of days from the day of t in JST until the next holiday, and the holiday.
+// For example, it returns 4 and 憲法記念日 on 2025-04-29.
+func DaysUntilNextHoliday(t time.Time) (int, Holiday) {
+	n, h, _ := defaultCalendar.DaysUntilNextHoliday(t)
+	return n, h
+}
+
+// DaysUntilNextBusinessDay returns the number of days from the day of t in JST until the next business day,
+// and 00:00 JST of the day.
+// For example, it returns 4 and 2025-05-07 on 2025-05-03.
+func DaysUntilNextBusinessDay(t time.Time) (int, time.Time) {
+	n, next, _ := defaultCalendar.DaysUntilNextBusinessDay(t)
+	return n, next
+}
+
+// NthBusinessDayOfMonth returns 00:00 JST of the n-th business day of the month (n starts at 1).
+// For example, NthBusinessDayOfMonth(2024, time.May, 3) returns May 8th, 2024.
+// It returns false if the month has less than n business days.
+func NthBusinessDayOfMonth(year int, month time.Month, n int) (time.Time, bool) {
+	return defaultCalendar.NthBusinessDayOfMonth(year, month, n)
+}
+
+// BusinessDayOfMonth returns which business day of the month the day of t in JST is, starting at 1.
+// It returns false if the day of t is not a business day.
+func BusinessDayOfMonth(t time.Time) (int, bool) {
+	return defaultCalendar.BusinessDayOfMonth(t)
+}
+
+// FirstBusinessDayOfMonth returns 00:00 JST of the first business day of the month.
+func FirstBusinessDayOfMonth(year int, month time.Month) time.Time {
+	d, _ := defaultCalendar.FirstBusinessDayOfMonth(year, month)
+	return d
+}
+
+// LastBusinessDayOfMonth returns 00:00 JST of the last business day of the month.
+// It is often used as the settlement date.
+func LastBusinessDayOfMonth(year int, month time.Month) time.Time {
+	d, _ := defaultCalendar.LastBusinessDayOfMonth(year, month)
+	return d
+}
+
+// dateOf returns the date of t.
+func dateOf(t time.Time) Date {
+	return Date{t.Year(), t.Month(), t.Day()}
+}
@@ -0,0 +1,200 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsBusinessDay(t *testing.T) {
+	tests := []struct {
+		date time.Time
+		want bool
+	}{
+		{time.Date(2024, time.August, 9, 0, 0, 0, 0, JST), true},   // Fri
+		{time.Date(2024, time.August, 10, 0, 0, 0, 0, JST), false}, // Sat
+		{time.Date(2024, time.August, 11, 0, 0, 0, 0, JST), false}, // Sun, 山の日
+		{time.Date(2024, time.August, 12, 0, 0, 0, 0, JST), false}, // Mon, 休日
+		{time.Date(2024, time.August, 13, 0, 0, 0, 0, JST), true},  // Tue
+	}
+	for _, tt := range tests {
+		if got := IsBusinessDay(tt.date); got != tt.want {
+			t.Errorf("IsBusinessDay(%s): want %t, got %t", tt.date, tt.want, got)
+		}
+	}
+}
+
+func TestNextBusinessDay(t *testing.T) {
+	got := NextBusinessDay(time.Date(2024, time.August, 9, 9, 30, 0, 0, JST))
+	want := time.Date(2024, time.August, 13, 9, 30, 0, 0, JST)
+	if !got.Equal(want) {
+		t.Errorf("want %s, got %s", want, got)
+	}
+}
+
+func TestPreviousBusinessDay(t *testing.T) {
+	got := PreviousBusinessDay(time.Date(2024, time.August, 13, 9, 30, 0, 0, JST))
+	want := time.Date(2024, time.August, 9, 9, 30, 0, 0, JST)
+	if !got.Equal(want) {
+		t.Errorf("want %s, got %s", want, got)
+	}
+}
+
+func TestNextHoliday(t *testing.T) {
+	h, ok := NextHoliday(time.Date(2024, time.December, 31, 12, 0, 0, 0, JST))
+	if !ok {
+		t.Fatal("want true, but got false")
+	}
+	if got, want := h.Date, "2025-01-01"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+
+	// the day of t is excluded
+	h, ok = NextHoliday(time.Date(2025, time.January, 1, 0, 0, 0, 0, JST))
+	if !ok {
+		t.Fatal("want true, but got false")
+	}
+	if got, want := h.Date, "2025-01-13"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
+
+func TestUntilNextHoliday(t *testing.T) {
+	got := UntilNextHoliday(time.Date(2024, time.December, 31, 12, 0, 0, 0, JST))
+	if want := 12 * time.Hour; got != want {
+		t.Errorf("want %s, got %s", want, got)
+	}
+}
+
+func TestDaysUntilNextHoliday(t *testing.T) {
+	tests := []struct {
+		t    time.Time
+		days int
+		want string
+	}{
+		{time.Date(2025, time.April, 29, 23, 0, 0, 0, JST), 4, "2025-05-03 憲法記念日"},
+		{time.Date(2025, time.May, 5, 0, 0, 0, 0, JST), 1, "2025-05-06 休日"},
+		{time.Date(2024, time.December, 31, 12, 0, 0, 0, JST), 1, "2025-01-01 元日"},
+		// 2025-04-28 15:00 UTC is 2025-04-29 00:00 JST
+		{time.Date(2025, time.April, 28, 15, 0, 0, 0, time.UTC), 4, "2025-05-03 憲法記念日"},
+	}
+	for _, tt := range tests {
+		days, h := DaysUntilNextHoliday(tt.t)
+		if days != tt.days || h.String() != tt.want {
+			t.Errorf("DaysUntilNextHoliday(%s): want (%d, %s), got (%d, %s)", tt.t, tt.days, tt.want, days, h)
+		}
+	}
+}
+
+func TestDaysUntilNextBusinessDay(t *testing.T) {
+	tests := []struct {
+		t    time.Time
+		days int
+		want time.Time
+	}{
+		// Friday to Monday
+		{time.Date(2025, time.April, 25, 18, 0, 0, 0, JST), 3, time.Date(2025, time.April, 28, 0, 0, 0, 0, JST)},
+		// Golden Week
+		{time.Date(2025, time.May, 2, 9, 0, 0, 0, JST), 5, time.Date(2025, time.May, 7, 0, 0, 0, 0, JST)},
+		{time.Date(2025, time.May, 3, 9, 0, 0, 0, JST), 4, time.Date(2025, time.May, 7, 0, 0, 0, 0, JST)},
+		// weekdays
+		{time.Date(2025, time.May, 7, 9, 0, 0, 0, JST), 1, time.Date(2025, time.May, 8, 0, 0, 0, 0, JST)},
+	}
+	for _, tt := range tests {
+		days, got := DaysUntilNextBusinessDay(tt.t)
+		if days != tt.days || !got.Equal(tt.want) {
+			t.Errorf("DaysUntilNextBusinessDay(%s): want (%d, %s), got (%d, %s)", tt.t, tt.days, tt.want, days, got)
+		}
+	}
+}
+
+func TestNthBusinessDayOfMonth(t *testing.T) {
+	tests := []struct {
+		year  int
+		month time.Month
+		n     int
+		want  time.Time
+		ok    bool
+	}{
+		// 2024-05-01 Wed, 05-02 Thu, 05-03〜05-06 Golden Week, 05-07 Tue
+		{2024, time.May, 1, time.Date(2024, time.May, 1, 0, 0, 0, 0, JST), true},
+		{2024, time.May, 3, time.Date(2024, time.May, 7, 0, 0, 0, 0, JST), true},
+		{2024, time.May, 21, time.Date(2024, time.May, 31, 0, 0, 0, 0, JST), true},
+		{2024, time.May, 22, time.Time{}, false},
+		{2024, time.May, 0, time.Time{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := NthBusinessDayOfMonth(tt.year, tt.month, tt.n)
+		if !got.Equal(tt.want) || ok != tt.ok {
+			t.Errorf("NthBusinessDayOfMonth(%d, %s, %d): want (%s, %t), got (%s, %t)", tt.year, tt.month, tt.n, tt.want, tt.ok, got, ok)
+		}
+	}
+}
+
+func TestBusinessDayOfMonth(t *testing.T) {
+	tests := []struct {
+		date time.Time
+		want int
+		ok   bool
+	}{
+		{time.Date(2024, time.May, 7, 15, 0, 0, 0, JST), 3, true},
+		{time.Date(2024, time.May, 6, 16, 0, 0, 0, time.UTC), 3, true}, // 2024-05-07 01:00 JST
+		{time.Date(2024, time.May, 6, 0, 0, 0, 0, JST), 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := BusinessDayOfMonth(tt.date)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("BusinessDayOfMonth(%s): want (%d, %t), got (%d, %t)", tt.date, tt.want, tt.ok, got, ok)
+		}
+	}
+
+	for n := 1; ; n++ {
+		d, ok := NthBusinessDayOfMonth(2024, time.December, n)
+		if !ok {
+			break
+		}
+		if got, ok := BusinessDayOfMonth(d); got != n || !ok {
+			t.Errorf("BusinessDayOfMonth(%s): want (%d, true), got (%d, %t)", d, n, got, ok)
+		}
+	}
+}
+
+func TestFirstBusinessDayOfMonth(t *testing.T) {
+	tests := []struct {
+		year  int
+		month time.Month
+		want  time.Time
+	}{
+		// 2024-01-01 元日, 2024-01-02 Tue
+		{2024, time.January, time.Date(2024, time.January, 2, 0, 0, 0, 0, JST)},
+		// 2024-06-01 Sat, 06-02 Sun
+		{2024, time.June, time.Date(2024, time.June, 3, 0, 0, 0, 0, JST)},
+		{2024, time.July, time.Date(2024, time.July, 1, 0, 0, 0, 0, JST)},
+	}
+	for _, tt := range tests {
+		if got := FirstBusinessDayOfMonth(tt.year, tt.month); !got.Equal(tt.want) {
+			t.Errorf("FirstBusinessDayOfMonth(%d, %s): want %s, got %s", tt.year, tt.month, tt.want, got)
+		}
+	}
+}
+
+func TestLastBusinessDayOfMonth(t *testing.T) {
+	tests := []struct {
+		year  int
+		month time.Month
+		want  time.Time
+	}{
+		// 2024-03-31 Sun, 03-30 Sat
+		{2024, time.March, time.Date(2024, time.March, 29, 0, 0, 0, 0, JST)},
+		// 2024-12-31 Tue
+		{2024, time.December, time.Date(2024, time.December, 31, 0, 0, 0, 0, JST)},
+		// 2021-09-30 Thu
+		{2021, time.September, time.Date(2021, time.September, 30, 0, 0, 0, 0, JST)},
+		// 2019-04-30 休日, 04-29 昭和の日, 04-27 Sat, 04-28 Sun
+		{2019, time.April, time.Date(2019, time.April, 26, 0, 0, 0, 0, JST)},
+	}
+	for _, tt := range tests {
+		if got := LastBusinessDayOfMonth(tt.year, tt.month); !got.Equal(tt.want) {
+			t.Errorf("LastBusinessDayOfMonth(%d, %s): want %s, got %s", tt.year, tt.month, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package holiday
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// yearCacheSize is the max number of years in yearCache.
+// It covers scanning a century or so.
+const yearCacheSize = 128
+
+// yearCache is a bounded cache of the holidays calculated for each year.
+// The least recently used year is evicted when the cache is full.
+// It is safe for concurrent use by multiple goroutines.
+type yearCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[int][]Holiday
+
+	// order is the years in the cache, from the least recently used.
+	order []int
+}
+
+func newYearCache(size int) *yearCache {
+	return &yearCache{
+		size:    size,
+		entries: make(map[int][]Holiday, size),
+	}
+}
+
+// get returns the holidays in the year, calling calc if the year is not in the cache.
+// The caller must not modify the returned slice.
+func (c *yearCache) get(year int, calc func(year int) []Holiday) []Holiday {
+	c.mu.Lock()
+	if holidays, ok := c.entries[year]; ok {
+		c.touch(year)
+		c.mu.Unlock()
+		return holidays
+	}
+	c.mu.Unlock()
+
+	// calculate without the lock, because it is slow.
+	holidays := calc(year)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[year]; ok {
+		// another goroutine has calculated it.
+		c.touch(year)
+		return c.entries[year]
+	}
+	if len(c.order) >= c.size {
+		delete(c.entries, c.order[0])
+		c.order = slices.Delete(c.order, 0, 1)
+	}
+	c.entries[year] = holidays
+	c.order = append(c.order, year)
+	return holidays
+}
+
+// touch marks the year as the most recently used.
+func (c *yearCache) touch(year int) {
+	i := slices.Index(c.order, year)
+	c.order = append(slices.Delete(c.order, i, i+1), year)
+}
+
+// defaultYearCache caches the holidays of defaultRuleSet.
+var defaultYearCache = newYearCache(yearCacheSize)
+
+func cachedHolidaysInYear(year int) []Holiday {
+	return defaultYearCache.get(year, defaultRuleSet.calcHolidaysInYear)
+}
+
+func calcHolidaysInMonth(year int, month time.Month) []Holiday {
+	prefix := fmt.Sprintf("%04d-%02d-", year, int(month))
+	var result []Holiday
+	for _, h := range cachedHolidaysInYear(year) {
+		if strings.HasPrefix(h.Date, prefix) {
+			result = append(result, h)
+		}
+	}
+	return result
+}
+
+func calcHolidaysInYear(year int) []Holiday {
+	return slices.Clone(cachedHolidaysInYear(year))
+}
+
+func calcHolidaysInRange(from, to Date) []Holiday {
+	if from.Compare(to) > 0 {
+		from, to = to, from
+	}
+	startDate := from.String()
+	endDate := to.String()
+	var result []Holiday
+	for year := from.Year; year <= to.Year; year++ {
+		for _, h := range cachedHolidaysInYear(year) {
+			if startDate <= h.Date && h.Date <= endDate {
+				result = append(result, h)
+			}
+		}
+	}
+	return result
+}
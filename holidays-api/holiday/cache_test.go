@@ -0,0 +1,71 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCachedHolidays(t *testing.T) {
+	for year := 2050; year <= 2100; year++ {
+		want := defaultRuleSet.calcHolidaysInYear(year)
+		if diff := cmp.Diff(want, calcHolidaysInYear(year)); diff != "" {
+			t.Errorf("%d: calcHolidaysInYear mismatch (-want/+got):\n%s", year, diff)
+		}
+		for month := time.January; month <= time.December; month++ {
+			want := defaultRuleSet.calcHolidaysInMonth(year, month)
+			if diff := cmp.Diff(want, calcHolidaysInMonth(year, month)); diff != "" {
+				t.Errorf("%d-%02d: calcHolidaysInMonth mismatch (-want/+got):\n%s", year, month, diff)
+			}
+		}
+	}
+
+	from := Date{2049, time.December, 15}
+	to := Date{2052, time.May, 4}
+	if diff := cmp.Diff(defaultRuleSet.calcHolidaysInRange(from, to), calcHolidaysInRange(to, from)); diff != "" {
+		t.Errorf("calcHolidaysInRange mismatch (-want/+got):\n%s", diff)
+	}
+
+	// the result is owned by the caller.
+	holidays := calcHolidaysInYear(2060)
+	holidays[0].Name = "modified"
+	if calcHolidaysInYear(2060)[0].Name == "modified" {
+		t.Error("the cache is modified")
+	}
+}
+
+func TestYearCache_Evict(t *testing.T) {
+	var calls int
+	calc := func(year int) []Holiday {
+		calls++
+		return []Holiday{{Date: Date{year, time.January, 1}.String()}}
+	}
+	c := newYearCache(2)
+	c.get(2000, calc)
+	c.get(2001, calc)
+	c.get(2000, calc) // 2001 is the least recently used
+	c.get(2002, calc) // evicts 2001
+	if calls != 3 {
+		t.Errorf("want 3 calls, got %d", calls)
+	}
+	c.get(2000, calc)
+	if calls != 3 {
+		t.Errorf("want 3 calls, got %d", calls)
+	}
+	c.get(2001, calc)
+	if calls != 4 {
+		t.Errorf("want 4 calls, got %d", calls)
+	}
+	if len(c.entries) != 2 || len(c.order) != 2 {
+		t.Errorf("want 2 entries, got %d, %d", len(c.entries), len(c.order))
+	}
+}
+
+func BenchmarkFindHolidaysInYear_Calculated(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for year := 2050; year <= 2100; year++ {
+			FindHolidaysInYear(year)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package holiday
+
+import (
+	"slices"
+	"time"
+)
+
+// HolidayProvider provides the holidays of a country, or a user-defined calendar.
+// Implementations must be safe for concurrent use by multiple goroutines.
+type HolidayProvider interface {
+	// HolidaysInRange returns the holidays between from and to (inclusive), sorted by date.
+	// The caller must not modify the returned slice.
+	HolidaysInRange(from, to Date) []Holiday
+}
+
+// ProviderFunc is an adapter to allow the use of ordinary functions as HolidayProvider.
+type ProviderFunc func(from, to Date) []Holiday
+
+// HolidaysInRange calls f(from, to).
+func (f ProviderFunc) HolidaysInRange(from, to Date) []Holiday {
+	return f(from, to)
+}
+
+// Japan is the built-in provider of the holidays in Japan.
+var Japan HolidayProvider = ProviderFunc(FindHolidaysInRange)
+
+// Calendar combines holiday providers and provides business-day calculations on them.
+//
+// A Calendar is safe for concurrent use by multiple goroutines,
+// as long as its fields are not modified after it is first used.
+type Calendar struct {
+	// Providers are the sources of holidays.
+	// If some providers have a holiday on the same day, the earlier provider wins.
+	Providers []HolidayProvider
+
+	// Location is the time zone of the calendar.
+	// If nil, Asia/Tokyo is used.
+	Location *time.Location
+
+	// Weekend is the days of week that are not business days.
+	// If nil, Saturday and Sunday are used.
+	Weekend []time.Weekday
+
+	// Clock provides the current time for IsHolidayToday, IsBusinessDayToday and NextHolidayFromNow.
+	// If nil, SystemClock is used.
+	Clock Clock
+}
+
+// NewCalendar returns a new calendar with the providers.
+func NewCalendar(providers ...HolidayProvider) *Calendar {
+	return &Calendar{Providers: providers}
+}
+
+// defaultCalendar is the calendar of Japan used by the package-level functions.
+var defaultCalendar = NewCalendar(Japan)
+
+func (c *Calendar) location() *time.Location {
+	if c.Location == nil {
+		return JST
+	}
+	return c.Location
+}
+
+func (c *Calendar) now() time.Time {
+	if c.Clock == nil {
+		return SystemClock.Now()
+	}
+	return c.Clock.Now()
+}
+
+func (c *Calendar) isWeekend(w time.Weekday) bool {
+	if c.Weekend == nil {
+		return w == time.Saturday || w == time.Sunday
+	}
+	for _, v := range c.Weekend {
+		if v == w {
+			return true
+		}
+	}
+	return false
+}
+
+// HolidaysInRange returns the holidays of all providers between from and to (inclusive).
+func (c *Calendar) HolidaysInRange(from, to Date) []Holiday {
+	if from.Compare(to) > 0 {
+		from, to = to, from
+	}
+	if len(c.Providers) == 1 {
+		return slices.Clone(c.Providers[0].HolidaysInRange(from, to))
+	}
+
+	seen := map[string]bool{}
+	var result []Holiday
+	for _, p := range c.Providers {
+		for _, h := range p.HolidaysInRange(from, to) {
+			if seen[h.Date] {
+				continue
+			}
+			seen[h.Date] = true
+			result = append(result, h)
+		}
+	}
+	SortHolidays(result)
+	return result
+}
+
+// FindHoliday returns the holiday on the day of t in the calendar's location.
+func (c *Calendar) FindHoliday(t time.Time) (Holiday, bool) {
+	d := dateOf(t.In(c.location()))
+	holidays := c.HolidaysInRange(d, d)
+	if len(holidays) == 0 {
+		return Holiday{}, false
+	}
+	return holidays[0], true
+}
+
+// IsHoliday reports whether the day of t is a holiday.
+func (c *Calendar) IsHoliday(t time.Time) bool {
+	_, ok := c.FindHoliday(t)
+	return ok
+}
+
+// IsBusinessDay reports whether the day of t is neither a weekend nor a holiday.
+// The holidays that are not days off, i.e. KindMourning, don't make the day a non-business day.
+func (c *Calendar) IsBusinessDay(t time.Time) bool {
+	t = t.In(c.location())
+	if c.isWeekend(t.Weekday()) {
+		return false
+	}
+	h, ok := c.FindHoliday(t)
+	return !ok || !h.Kind.IsDayOff()
+}
+
+// maxBusinessDaySearch is the number of days searched for a business day.
+// It stops the search on a calendar without business days,
+// e.g. Weekend lists all days of week.
+const maxBusinessDaySearch = 3 * 366
+
+// NextBusinessDay returns the first business day after t.
+// The clock time of t in the calendar's location is kept.
+// It returns false if no business day is found within three years.
+func (c *Calendar) NextBusinessDay(t time.Time) (time.Time, bool) {
+	return c.nextBusinessDay(t, 1)
+}
+
+// PreviousBusinessDay returns the last business day before t.
+// The clock time of t in the calendar's location is kept.
+// It returns false if no business day is found within three years.
+func (c *Calendar) PreviousBusinessDay(t time.Time) (time.Time, bool) {
+	return c.nextBusinessDay(t, -1)
+}
+
+func (c *Calendar) nextBusinessDay(t time.Time, step int) (time.Time, bool) {
+	t = t.In(c.location())
+	for i := 0; i < maxBusinessDaySearch; i++ {
+		t = t.AddDate(0, 0, step)
+		if c.IsBusinessDay(t) {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// NextHoliday returns the first holiday after the day of t.
+func (c *Calendar) NextHoliday(t time.Time) (Holiday, bool) {
+	from := t.In(c.location()).AddDate(0, 0, 1)
+
+	// the gap between holidays is less than a year,
+	// but search a little more for safety.
+	for i := 0; i < 3; i++ {
+		to := from.AddDate(1, 0, 0)
+		holidays := c.HolidaysInRange(dateOf(from), dateOf(to))
+		if len(holidays) > 0 {
+			return holidays[0], true
+		}
+		from = to.AddDate(0, 0, 1)
+	}
+	return Holiday{}, false
+}
+
+// UntilNextHoliday returns the duration until 00:00 of the next holiday after t.
+func (c *Calendar) UntilNextHoliday(t time.Time) time.Duration {
+	h, ok := c.NextHoliday(t)
+	if !ok {
+		return 0
+	}
+	d := mustParseDate(h.Date)
+	begin := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, c.location())
+	return begin.Sub(t)
+}
+
+// DaysUntilNextHoliday returns the number of days from the day of t until the next holiday, and the holiday.
+// For example, it returns 4 and 憲法記念日 on 2025-04-29.
+// It returns false if no holiday is found.
+func (c *Calendar) DaysUntilNextHoliday(t time.Time) (int, Holiday, bool) {
+	h, ok := c.NextHoliday(t)
+	if !ok {
+		return 0, Holiday{}, false
+	}
+	d := mustParseDate(h.Date)
+	return daysBetween(dateOf(t.In(c.location())), dateOf(d)), h, true
+}
+
+// DaysUntilNextBusinessDay returns the number of days from the day of t until the next business day, and 00:00 of the day.
+// For example, it returns 3 and Monday on Friday if the weekend has no holidays.
+// It returns false if no business day is found.
+func (c *Calendar) DaysUntilNextBusinessDay(t time.Time) (int, time.Time, bool) {
+	t = t.In(c.location())
+	next, ok := c.NextBusinessDay(t)
+	if !ok {
+		return 0, time.Time{}, false
+	}
+	next = time.Date(next.Year(), next.Month(), next.Day(), 0, 0, 0, 0, c.location())
+	return daysBetween(dateOf(t), dateOf(next)), next, true
+}
+
+// daysBetween returns the number of days from a to b.
+func daysBetween(a, b Date) int {
+	ta := time.Date(a.Year, a.Month, a.Day, 0, 0, 0, 0, time.UTC)
+	tb := time.Date(b.Year, b.Month, b.Day, 0, 0, 0, 0, time.UTC)
+	return int(tb.Sub(ta) / (24 * time.Hour))
+}
+
+// NthBusinessDayOfMonth returns 00:00 of the n-th business day of the month (n starts at 1).
+// It returns false if the month has less than n business days.
+func (c *Calendar) NthBusinessDayOfMonth(year int, month time.Month, n int) (time.Time, bool) {
+	days := c.businessDaysInMonth(year, month)
+	if n < 1 || n > len(days) {
+		return time.Time{}, false
+	}
+	return days[n-1], true
+}
+
+// BusinessDayOfMonth returns which business day of the month the day of t is, starting at 1.
+// It is the inverse of NthBusinessDayOfMonth.
+// It returns false if the day of t is not a business day.
+func (c *Calendar) BusinessDayOfMonth(t time.Time) (int, bool) {
+	t = t.In(c.location())
+	for i, d := range c.businessDaysInMonth(t.Year(), t.Month()) {
+		if d.Day() == t.Day() {
+			return i + 1, true
+		}
+	}
+	return 0, false
+}
+
+// FirstBusinessDayOfMonth returns 00:00 of the first business day of the month.
+// It returns false if the month has no business days.
+func (c *Calendar) FirstBusinessDayOfMonth(year int, month time.Month) (time.Time, bool) {
+	return c.NthBusinessDayOfMonth(year, month, 1)
+}
+
+// LastBusinessDayOfMonth returns 00:00 of the last business day of the month.
+// It returns false if the month has no business days.
+func (c *Calendar) LastBusinessDayOfMonth(year int, month time.Month) (time.Time, bool) {
+	days := c.businessDaysInMonth(year, month)
+	if len(days) == 0 {
+		return time.Time{}, false
+	}
+	return days[len(days)-1], true
+}
+
+// businessDaysInMonth returns 00:00 of the business days in the month.
+func (c *Calendar) businessDaysInMonth(year int, month time.Month) []time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, c.location())
+	last := first.AddDate(0, 1, -1)
+	holidays := c.HolidaysInRange(dateOf(first), dateOf(last))
+
+	var days []time.Time
+	for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+		if c.isWeekend(d.Weekday()) {
+			continue
+		}
+		date := dateOf(d).String()
+		if slices.ContainsFunc(holidays, func(h Holiday) bool { return h.Date == date && h.Kind.IsDayOff() }) {
+			continue
+		}
+		days = append(days, d)
+	}
+	return days
+}
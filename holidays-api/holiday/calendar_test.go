@@ -0,0 +1,110 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCalendar_HolidaysInRange(t *testing.T) {
+	company := ProviderFunc(func(from, to Date) []Holiday {
+		var result []Holiday
+		for _, h := range []Holiday{
+			{Date: "2024-08-11", Name: "夏季休暇", Kind: KindCustom},
+			{Date: "2024-08-13", Name: "夏季休暇", Kind: KindCustom},
+		} {
+			if from.String() <= h.Date && h.Date <= to.String() {
+				result = append(result, h)
+			}
+		}
+		return result
+	})
+	c := NewCalendar(Japan, company)
+
+	got := c.HolidaysInRange(Date{2024, time.August, 1}, Date{2024, time.August, 31})
+	want := []Holiday{
+		{Date: "2024-08-11", Name: "山の日"},
+		{Date: "2024-08-12", Name: "休日", Kind: KindSubstitute},
+		{Date: "2024-08-13", Name: "夏季休暇", Kind: KindCustom},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("HolidaysInRange() mismatch (-want/+got):\n%s", diff)
+	}
+
+	if c.IsBusinessDay(time.Date(2024, time.August, 13, 0, 0, 0, 0, JST)) {
+		t.Error("2024-08-13 must not be a business day")
+	}
+	next, ok := c.NextBusinessDay(time.Date(2024, time.August, 9, 9, 30, 0, 0, JST))
+	if want := time.Date(2024, time.August, 14, 9, 30, 0, 0, JST); !ok || !next.Equal(want) {
+		t.Errorf("NextBusinessDay: want %s, got %s", want, next)
+	}
+}
+
+func TestCalendar_LocationAndWeekend(t *testing.T) {
+	newYear := ProviderFunc(func(from, to Date) []Holiday {
+		var result []Holiday
+		for year := from.Year; year <= to.Year; year++ {
+			d := Date{year, time.January, 1}
+			if from.Compare(d) <= 0 && d.Compare(to) <= 0 {
+				result = append(result, Holiday{Date: d.String(), Name: "New Year's Day"})
+			}
+		}
+		return result
+	})
+	c := &Calendar{
+		Providers: []HolidayProvider{newYear},
+		Location:  time.UTC,
+		Weekend:   []time.Weekday{time.Friday, time.Saturday},
+	}
+
+	// 2024-01-01 00:00 UTC is 09:00 JST, but the calendar works in UTC.
+	if !c.IsHoliday(time.Date(2023, time.December, 31, 20, 0, 0, 0, time.FixedZone("", -5*60*60))) {
+		t.Error("2024-01-01 UTC must be a holiday")
+	}
+	// 2024-01-05 is Friday.
+	if c.IsBusinessDay(time.Date(2024, time.January, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Friday must be a weekend")
+	}
+	if !c.IsBusinessDay(time.Date(2024, time.January, 7, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Sunday must be a business day")
+	}
+
+	got := c.UntilNextHoliday(time.Date(2024, time.December, 31, 12, 0, 0, 0, time.UTC))
+	if want := 12 * time.Hour; got != want {
+		t.Errorf("UntilNextHoliday: want %s, got %s", want, got)
+	}
+}
+
+func TestCalendar_NoBusinessDays(t *testing.T) {
+	everyDay := ProviderFunc(func(from, to Date) []Holiday {
+		var result []Holiday
+		last := time.Date(to.Year, to.Month, to.Day, 0, 0, 0, 0, JST)
+		for d := time.Date(from.Year, from.Month, from.Day, 0, 0, 0, 0, JST); !d.After(last); d = d.AddDate(0, 0, 1) {
+			result = append(result, Holiday{Date: dateOf(d).String(), Name: "休業日", Kind: KindCustom})
+		}
+		return result
+	})
+	tests := map[string]*Calendar{
+		"weekend": {
+			Providers: []HolidayProvider{Japan},
+			Weekend:   []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday},
+		},
+		"provider": NewCalendar(everyDay),
+	}
+	day := time.Date(2024, time.August, 9, 0, 0, 0, 0, JST)
+	for name, c := range tests {
+		if _, ok := c.NextBusinessDay(day); ok {
+			t.Errorf("%s: NextBusinessDay must return false", name)
+		}
+		if _, ok := c.PreviousBusinessDay(day); ok {
+			t.Errorf("%s: PreviousBusinessDay must return false", name)
+		}
+		if _, _, ok := c.DaysUntilNextBusinessDay(day); ok {
+			t.Errorf("%s: DaysUntilNextBusinessDay must return false", name)
+		}
+		if _, ok := SettlementDate(day, 2, c); ok {
+			t.Errorf("%s: SettlementDate must return false", name)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package holiday
+
+import "time"
+
+// Clock provides the current time.
+// Implementations must be safe for concurrent use by multiple goroutines.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc is an adapter to allow the use of ordinary functions as Clock.
+type ClockFunc func() time.Time
+
+// Now calls f().
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// SystemClock is the clock that returns time.Now in JST.
+var SystemClock Clock = ClockFunc(func() time.Time {
+	return time.Now().In(JST)
+})
+
+// IsHolidayToday reports whether today is a holiday on the calendar.
+func (c *Calendar) IsHolidayToday() bool {
+	return c.IsHoliday(c.now())
+}
+
+// IsBusinessDayToday reports whether today is a business day on the calendar.
+func (c *Calendar) IsBusinessDayToday() bool {
+	return c.IsBusinessDay(c.now())
+}
+
+// NextHolidayFromNow returns the first holiday after today on the calendar.
+func (c *Calendar) NextHolidayFromNow() (Holiday, bool) {
+	return c.NextHoliday(c.now())
+}
+
+// IsHolidayToday reports whether today in JST is a holiday.
+func IsHolidayToday() bool {
+	return defaultCalendar.IsHolidayToday()
+}
+
+// IsBusinessDayToday reports whether today in JST is a business day.
+func IsBusinessDayToday() bool {
+	return defaultCalendar.IsBusinessDayToday()
+}
+
+// NextHolidayFromNow returns the first holiday after today in JST.
+func NextHolidayFromNow() (Holiday, bool) {
+	return defaultCalendar.NextHolidayFromNow()
+}
@@ -0,0 +1,45 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func fixedClock(t time.Time) Clock {
+	return ClockFunc(func() time.Time { return t })
+}
+
+func TestCalendar_Today(t *testing.T) {
+	tests := []struct {
+		now         time.Time
+		holiday     bool
+		businessDay bool
+		next        string
+	}{
+		// 2024-08-11 00:30 JST, 山の日
+		{time.Date(2024, time.August, 10, 15, 30, 0, 0, time.UTC), true, false, "2024-08-12"},
+		// 2024-08-09 23:59 JST, Fri
+		{time.Date(2024, time.August, 9, 23, 59, 0, 0, JST), false, true, "2024-08-11"},
+		// 2024-08-10 Sat
+		{time.Date(2024, time.August, 10, 12, 0, 0, 0, JST), false, false, "2024-08-11"},
+	}
+	for _, tt := range tests {
+		c := NewCalendar(Japan)
+		c.Clock = fixedClock(tt.now)
+		if got := c.IsHolidayToday(); got != tt.holiday {
+			t.Errorf("%s: IsHolidayToday: want %t, got %t", tt.now, tt.holiday, got)
+		}
+		if got := c.IsBusinessDayToday(); got != tt.businessDay {
+			t.Errorf("%s: IsBusinessDayToday: want %t, got %t", tt.now, tt.businessDay, got)
+		}
+		if h, ok := c.NextHolidayFromNow(); !ok || h.Date != tt.next {
+			t.Errorf("%s: NextHolidayFromNow: want %s, got %v, %t", tt.now, tt.next, h, ok)
+		}
+	}
+}
+
+func TestNextHolidayFromNow(t *testing.T) {
+	if _, ok := NextHolidayFromNow(); !ok {
+		t.Error("NextHolidayFromNow: no holiday found")
+	}
+}
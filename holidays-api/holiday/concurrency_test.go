@@ -0,0 +1,64 @@
+package holiday
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// run this test with -race to detect data races.
+func TestConcurrentQueries(t *testing.T) {
+	want := make(map[int][]Holiday)
+	for year := 1990; year <= 2040; year++ {
+		want[year] = FindHolidaysInYear(year)
+	}
+
+	c := NewCalendar(Japan, ProviderFunc(func(from, to Date) []Holiday {
+		var result []Holiday
+		for year := from.Year; year <= to.Year; year++ {
+			d := Date{year, time.January, 2}
+			if from.Compare(d) <= 0 && d.Compare(to) <= 0 {
+				result = append(result, Holiday{Date: d.String(), Name: "custom", Kind: KindCustom})
+			}
+		}
+		return result
+	}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for year := 1990; year <= 2040; year++ {
+				got := FindHolidaysInYear(year)
+				if diff := cmp.Diff(want[year], got); diff != "" {
+					t.Errorf("FindHolidaysInYear(%d) mismatch (-want/+got):\n%s", year, diff)
+					return
+				}
+
+				// the results are owned by the caller.
+				got = append(got, Holiday{Date: "9999-12-31", Name: "dummy"})
+				got[0].Name = "dummy"
+
+				FindHolidaysInMonth(year, time.Month(i%12+1))
+				FindHolidaysInRange(Date{year, time.April, 1}, Date{year + 1, time.March, 31})
+				FindHoliday(year, time.January, 1)
+
+				d := time.Date(year, time.Month(i%12+1), 1, 0, 0, 0, 0, JST)
+				IsBusinessDay(d)
+				NextHoliday(d)
+				c.NextBusinessDay(d)
+				c.HolidaysInRange(Date{year, time.January, 1}, Date{year, time.December, 31})
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for year := 1990; year <= 2040; year++ {
+		if diff := cmp.Diff(want[year], FindHolidaysInYear(year)); diff != "" {
+			t.Errorf("FindHolidaysInYear(%d) is changed by callers (-want/+got):\n%s", year, diff)
+		}
+	}
+}
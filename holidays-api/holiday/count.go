@@ -0,0 +1,120 @@
+package holiday
+
+import (
+	"sort"
+	"time"
+)
+
+// yearCount is the number of holidays in a year.
+type yearCount struct {
+	holidays int
+
+	// weekdayHolidays is the number of holidays on Monday to Friday.
+	weekdayHolidays int
+}
+
+// CountHolidays returns the number of holidays between from and to (inclusive).
+func CountHolidays(from, to Date) int {
+	var n int
+	countInRange(from, to, func(c yearCount) {
+		n += c.holidays
+	})
+	return n
+}
+
+// CountBusinessDays returns the number of business days between from and to (inclusive).
+// Business days are the days that are neither weekends nor holidays.
+func CountBusinessDays(from, to Date) int {
+	if from.Compare(to) > 0 {
+		from, to = to, from
+	}
+	var holidays int
+	countInRange(from, to, func(c yearCount) {
+		holidays += c.weekdayHolidays
+	})
+	return countWeekdays(from, to) - holidays
+}
+
+// countInRange calls fn with the counts of each year in the range.
+// The counts of whole years are cached.
+func countInRange(from, to Date, fn func(c yearCount)) {
+	if from.Compare(to) > 0 {
+		from, to = to, from
+	}
+	for year := from.Year; year <= to.Year; year++ {
+		first := Date{year, time.January, 1}
+		last := Date{year, time.December, 31}
+		if year == from.Year {
+			first = from
+		}
+		if year == to.Year {
+			last = to
+		}
+
+		if first.Month == time.January && first.Day == 1 && last.Month == time.December && last.Day == 31 {
+			fn(countInYear(year))
+		} else {
+			fn(countHolidays(currentDataset(), first, last))
+		}
+	}
+}
+
+func countInYear(year int) yearCount {
+	// the counts are cached in the dataset, because they change when the dataset is updated.
+	ds := currentDataset()
+	if v, ok := ds.counts.Load(year); ok {
+		return v.(yearCount)
+	}
+	c := countHolidays(ds, Date{year, time.January, 1}, Date{year, time.December, 31})
+	ds.counts.Store(year, c)
+	return c
+}
+
+// countHolidays counts the holidays between from and to (inclusive) in the same year.
+func countHolidays(ds *dataset, from, to Date) yearCount {
+	var c yearCount
+	count := func(h Holiday) {
+		c.holidays++
+		switch mustParseDate(h.Date).Weekday() {
+		case time.Saturday, time.Sunday:
+		default:
+			c.weekdayHolidays++
+		}
+	}
+
+	if ds.covers(from.Year, to.Year) {
+		// count the pre-calculated holidays without copying them.
+		holidays := ds.holidays
+		startDate := from.String()
+		endDate := to.String()
+		start := sort.Search(len(holidays), func(i int) bool {
+			return holidays[i].Date >= startDate
+		})
+		for i := start; i < len(holidays) && holidays[i].Date <= endDate; i++ {
+			count(holidays[i])
+		}
+		return c
+	}
+
+	for _, h := range calcHolidaysInRange(from, to) {
+		count(h)
+	}
+	return c
+}
+
+// countWeekdays returns the number of Monday to Friday between from and to (inclusive).
+func countWeekdays(from, to Date) int {
+	start := time.Date(from.Year, from.Month, from.Day, 0, 0, 0, 0, time.UTC)
+	end := time.Date(to.Year, to.Month, to.Day, 0, 0, 0, 0, time.UTC)
+	days := int(end.Sub(start)/(24*time.Hour)) + 1
+
+	n := days / 7 * 5
+	w := start.Weekday()
+	for i := 0; i < days%7; i++ {
+		if w != time.Saturday && w != time.Sunday {
+			n++
+		}
+		w = (w + 1) % 7
+	}
+	return n
+}
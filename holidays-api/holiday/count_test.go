@@ -0,0 +1,76 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCountHolidays(t *testing.T) {
+	tests := []struct {
+		from, to Date
+		want     int
+	}{
+		{Date{2019, time.January, 1}, Date{2019, time.December, 31}, 22},
+		{Date{2024, time.August, 1}, Date{2024, time.August, 31}, 2},
+		{Date{2024, time.August, 31}, Date{2024, time.August, 1}, 2},
+		{Date{2024, time.August, 12}, Date{2024, time.August, 12}, 1},
+		{Date{2024, time.August, 13}, Date{2024, time.August, 13}, 0},
+	}
+	for _, tt := range tests {
+		if got := CountHolidays(tt.from, tt.to); got != tt.want {
+			t.Errorf("CountHolidays(%s, %s): want %d, got %d", tt.from, tt.to, tt.want, got)
+		}
+	}
+}
+
+func TestCountBusinessDays(t *testing.T) {
+	tests := []struct {
+		from, to Date
+		want     int
+	}{
+		// 2024-08-09 Fri, 08-10 Sat, 08-11 Sun 山の日, 08-12 Mon 休日, 08-13 Tue
+		{Date{2024, time.August, 9}, Date{2024, time.August, 13}, 2},
+		{Date{2024, time.August, 10}, Date{2024, time.August, 12}, 0},
+		{Date{2024, time.August, 13}, Date{2024, time.August, 9}, 2},
+	}
+	for _, tt := range tests {
+		if got := CountBusinessDays(tt.from, tt.to); got != tt.want {
+			t.Errorf("CountBusinessDays(%s, %s): want %d, got %d", tt.from, tt.to, tt.want, got)
+		}
+	}
+}
+
+// compare with the naive implementations across pre-calculated and calculated years.
+func TestCount_Naive(t *testing.T) {
+	ranges := [][2]Date{
+		{{2020, time.March, 15}, {2026, time.February, 3}},
+		{{1950, time.January, 1}, {1960, time.December, 31}},
+		{{2023, time.December, 30}, {2031, time.January, 2}},
+	}
+	for _, r := range ranges {
+		from, to := r[0], r[1]
+
+		if got, want := CountHolidays(from, to), len(FindHolidaysInRange(from, to)); got != want {
+			t.Errorf("CountHolidays(%s, %s): want %d, got %d", from, to, want, got)
+		}
+
+		var want int
+		end := time.Date(to.Year, to.Month, to.Day, 0, 0, 0, 0, JST)
+		for d := time.Date(from.Year, from.Month, from.Day, 0, 0, 0, 0, JST); !d.After(end); d = d.AddDate(0, 0, 1) {
+			if IsBusinessDay(d) {
+				want++
+			}
+		}
+		if got := CountBusinessDays(from, to); got != want {
+			t.Errorf("CountBusinessDays(%s, %s): want %d, got %d", from, to, want, got)
+		}
+	}
+}
+
+func BenchmarkCountBusinessDays(b *testing.B) {
+	from := Date{2000, time.January, 1}
+	to := Date{2030, time.December, 31}
+	for i := 0; i < b.N; i++ {
+		CountBusinessDays(from, to)
+	}
+}
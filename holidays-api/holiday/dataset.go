@@ -0,0 +1,205 @@
+package holiday
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+// dataset is the pre-calculated holidays and their metadata.
+// It is generated from syukujitsu.csv by the updater,
+// or parsed from the embedded CSV if the package is built with the holidays_csv tag.
+type dataset struct {
+	// holidays are sorted by date.
+	holidays []Holiday
+
+	// the year range of the holidays
+	startYear, endYear int
+
+	version     string
+	sourceURL   string
+	generatedAt string
+
+	// counts caches yearCount for each year.
+	counts sync.Map // map[int]yearCount
+}
+
+// loadedDataset replaces the built-in dataset if it is not nil.
+var loadedDataset atomic.Pointer[dataset]
+
+// currentDataset returns the dataset in use.
+func currentDataset() *dataset {
+	if ds := loadedDataset.Load(); ds != nil {
+		return ds
+	}
+	return builtinDataset()
+}
+
+// covers reports whether the years between from and to are in the dataset.
+func (ds *dataset) covers(from, to int) bool {
+	return ds.startYear <= from && to <= ds.endYear
+}
+
+// 内閣府ホーム  >  内閣府の政策  >  制度  >  国民の祝日について
+// https://www8.cao.go.jp/chosei/shukujitsu/gaiyou.html
+const syukujitsuURL = "https://www8.cao.go.jp/chosei/shukujitsu/syukujitsu.csv"
+
+// holidays established by special laws, e.g. 平成三十年法律第九十九号
+var specialHolidayNames = map[string]bool{
+	"結婚の儀":     true,
+	"大喪の礼":     true,
+	"即位礼正殿の儀":  true,
+	"休日（祝日扱い）": true,
+}
+
+// parseCSV parses syukujitsu.csv published by the Cabinet Office.
+// It is encoded in Shift_JIS or UTF-8, and the dates are formatted as 2006/1/2.
+func parseCSV(rawData []byte) (*dataset, error) {
+	data, err := decodeCSV(rawData)
+	if err != nil {
+		return nil, err
+	}
+	csvReader := csv.NewReader(bytes.NewReader(data))
+
+	// skip 国民の祝日・休日月日,国民の祝日・休日名称 line
+	if _, err := csvReader.Read(); err != nil {
+		return nil, fmt.Errorf("holiday: failed to read the header of the csv: %w", err)
+	}
+
+	var holidays []Holiday
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("holiday: failed to read the csv: %w", err)
+		}
+		if len(record) < 2 {
+			return nil, fmt.Errorf("holiday: unexpected record: %q", record)
+		}
+		d, err := ParseDate(record[0])
+		if err != nil {
+			return nil, err
+		}
+		holidays = append(holidays, Holiday{
+			Date: d.String(),
+			Name: NormalizeName(record[1]),
+		})
+	}
+	if len(holidays) == 0 {
+		return nil, errors.New("holiday: no holidays in the csv")
+	}
+	SortHolidays(holidays)
+
+	isHoliday := make(map[string]bool, len(holidays))
+	for _, h := range holidays {
+		isHoliday[h.Date] = true
+	}
+	for i := range holidays {
+		holidays[i].Kind = KindOf(holidays[i], isHoliday)
+	}
+
+	startYear, _ := strconv.Atoi(strings.Split(holidays[0].Date, "-")[0])
+	endYear, _ := strconv.Atoi(strings.Split(holidays[len(holidays)-1].Date, "-")[0])
+	sum := sha256.Sum256(rawData)
+	return &dataset{
+		holidays:  holidays,
+		startYear: startYear,
+		endYear:   endYear,
+		version:   hex.EncodeToString(sum[:8]),
+		sourceURL: syukujitsuURL,
+	}, nil
+}
+
+// validateHolidays reports an error if the holidays are not sorted by date, have duplicated dates,
+// or are out of the years between startYear and endYear (inclusive).
+func validateHolidays(holidays []Holiday, startYear, endYear int) error {
+	var prev string
+	for _, h := range holidays {
+		d, err := ParseDate(h.Date)
+		if err != nil {
+			return err
+		}
+		if d.String() != h.Date {
+			return fmt.Errorf("holiday: %s is not in the format of YYYY-MM-DD", h.Date)
+		}
+		if d.Year < startYear || d.Year > endYear {
+			return fmt.Errorf("holiday: %s is out of the range %d-%d", h, startYear, endYear)
+		}
+		if h.Date == prev {
+			return fmt.Errorf("holiday: %s is duplicated", h.Date)
+		}
+		if h.Date < prev {
+			return fmt.Errorf("holiday: %s is not sorted, it comes after %s", h.Date, prev)
+		}
+		if h.Name == "" {
+			return fmt.Errorf("holiday: the name of %s is empty", h.Date)
+		}
+		prev = h.Date
+	}
+	return nil
+}
+
+var utf8BOM = []byte("\xef\xbb\xbf")
+
+// decodeCSV converts the csv into UTF-8.
+// The Cabinet Office publishes it in Shift_JIS, but the encoding has been changed before.
+// So the data is treated as UTF-8 if it has a BOM or it is valid UTF-8, otherwise as Shift_JIS.
+func decodeCSV(rawData []byte) ([]byte, error) {
+	if data, ok := bytes.CutPrefix(rawData, utf8BOM); ok {
+		rawData = data
+	}
+	data := rawData
+	if !utf8.Valid(rawData) {
+		var err error
+		data, _, err = transform.Bytes(japanese.ShiftJIS.NewDecoder(), rawData)
+		if err != nil {
+			return nil, fmt.Errorf("holiday: failed to decode the csv: %w", err)
+		}
+	}
+
+	// the decoder replaces the invalid bytes with U+FFFD, which makes mojibake names.
+	if bytes.ContainsRune(data, utf8.RuneError) {
+		return nil, errors.New("holiday: the csv is neither UTF-8 nor Shift_JIS")
+	}
+	return data, nil
+}
+
+// KindOf returns the kind of the holiday listed in syukujitsu.csv.
+// isHoliday contains the dates of all holidays in the csv, formatted as 2006-01-02.
+// The updater uses it to generate the pre-calculated holidays, so both agree on the kinds.
+func KindOf(h Holiday, isHoliday map[string]bool) Kind {
+	if specialHolidayNames[h.Name] {
+		return KindSpecial
+	}
+	if h.Name != "休日" {
+		return KindNational
+	}
+
+	// "休日" is a substitute holiday (振替休日) if it follows a series of holidays starting on Sunday,
+	// otherwise it is a citizens' holiday (国民の休日).
+	d := mustParseDate(h.Date)
+	for {
+		d = d.AddDate(0, 0, -1)
+		if !isHoliday[d.Format(dateLayout)] {
+			return KindCitizens
+		}
+		if d.Weekday() == time.Sunday {
+			return KindSubstitute
+		}
+	}
+}
@@ -0,0 +1,28 @@
+//go:build holidays_csv
+
+package holiday
+
+import (
+	_ "embed"
+	"sync"
+)
+
+// syukujitsuCSV is a copy of syukujitsu.csv published by the Cabinet Office.
+// Replace the file and rebuild to update the holidays without regenerating the Go source.
+//
+//go:embed syukujitsu.csv
+var syukujitsuCSV []byte
+
+var csvDataset = sync.OnceValue(func() *dataset {
+	ds, err := parseCSV(syukujitsuCSV)
+	if err != nil {
+		panic(err)
+	}
+	return ds
+})
+
+// builtinDataset returns the holidays parsed from the embedded syukujitsu.csv.
+// The CSV is parsed at the first use.
+func builtinDataset() *dataset {
+	return csvDataset()
+}
@@ -0,0 +1,187 @@
+//go:build !holidays_min
+
+package holiday
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// The tests in this file depend on the historical holidays excluded by the holidays_min build.
+
+func TestFindHoliday(t *testing.T) {
+	h, ok := findHoliday(2000, time.January, 1)
+	if !ok {
+		t.Error("want true, but got false")
+	}
+	if got, want := h.Date, "2000-01-01"; want != got {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
+
+func TestFindHolidaysInMonth(t *testing.T) {
+	got := findHolidaysInMonth(2000, time.January)
+	want := []Holiday{
+		{
+			Date: "2000-01-01",
+			Name: "元日",
+		},
+		{
+			Date: "2000-01-10",
+			Name: "成人の日",
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("holidays not match: (-want/+got)\n%s", diff)
+	}
+}
+
+func TestFindHolidaysInYear(t *testing.T) {
+	got := findHolidaysInYear(2000)
+	want := []Holiday{
+		{
+			Date: "2000-01-01",
+			Name: "元日",
+		},
+		{
+			Date: "2000-01-10",
+			Name: "成人の日",
+		},
+		{
+			Date: "2000-02-11",
+			Name: "建国記念の日",
+		},
+		{
+			Date: "2000-03-20",
+			Name: "春分の日",
+		},
+		{
+			Date: "2000-04-29",
+			Name: "みどりの日",
+		},
+		{
+			Date: "2000-05-03",
+			Name: "憲法記念日",
+		},
+		{
+			Date: "2000-05-04",
+			Name: "休日",
+			Kind: KindCitizens,
+		},
+		{
+			Date: "2000-05-05",
+			Name: "こどもの日",
+		},
+		{
+			Date: "2000-07-20",
+			Name: "海の日",
+		},
+		{
+			Date: "2000-09-15",
+			Name: "敬老の日",
+		},
+		{
+			Date: "2000-09-23",
+			Name: "秋分の日",
+		},
+		{
+			Date: "2000-10-09",
+			Name: "体育の日",
+		},
+		{
+			Date: "2000-11-03",
+			Name: "文化の日",
+		},
+		{
+			Date: "2000-11-23",
+			Name: "勤労感謝の日",
+		},
+		{
+			Date: "2000-12-23",
+			Name: "天皇誕生日",
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("holidays not match: (-want/+got)\n%s", diff)
+	}
+}
+
+// the embedded CSV must be the same data as the generated Go source.
+func TestParseCSV(t *testing.T) {
+	rawData, err := os.ReadFile("syukujitsu.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := parseCSV(rawData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := currentDataset()
+	if diff := cmp.Diff(want.holidays, got.holidays); diff != "" {
+		t.Errorf("holidays mismatch (-want/+got):\n%s", diff)
+	}
+	if got.startYear != want.startYear || got.endYear != want.endYear {
+		t.Errorf("year range mismatch: want %d-%d, got %d-%d", want.startYear, want.endYear, got.startYear, got.endYear)
+	}
+	if got.version != want.version {
+		t.Errorf("version mismatch: want %s, got %s", want.version, got.version)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	rawData, err := os.ReadFile("syukujitsu.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, end := CoveredYears()
+	next := end + 1
+
+	var body atomic.Value
+	body.Store(addHolidayToCSV(t, rawData, fmt.Sprintf("\r\n%d/1/1,元日\r\n", next)))
+	testUpdateServer(t, &body)
+
+	if err := Update(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if _, to := CoveredYears(); to != next {
+		t.Errorf("want covered until %d, got %d", next, to)
+	}
+	if last, ok := LastUpdate(); !ok || !last.Changed || last.Err != nil {
+		t.Errorf("unexpected LastUpdate: %v, %t", last, ok)
+	}
+	if diff, ok := LastDiff(); !ok || len(diff.Added) != 1 || diff.Added[0].Date != fmt.Sprintf("%d-01-01", next) {
+		t.Errorf("unexpected LastDiff: %v, %t", diff, ok)
+	}
+	if DataSourceURL() != updateURL {
+		t.Errorf("unexpected DataSourceURL: %s", DataSourceURL())
+	}
+	// the year is partially covered, so the counts must not be cached from the old data.
+	if got := CountHolidays(Date{next, time.January, 1}, Date{next, time.December, 31}); got != 1 {
+		t.Errorf("want 1 holiday in %d, got %d", next, got)
+	}
+
+	// broken data doesn't replace the current data.
+	body.Store([]byte("broken"))
+	if err := Update(context.Background()); err == nil {
+		t.Error("want error, got nil")
+	}
+	if last, ok := LastUpdate(); !ok || last.Changed || last.Err == nil {
+		t.Errorf("unexpected LastUpdate: %v, %t", last, ok)
+	}
+	if _, to := CoveredYears(); to != next {
+		t.Errorf("want covered until %d, got %d", next, to)
+	}
+
+	// fewer holidays are rejected.
+	body.Store(rawData[:len(rawData)/2])
+	if err := Update(context.Background()); err == nil {
+		t.Error("want error, got nil")
+	}
+}
@@ -0,0 +1,31 @@
+//go:build !holidays_csv
+
+package holiday
+
+var generatedDataset = &dataset{
+	holidays:    holidays,
+	startYear:   holidaysStartYear,
+	endYear:     holidaysEndYear,
+	version:     dataVersion,
+	sourceURL:   dataSourceURL,
+	generatedAt: dataGeneratedAt,
+}
+
+// builtinDataset returns the pre-calculated holidays generated by the updater.
+func builtinDataset() *dataset {
+	return generatedDataset
+}
+
+// joinHolidays concatenates the holidays of the decades.
+// The decades excluded by the holidays_min build are nil.
+func joinHolidays(decades ...[]Holiday) []Holiday {
+	var n int
+	for _, d := range decades {
+		n += len(d)
+	}
+	result := make([]Holiday, 0, n)
+	for _, d := range decades {
+		result = append(result, d...)
+	}
+	return result
+}
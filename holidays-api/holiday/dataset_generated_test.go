@@ -0,0 +1,11 @@
+//go:build !holidays_csv
+
+package holiday
+
+import "testing"
+
+func TestValidateData(t *testing.T) {
+	if err := validateData(); err != nil {
+		t.Error(err)
+	}
+}
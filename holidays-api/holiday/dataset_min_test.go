@@ -0,0 +1,38 @@
+//go:build !holidays_csv && holidays_min
+
+package holiday
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// the holidays excluded by the holidays_min build must be calculated from the law.
+func TestMinDataset(t *testing.T) {
+	rawData, err := os.ReadFile("syukujitsu.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	full, err := parseCSV(rawData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ds := builtinDataset()
+	if ds.startYear <= full.startYear {
+		t.Fatalf("startYear = %d, want after %d", ds.startYear, full.startYear)
+	}
+	for year := full.startYear; year <= full.endYear; year++ {
+		var want []Holiday
+		for _, h := range full.holidays {
+			if mustParseDate(h.Date).Year() == year {
+				want = append(want, h)
+			}
+		}
+		if diff := cmp.Diff(want, FindHolidaysInYear(year)); diff != "" {
+			t.Errorf("FindHolidaysInYear(%d) mismatch (-want/+got):\n%s", year, diff)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package holiday
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+func TestParseCSV_UTF8(t *testing.T) {
+	rawData, err := os.ReadFile("syukujitsu.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := parseCSV(rawData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	utf8Data, _, err := transform.Bytes(japanese.ShiftJIS.NewDecoder(), rawData)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string][]byte{
+		"UTF-8":          utf8Data,
+		"UTF-8 with BOM": append([]byte("\xef\xbb\xbf"), utf8Data...),
+	}
+	for name, input := range tests {
+		got, err := parseCSV(input)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if diff := cmp.Diff(want.holidays, got.holidays); diff != "" {
+			t.Errorf("%s: holidays mismatch (-want/+got):\n%s", name, diff)
+		}
+	}
+}
+
+func TestParseCSV_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"header\n",
+		"header\n2024/13/1,invalid\n",
+		"header\n2024/1/1\n",
+		// neither UTF-8 nor Shift_JIS
+		"header\n2024/1/1,\xff\xfe\n",
+	}
+	for _, input := range tests {
+		if _, err := parseCSV([]byte(input)); err == nil {
+			t.Errorf("%q: want error, got nil", input)
+		}
+	}
+}
+
+func TestValidateHolidays(t *testing.T) {
+	tests := []struct {
+		name     string
+		holidays []Holiday
+		wantErr  bool
+	}{
+		{
+			name: "valid",
+			holidays: []Holiday{
+				{Date: "2025-01-01", Name: "元日"},
+				{Date: "2025-01-13", Name: "成人の日"},
+			},
+		},
+		{
+			name: "not sorted",
+			holidays: []Holiday{
+				{Date: "2025-01-13", Name: "成人の日"},
+				{Date: "2025-01-01", Name: "元日"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicated",
+			holidays: []Holiday{
+				{Date: "2025-01-01", Name: "元日"},
+				{Date: "2025-01-01", Name: "元日"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "out of range",
+			holidays: []Holiday{
+				{Date: "2026-01-01", Name: "元日"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid date",
+			holidays: []Holiday{
+				{Date: "2025-1-1", Name: "元日"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty name",
+			holidays: []Holiday{
+				{Date: "2025-01-01"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		err := validateHolidays(tt.holidays, 2025, 2025)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: validateHolidays() error = %v, wantErr %t", tt.name, err, tt.wantErr)
+		}
+	}
+}
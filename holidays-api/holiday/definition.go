@@ -1075,7 +1075,7 @@ var annuallyHolidaysRules = []annuallyHolidaysRule{
 }
 
 var specialHolidays = []Holiday{
-	// 昭和四十三年法律第十六号
+	// 昭和三十四年法律第十六号
 	// 皇太子明仁親王の結婚の儀の行われる日を休日とする法律
 	// 衆議院制定法律: https://www.shugiin.go.jp/internet/itdb_housei.nsf/html/houritsu/03119590317016.htm
 	//
@@ -1083,6 +1083,7 @@ var specialHolidays = []Holiday{
 	{
 		Date: "1959-04-10",
 		Name: "結婚の儀",
+		Kind: KindSpecial,
 	},
 
 	// 平成元年法律第四号
@@ -1093,6 +1094,7 @@ var specialHolidays = []Holiday{
 	{
 		Date: "1989-02-24",
 		Name: "大喪の礼",
+		Kind: KindSpecial,
 	},
 
 	// 平成二年法律第二十四号
@@ -1102,6 +1104,7 @@ var specialHolidays = []Holiday{
 	{
 		Date: "1990-11-12",
 		Name: "即位礼正殿の儀",
+		Kind: KindSpecial,
 	},
 
 	// 平成五年法律第三十二号
@@ -1111,6 +1114,7 @@ var specialHolidays = []Holiday{
 	{
 		Date: "1993-06-09",
 		Name: "結婚の儀",
+		Kind: KindSpecial,
 	},
 
 	// 平成三十年法律第九十九号
@@ -1124,9 +1128,11 @@ var specialHolidays = []Holiday{
 	{
 		Date: "2019-05-01",
 		Name: "休日（祝日扱い）", // "天皇の即位の日",
+		Kind: KindSpecial,
 	},
 	{
 		Date: "2019-10-22",
 		Name: "休日（祝日扱い）", // "即位礼正殿の儀の行われる日",
+		Kind: KindSpecial,
 	},
 }
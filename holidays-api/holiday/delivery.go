@@ -0,0 +1,50 @@
+package holiday
+
+import "time"
+
+// DeliveryOptions configures EstimateDelivery.
+type DeliveryOptions struct {
+	// DeliverOnSaturday treats Saturdays as delivery days.
+	// Holidays are skipped even if they fall on Saturdays.
+	DeliverOnSaturday bool
+
+	// DeliverOnSunday treats Sundays as delivery days.
+	DeliverOnSunday bool
+
+	// Margin is the number of extra delivery days for the latest estimate.
+	Margin int
+}
+
+// EstimateDelivery returns the earliest and the latest delivery date of a package shipped at ship.
+// The earliest date is leadBusinessDays delivery days after ship,
+// and the latest date is opts.Margin delivery days after the earliest date.
+// If opts is nil, the deliveries are only on business days.
+// The returned dates are 00:00 JST.
+func EstimateDelivery(ship time.Time, leadBusinessDays int, opts *DeliveryOptions) (earliest, latest time.Time) {
+	if opts == nil {
+		opts = &DeliveryOptions{}
+	}
+	weekend := []time.Weekday{}
+	if !opts.DeliverOnSaturday {
+		weekend = append(weekend, time.Saturday)
+	}
+	if !opts.DeliverOnSunday {
+		weekend = append(weekend, time.Sunday)
+	}
+	c := &Calendar{
+		Providers: []HolidayProvider{Japan},
+		Weekend:   weekend,
+	}
+
+	t := ship.In(JST)
+	t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, JST)
+	for i := 0; i < leadBusinessDays; i++ {
+		t, _ = c.NextBusinessDay(t)
+	}
+	earliest = t
+	for i := 0; i < opts.Margin; i++ {
+		t, _ = c.NextBusinessDay(t)
+	}
+	latest = t
+	return
+}
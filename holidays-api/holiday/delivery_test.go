@@ -0,0 +1,47 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEstimateDelivery(t *testing.T) {
+	ship := time.Date(2024, time.August, 8, 18, 0, 0, 0, JST) // Thu
+	tests := []struct {
+		name             string
+		lead             int
+		opts             *DeliveryOptions
+		earliest, latest time.Time
+	}{
+		{
+			name:     "business days",
+			lead:     2,
+			opts:     nil,
+			earliest: time.Date(2024, time.August, 13, 0, 0, 0, 0, JST),
+			latest:   time.Date(2024, time.August, 13, 0, 0, 0, 0, JST),
+		},
+		{
+			name:     "saturday delivery",
+			lead:     2,
+			opts:     &DeliveryOptions{DeliverOnSaturday: true, Margin: 2},
+			earliest: time.Date(2024, time.August, 10, 0, 0, 0, 0, JST),
+			latest:   time.Date(2024, time.August, 14, 0, 0, 0, 0, JST),
+		},
+		{
+			name: "every day but holidays",
+			lead: 3,
+			opts: &DeliveryOptions{DeliverOnSaturday: true, DeliverOnSunday: true, Margin: 1},
+			// 08-11 山の日 and 08-12 休日 are skipped
+			earliest: time.Date(2024, time.August, 13, 0, 0, 0, 0, JST),
+			latest:   time.Date(2024, time.August, 14, 0, 0, 0, 0, JST),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			earliest, latest := EstimateDelivery(ship, tt.lead, tt.opts)
+			if !earliest.Equal(tt.earliest) || !latest.Equal(tt.latest) {
+				t.Errorf("want (%s, %s), got (%s, %s)", tt.earliest, tt.latest, earliest, latest)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package holiday
+
+import "sync/atomic"
+
+// previousDataset is the dataset replaced by the last successful Update that changed the data.
+var previousDataset atomic.Pointer[dataset]
+
+// Diff is the difference of the pre-calculated holidays between two versions of the data.
+type Diff struct {
+	// FromVersion and ToVersion are the versions of the data, see DataVersion.
+	FromVersion string
+	ToVersion   string
+
+	// Added are the holidays only in the new data, sorted by date.
+	Added []Holiday
+
+	// Removed are the holidays only in the old data, sorted by date.
+	Removed []Holiday
+
+	// Renamed are the holidays whose name or kind changed, sorted by date.
+	Renamed []HolidayChange
+}
+
+// HolidayChange is a holiday changed between two versions of the data.
+type HolidayChange struct {
+	Old Holiday
+	New Holiday
+}
+
+// LastDiff returns what the last Update changed.
+// It returns false if Update has never replaced the data since the process started.
+// The overrides loaded by LoadOverrides are applied to both sides.
+func LastDiff() (Diff, bool) {
+	prev := previousDataset.Load()
+	if prev == nil {
+		return Diff{}, false
+	}
+	cur := currentDataset()
+	diff := diffHolidays(prev.holidays, cur.holidays)
+	diff.FromVersion = prev.version
+	diff.ToVersion = cur.version
+	return diff, true
+}
+
+// diffHolidays compares the holidays sorted by date.
+func diffHolidays(before, after []Holiday) Diff {
+	var diff Diff
+	i, j := 0, 0
+	for i < len(before) && j < len(after) {
+		switch {
+		case before[i].Date < after[j].Date:
+			diff.Removed = append(diff.Removed, before[i])
+			i++
+		case before[i].Date > after[j].Date:
+			diff.Added = append(diff.Added, after[j])
+			j++
+		default:
+			if before[i] != after[j] {
+				diff.Renamed = append(diff.Renamed, HolidayChange{Old: before[i], New: after[j]})
+			}
+			i++
+			j++
+		}
+	}
+	diff.Removed = append(diff.Removed, before[i:]...)
+	diff.Added = append(diff.Added, after[j:]...)
+	return diff
+}
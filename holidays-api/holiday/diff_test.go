@@ -0,0 +1,39 @@
+package holiday
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiffHolidays(t *testing.T) {
+	old := []Holiday{
+		{Date: "2030-01-01", Name: "元日", Kind: KindNational},
+		{Date: "2030-01-14", Name: "成人の日", Kind: KindNational},
+		{Date: "2030-02-11", Name: "建国記念の日", Kind: KindNational},
+		{Date: "2030-12-31", Name: "大晦日", Kind: KindCustom},
+	}
+	after := []Holiday{
+		{Date: "2030-01-01", Name: "元日", Kind: KindNational},
+		{Date: "2030-01-14", Name: "成年の日", Kind: KindNational},
+		{Date: "2030-02-11", Name: "建国記念の日", Kind: KindNational},
+		{Date: "2030-05-01", Name: "即位の日", Kind: KindSpecial},
+	}
+	want := Diff{
+		Added:   []Holiday{{Date: "2030-05-01", Name: "即位の日", Kind: KindSpecial}},
+		Removed: []Holiday{{Date: "2030-12-31", Name: "大晦日", Kind: KindCustom}},
+		Renamed: []HolidayChange{
+			{
+				Old: Holiday{Date: "2030-01-14", Name: "成人の日", Kind: KindNational},
+				New: Holiday{Date: "2030-01-14", Name: "成年の日", Kind: KindNational},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, diffHolidays(old, after)); diff != "" {
+		t.Errorf("mismatch (-want/+got):\n%s", diff)
+	}
+
+	if got := diffHolidays(old, old); len(got.Added)+len(got.Removed)+len(got.Renamed) != 0 {
+		t.Errorf("want no changes, got %v", got)
+	}
+}
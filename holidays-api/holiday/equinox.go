@@ -0,0 +1,77 @@
+package holiday
+
+import (
+	"math"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/internal/astro"
+)
+
+// Equinox is the moment of an equinox calculated from the longitude of the sun.
+type Equinox struct {
+	// Time is the moment of the equinox in JST.
+	Time time.Time
+
+	// Uncertainty is the estimated error of Time.
+	// It is the sum of the error of the truncated series of the longitude and the error of ΔT,
+	// so it grows for the far future, e.g. about 2 minutes in 2030 and 7 minutes in 2150.
+	// If Time is closer to midnight than Uncertainty, the day of the equinox is not reliable.
+	Uncertainty time.Duration
+}
+
+// Date returns the day of the equinox in JST.
+func (e Equinox) Date() Date {
+	return dateOf(e.Time)
+}
+
+// Reliable reports whether the day of the equinox is certain within Uncertainty,
+// that is, the equinox is not around midnight in JST.
+func (e Equinox) Reliable() bool {
+	return dateOf(e.Time.Add(-e.Uncertainty)) == dateOf(e.Time.Add(e.Uncertainty))
+}
+
+// seriesUncertainty is the error of the longitude of the sun by the truncated series of astro.SunLongitude.
+// The equinoxes from 2000 to 2030 are within 2 minutes of the ephemeris of the National Astronomical Observatory of Japan.
+const seriesUncertainty = 2 * time.Minute
+
+// VernalEquinox returns the moment of the vernal equinox (春分) in the year.
+func VernalEquinox(year int) Equinox {
+	return findEquinox(year, time.March, 0)
+}
+
+// AutumnalEquinox returns the moment of the autumnal equinox (秋分) in the year.
+func AutumnalEquinox(year int) Equinox {
+	return findEquinox(year, time.September, 180)
+}
+
+// findEquinox finds the moment when the longitude of the sun is the target around the 20th of the month.
+func findEquinox(year int, month time.Month, target float64) Equinox {
+	t := time.Date(year, month, 20, 0, 0, 0, 0, JST)
+	for i := 0; i < 10; i++ {
+		// the difference of the longitude in -180 to 180 degrees.
+		diff := math.Mod(target-astro.SunLongitude(astro.JulianYearOf(t))+540, 360) - 180
+
+		// the sun moves about 360 degrees in a tropical year.
+		step := time.Duration(diff / 360 * 365.2422 * 24 * float64(time.Hour))
+		t = t.Add(step)
+		if step.Abs() < time.Second {
+			break
+		}
+	}
+	t = t.Round(time.Second)
+	return Equinox{
+		Time:        t,
+		Uncertainty: (seriesUncertainty + deltaTUncertainty(astro.DecimalYear(t))).Round(time.Second),
+	}
+}
+
+// deltaTUncertainty returns the estimated error of astro.DeltaT.
+// The polynomials are fitted to the observations until 2005, and the error of the extrapolation
+// grows quadratically after that. For example, it is about 6 seconds in 2025, 2 minutes in 2100, and 5 minutes in 2150.
+func deltaTUncertainty(y float64) time.Duration {
+	if y < 2005 {
+		return time.Second
+	}
+	t := y - 2005
+	return time.Second + time.Duration(0.015*t*t*float64(time.Second))
+}
@@ -0,0 +1,66 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEquinox(t *testing.T) {
+	// from the ephemeris of the National Astronomical Observatory of Japan.
+	tests := []struct {
+		got  Equinox
+		want time.Time
+	}{
+		{VernalEquinox(2000), time.Date(2000, time.March, 20, 16, 35, 0, 0, JST)},
+		{AutumnalEquinox(2010), time.Date(2010, time.September, 23, 12, 9, 0, 0, JST)},
+		{VernalEquinox(2024), time.Date(2024, time.March, 20, 12, 6, 0, 0, JST)},
+		{AutumnalEquinox(2025), time.Date(2025, time.September, 23, 3, 19, 0, 0, JST)},
+	}
+	for _, tt := range tests {
+		if d := tt.got.Time.Sub(tt.want).Abs(); d > tt.got.Uncertainty {
+			t.Errorf("%s: want %s ± %s", tt.got.Time, tt.want, tt.got.Uncertainty)
+		}
+		if tt.got.Time.Location() != JST {
+			t.Errorf("%s: want JST", tt.got.Time)
+		}
+		if !tt.got.Reliable() {
+			t.Errorf("%s: want reliable", tt.got.Time)
+		}
+	}
+}
+
+func TestEquinox_Date(t *testing.T) {
+	for year := MinYear; year <= MaxYear; year++ {
+		if got, want := VernalEquinox(year).Date(), (Date{year, time.March, vernalEquinoxDay(year)}); got != want {
+			t.Errorf("VernalEquinox(%d): want %s, got %s", year, want, got)
+		}
+		if got, want := AutumnalEquinox(year).Date(), (Date{year, time.September, autumnalEquinoxDay(year)}); got != want {
+			t.Errorf("AutumnalEquinox(%d): want %s, got %s", year, want, got)
+		}
+	}
+}
+
+func TestEquinox_Uncertainty(t *testing.T) {
+	// the uncertainty grows for the far future.
+	near, far := VernalEquinox(2030), VernalEquinox(2150)
+	if near.Uncertainty >= far.Uncertainty {
+		t.Errorf("want %s < %s", near.Uncertainty, far.Uncertainty)
+	}
+	if far.Uncertainty < 5*time.Minute || far.Uncertainty > 10*time.Minute {
+		t.Errorf("unexpected uncertainty in 2150: %s", far.Uncertainty)
+	}
+}
+
+func TestEquinox_Reliable(t *testing.T) {
+	e := Equinox{
+		Time:        time.Date(2100, time.March, 20, 23, 58, 0, 0, JST),
+		Uncertainty: 3 * time.Minute,
+	}
+	if e.Reliable() {
+		t.Errorf("%s ± %s: want unreliable", e.Time, e.Uncertainty)
+	}
+	e.Uncertainty = time.Minute
+	if !e.Reliable() {
+		t.Errorf("%s ± %s: want reliable", e.Time, e.Uncertainty)
+	}
+}
@@ -0,0 +1,62 @@
+package holiday
+
+import "slices"
+
+// Dedupe returns the holidays without the duplicates on the same day.
+// The first holiday of each day is kept, and the order is preserved.
+func Dedupe(holidays []Holiday) []Holiday {
+	seen := make(map[string]bool, len(holidays))
+	result := make([]Holiday, 0, len(holidays))
+	for _, h := range holidays {
+		if seen[h.Date] {
+			continue
+		}
+		seen[h.Date] = true
+		result = append(result, h)
+	}
+	return result
+}
+
+// Merge returns the holidays of all sources sorted by date.
+// If some sources have holidays on the same day, the one in the earlier source takes precedence,
+// e.g. Merge(national, closures) prefers 元日 to 年末年始休み on January 1st.
+func Merge(sources ...[]Holiday) []Holiday {
+	var n int
+	for _, s := range sources {
+		n += len(s)
+	}
+	all := make([]Holiday, 0, n)
+	for _, s := range sources {
+		all = append(all, s...)
+	}
+	result := Dedupe(all)
+	SortHolidays(result)
+	return result
+}
+
+// Filter returns the holidays that satisfy keep, in the original order.
+func Filter(holidays []Holiday, keep func(Holiday) bool) []Holiday {
+	result := []Holiday{}
+	for _, h := range holidays {
+		if keep(h) {
+			result = append(result, h)
+		}
+	}
+	return result
+}
+
+// FilterByKind returns the holidays of the kinds, e.g. FilterByKind(holidays, KindNational, KindSubstitute).
+func FilterByKind(holidays []Holiday, kinds ...Kind) []Holiday {
+	return Filter(holidays, func(h Holiday) bool {
+		return slices.Contains(kinds, h.Kind)
+	})
+}
+
+// FilterByName returns the holidays whose names satisfy match.
+// For example, FilterByName(holidays, func(name string) bool { return ContainsName(name, "敬老") })
+// returns 敬老の日.
+func FilterByName(holidays []Holiday, match func(name string) bool) []Holiday {
+	return Filter(holidays, func(h Holiday) bool {
+		return match(h.Name)
+	})
+}
@@ -0,0 +1,75 @@
+package holiday
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDedupe(t *testing.T) {
+	got := Dedupe([]Holiday{
+		{Date: "2025-01-01", Name: "元日"},
+		{Date: "2025-01-01", Name: "年末年始休み", Kind: KindCustomary},
+		{Date: "2024-12-31", Name: "年末年始休み", Kind: KindCustomary},
+	})
+	want := []Holiday{
+		{Date: "2025-01-01", Name: "元日"},
+		{Date: "2024-12-31", Name: "年末年始休み", Kind: KindCustomary},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Dedupe() mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	national := []Holiday{
+		{Date: "2025-01-01", Name: "元日"},
+		{Date: "2025-01-13", Name: "成人の日"},
+	}
+	closures := []Holiday{
+		{Date: "2024-12-31", Name: "年末年始休み", Kind: KindCustomary},
+		{Date: "2025-01-01", Name: "年末年始休み", Kind: KindCustomary},
+		{Date: "2025-01-02", Name: "年末年始休み", Kind: KindCustomary},
+	}
+	got := Merge(national, closures)
+	want := []Holiday{
+		{Date: "2024-12-31", Name: "年末年始休み", Kind: KindCustomary},
+		{Date: "2025-01-01", Name: "元日"},
+		{Date: "2025-01-02", Name: "年末年始休み", Kind: KindCustomary},
+		{Date: "2025-01-13", Name: "成人の日"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Merge() mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestFilterByKind(t *testing.T) {
+	holidays := FindHolidaysInRange(Date{2019, 4, 27}, Date{2019, 5, 6})
+	got := FilterByKind(holidays, KindSpecial, KindSubstitute)
+	want := []Holiday{
+		{Date: "2019-05-01", Name: "休日（祝日扱い）", Kind: KindSpecial},
+		{Date: "2019-05-06", Name: "休日", Kind: KindSubstitute},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FilterByKind() mismatch (-want/+got):\n%s", diff)
+	}
+
+	if got := FilterByKind(holidays, KindLocal); len(got) != 0 {
+		t.Errorf("FilterByKind() = %v, want empty", got)
+	}
+}
+
+func TestFilterByName(t *testing.T) {
+	holidays := FindHolidaysInYear(2025)
+	got := FilterByName(holidays, func(name string) bool {
+		return name == "休日"
+	})
+	want := []Holiday{
+		{Date: "2025-02-24", Name: "休日", Kind: KindSubstitute},
+		{Date: "2025-05-06", Name: "休日", Kind: KindSubstitute},
+		{Date: "2025-11-24", Name: "休日", Kind: KindSubstitute},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FilterByName() mismatch (-want/+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,78 @@
+package holiday
+
+import (
+	"testing"
+)
+
+// The well-known historical holidays published by the Cabinet Office.
+func TestHistoricalHolidays(t *testing.T) {
+	tests := []struct {
+		date string
+		want Holiday
+		ok   bool
+	}{
+		// the first day of the official data
+		{"1955-01-01", Holiday{Date: "1955-01-01", Name: "元日"}, true},
+		{"1955-01-15", Holiday{Date: "1955-01-15", Name: "成人の日"}, true},
+
+		// the wedding of Crown Prince Akihito
+		{"1959-04-10", Holiday{Date: "1959-04-10", Name: "結婚の儀", Kind: KindSpecial}, true},
+
+		// 建国記念の日, 敬老の日 and 体育の日 were established in 1966.
+		{"1966-02-11", Holiday{}, false},
+		{"1966-09-15", Holiday{Date: "1966-09-15", Name: "敬老の日"}, true},
+		{"1966-10-10", Holiday{Date: "1966-10-10", Name: "体育の日"}, true},
+		{"1967-02-11", Holiday{Date: "1967-02-11", Name: "建国記念の日"}, true},
+
+		// the first substitute holiday
+		{"1973-04-30", Holiday{Date: "1973-04-30", Name: "休日", Kind: KindSubstitute}, true},
+
+		// the first citizens' holiday
+		{"1988-05-04", Holiday{Date: "1988-05-04", Name: "休日", Kind: KindCitizens}, true},
+
+		// the end of the Showa era
+		{"1988-04-29", Holiday{Date: "1988-04-29", Name: "天皇誕生日"}, true},
+		{"1989-02-24", Holiday{Date: "1989-02-24", Name: "大喪の礼", Kind: KindSpecial}, true},
+		{"1989-04-29", Holiday{Date: "1989-04-29", Name: "みどりの日"}, true},
+		{"1989-12-23", Holiday{Date: "1989-12-23", Name: "天皇誕生日"}, true},
+
+		{"1996-07-20", Holiday{Date: "1996-07-20", Name: "海の日"}, true},
+
+		// the Happy Monday System
+		{"2000-01-10", Holiday{Date: "2000-01-10", Name: "成人の日"}, true},
+
+		{"2007-04-29", Holiday{Date: "2007-04-29", Name: "昭和の日"}, true},
+		{"2007-05-04", Holiday{Date: "2007-05-04", Name: "みどりの日"}, true},
+		{"2016-08-11", Holiday{Date: "2016-08-11", Name: "山の日"}, true},
+
+		// the enthronement of Emperor Naruhito
+		{"2019-05-01", Holiday{Date: "2019-05-01", Name: "休日（祝日扱い）", Kind: KindSpecial}, true},
+		{"2019-12-23", Holiday{}, false},
+
+		// moved for the Tokyo Olympic Games
+		{"2020-07-24", Holiday{Date: "2020-07-24", Name: "スポーツの日"}, true},
+	}
+	for _, tt := range tests {
+		d, err := ParseDate(tt.date)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, ok := FindHoliday(d.Year, d.Month, d.Day)
+		if ok != tt.ok || got != tt.want {
+			t.Errorf("%s: want (%v, %t), got (%v, %t)", tt.date, tt.want, tt.ok, got, ok)
+		}
+	}
+}
+
+func TestEarliestOfficialYear(t *testing.T) {
+	from, _ := CoveredYears()
+	if from < EarliestOfficialYear {
+		t.Errorf("the covered years start in %d before EarliestOfficialYear %d", from, EarliestOfficialYear)
+	}
+
+	// the official data start with 元日 in EarliestOfficialYear.
+	h, ok := FindHoliday(EarliestOfficialYear, 1, 1)
+	if !ok || h.Name != "元日" {
+		t.Errorf("want 元日, got (%v, %t)", h, ok)
+	}
+}
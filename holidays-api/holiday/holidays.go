@@ -1,12 +1,22 @@
+// Package holiday provides the holidays in Japan.
+//
+// All functions in this package are safe for concurrent use by multiple goroutines.
+// The returned slices are owned by the caller, so they may be modified freely.
+//
+// The functions that accept time.Time convert it to Asia/Tokyo before taking the date,
+// so 2024-08-10T15:00:00Z is treated as 2024-08-11 (山の日), regardless of the location of the time.
 package holiday
 
 import (
 	"cmp"
 	"fmt"
-	"math"
+	"log/slog"
+	"slices"
 	"sort"
 	"strings"
 	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/internal/astro"
 )
 
 // Date represents a date.
@@ -16,7 +26,8 @@ type Date struct {
 	Day   int
 }
 
-func (a Date) cmp(b Date) int {
+// Compare returns -1 if a is before b, 0 if they are the same day, and +1 if a is after b.
+func (a Date) Compare(b Date) int {
 	if a.Year != b.Year {
 		return cmp.Compare(a.Year, b.Year)
 	}
@@ -45,7 +56,7 @@ func (d Date) nextMonth() Date {
 
 // FindHoliday returns whether the specific day is a holiday.
 func FindHoliday(year int, month time.Month, day int) (Holiday, bool) {
-	if holidaysStartYear <= year && year <= holidaysEndYear {
+	if currentDataset().covers(year, year) {
 		// return from pre-calculated holidays
 		return findHoliday(year, month, day)
 	}
@@ -63,7 +74,7 @@ func FindHoliday(year int, month time.Month, day int) (Holiday, bool) {
 
 // FindHolidaysInMonth returns holidays in the month.
 func FindHolidaysInMonth(year int, month time.Month) []Holiday {
-	if holidaysStartYear <= year && year <= holidaysEndYear {
+	if currentDataset().covers(year, year) {
 		// return from pre-calculated holidays
 		return findHolidaysInMonth(year, month)
 	}
@@ -74,7 +85,7 @@ func FindHolidaysInMonth(year int, month time.Month) []Holiday {
 
 // FindHolidaysInYear returns holidays in the year.
 func FindHolidaysInYear(year int) []Holiday {
-	if holidaysStartYear <= year && year <= holidaysEndYear {
+	if currentDataset().covers(year, year) {
 		// return from pre-calculated holidays
 		return findHolidaysInYear(year)
 	}
@@ -83,11 +94,25 @@ func FindHolidaysInYear(year int) []Holiday {
 	return calcHolidaysInYear(year)
 }
 
+// FindHolidaysByMonth returns holidays in the year grouped by month.
+// The result has all 12 months as keys, and the months without holidays have empty slices.
+func FindHolidaysByMonth(year int) map[time.Month][]Holiday {
+	result := make(map[time.Month][]Holiday, 12)
+	for month := time.January; month <= time.December; month++ {
+		result[month] = []Holiday{}
+	}
+	for _, h := range FindHolidaysInYear(year) {
+		month := mustParseDate(h.Date).Month()
+		result[month] = append(result[month], h)
+	}
+	return result
+}
+
 func FindHolidaysInRange(from, to Date) []Holiday {
-	if from.cmp(to) > 0 {
+	if from.Compare(to) > 0 {
 		from, to = to, from
 	}
-	if holidaysStartYear <= from.Year && to.Year <= holidaysEndYear {
+	if currentDataset().covers(from.Year, to.Year) {
 		// return from pre-calculated holidays
 		return findHolidaysInRange(from, to)
 	}
@@ -109,17 +134,118 @@ func mustParseDate(date string) time.Time {
 type Holiday struct {
 	Date string
 	Name string
+	Kind Kind
+}
+
+// String returns the date and the name of the holiday, e.g. "2025-01-01 元日".
+func (h Holiday) String() string {
+	return h.Date + " " + h.Name
+}
+
+// LogValue implements slog.LogValuer.
+func (h Holiday) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("date", h.Date),
+		slog.String("name", h.Name),
+		slog.String("kind", h.Kind.String()),
+	)
+}
+
+// Kind is the kind of a holiday.
+type Kind int
+
+const (
+	// KindNational is a "国民の祝日" defined in Article 2 of the Act on National Holidays.
+	KindNational Kind = iota
+
+	// KindSubstitute is a substitute holiday (振替休日) defined in Article 3, Paragraph 2.
+	KindSubstitute
+
+	// KindCitizens is a citizens' holiday (国民の休日) defined in Article 3, Paragraph 3.
+	KindCitizens
+
+	// KindSpecial is a one-off holiday established by a special law, e.g. 即位礼正殿の儀.
+	KindSpecial
+
+	// KindCustom is a non-statutory day added by users, e.g. company closures.
+	KindCustom
+
+	// KindLocal is a local observance of a prefecture or a municipality, e.g. 都民の日.
+	// It is not a legal holiday, but many schools and local businesses close on it.
+	KindLocal
+
+	// KindCustomary is a customary non-statutory closure, e.g. お盆 and 年末年始.
+	KindCustomary
+
+	// KindMourning is a one-off day of national mourning, e.g. a state funeral (国葬儀).
+	// It is not a legal holiday, and government offices stay open on it.
+	KindMourning
+)
+
+var kindNames = [...]string{
+	KindNational:   "national",
+	KindSubstitute: "substitute",
+	KindCitizens:   "citizens",
+	KindSpecial:    "special",
+	KindCustom:     "custom",
+	KindLocal:      "local",
+	KindCustomary:  "customary",
+	KindMourning:   "mourning",
+}
+
+func (k Kind) String() string {
+	if 0 <= k && int(k) < len(kindNames) {
+		return kindNames[k]
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
 }
 
-type withDate []Holiday
+// IsDayOff reports whether the days of the kind are days off.
+// It is false only for KindMourning; government offices and businesses stay open on the days of mourning.
+func (k Kind) IsDayOff() bool {
+	return k != KindMourning
+}
+
+// Equal reports whether h and other are the same holiday, i.e. they have the same date, name and kind.
+func (h Holiday) Equal(other Holiday) bool {
+	return h == other
+}
+
+// Before reports whether h comes before other in the order of Compare.
+func (h Holiday) Before(other Holiday) bool {
+	return Compare(h, other) < 0
+}
+
+// After reports whether h comes after other in the order of Compare.
+func (h Holiday) After(other Holiday) bool {
+	return Compare(h, other) > 0
+}
 
-func (s withDate) Len() int           { return len(s) }
-func (s withDate) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
-func (s withDate) Less(i, j int) bool { return s[i].Date < s[j].Date }
+// Compare returns -1 if a comes before b, 1 if a comes after b, and 0 if they are equal.
+// The holidays are ordered by the date, then the name, then the kind.
+// It is suitable for slices.SortFunc.
+func Compare(a, b Holiday) int {
+	if c := cmp.Compare(a.Date, b.Date); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.Name, b.Name); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.Kind, b.Kind)
+}
+
+// SortHolidays sorts the holidays by the date in place.
+// The sort is stable, so the holidays on the same day keep their original order.
+func SortHolidays(holidays []Holiday) {
+	slices.SortStableFunc(holidays, func(a, b Holiday) int {
+		return cmp.Compare(a.Date, b.Date)
+	})
+}
 
 // findHoliday returns whether the specific day is a holiday.
 func findHoliday(year int, month time.Month, day int) (Holiday, bool) {
 	date := fmt.Sprintf("%04d-%02d-%02d", year, int(month), day)
+	holidays := currentDataset().holidays
 	idx := sort.Search(len(holidays), func(i int) bool {
 		return holidays[i].Date >= date
 	})
@@ -149,6 +275,7 @@ func findHolidaysInRange(from, to Date) []Holiday {
 	startDate := from.String()
 	endDate := to.String()
 
+	holidays := currentDataset().holidays
 	start := sort.Search(len(holidays), func(i int) bool {
 		return holidays[i].Date >= startDate
 	})
@@ -158,7 +285,9 @@ func findHolidaysInRange(from, to Date) []Holiday {
 	if end < len(holidays) && holidays[end].Date == endDate {
 		end++
 	}
-	return holidays[start:end]
+
+	// copy the result so that callers cannot modify the pre-calculated holidays.
+	return slices.Clone(holidays[start:end])
 }
 
 type annuallyHolidaysRule struct {
@@ -170,6 +299,11 @@ type annuallyHolidaysRule struct {
 
 	// StaticHolydays are holydays that are on the same weekday in the month.
 	WeekdayHolydays []weekdayHolyday
+
+	// NoVernalEquinoxDay and NoAutumnalEquinoxDay remove the equinox days.
+	// They are used only by hypothetical amendments.
+	NoVernalEquinoxDay   bool
+	NoAutumnalEquinoxDay bool
 }
 
 type staticHolyday struct {
@@ -184,12 +318,12 @@ type weekdayHolyday struct {
 	Name    string
 }
 
-func calcHolidaysInMonthWithoutInLieu(year int, month time.Month) []Holiday {
+func (rs *RuleSet) calcHolidaysInMonthWithoutInLieu(year int, month time.Month) []Holiday {
 	// search the rule of this year
 	var rule *annuallyHolidaysRule
-	for i := 0; i < len(annuallyHolidaysRules); i++ {
-		if year >= annuallyHolidaysRules[i].BeginYear {
-			rule = &annuallyHolidaysRules[i]
+	for i := 0; i < len(rs.rules); i++ {
+		if year >= rs.rules[i].BeginYear {
+			rule = &rs.rules[i]
 			break
 		}
 	}
@@ -209,15 +343,9 @@ func calcHolidaysInMonthWithoutInLieu(year int, month time.Month) []Holiday {
 		}
 	}
 
-	weekdayOfFirstDay := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).Weekday()
-	_ = weekdayOfFirstDay
 	for _, d := range rule.WeekdayHolydays {
 		if d.Month == month {
-			day := int(d.Weekday - weekdayOfFirstDay)
-			if day < 0 {
-				day += 7
-			}
-			day += d.Index*7 + 1
+			day := nthWeekday(year, month, d.Weekday, d.Index+1)
 			holydays = append(holydays, Holiday{
 				Date: fmt.Sprintf("%04d-%02d-%02d", year, int(month), day),
 				Name: d.Name,
@@ -226,7 +354,7 @@ func calcHolidaysInMonthWithoutInLieu(year int, month time.Month) []Holiday {
 	}
 
 	// Vernal Equinox Day
-	if month == time.March {
+	if month == time.March && !rule.NoVernalEquinoxDay {
 		holydays = append(holydays, Holiday{
 			Date: fmt.Sprintf("%04d-%02d-%02d", year, int(month), vernalEquinoxDay(year)),
 			Name: "春分の日",
@@ -234,7 +362,7 @@ func calcHolidaysInMonthWithoutInLieu(year int, month time.Month) []Holiday {
 	}
 
 	// Autumnal Equinox Day
-	if month == time.September {
+	if month == time.September && !rule.NoAutumnalEquinoxDay {
 		holydays = append(holydays, Holiday{
 			Date: fmt.Sprintf("%04d-%02d-%02d", year, int(month), autumnalEquinoxDay(year)),
 			Name: "秋分の日",
@@ -242,146 +370,138 @@ func calcHolidaysInMonthWithoutInLieu(year int, month time.Month) []Holiday {
 	}
 
 	yearMonthPrefix := yearPrefix + monthPrefix
-	for _, d := range specialHolidays {
+	for _, d := range rs.special {
 		if strings.HasPrefix(d.Date, yearMonthPrefix) {
 			holydays = append(holydays, d)
 		}
 	}
 
-	sort.Sort(withDate(holydays))
+	SortHolidays(holydays)
 	return holydays
 }
 
-func calcHolidaysInMonth(year int, month time.Month) []Holiday {
-	holidays := calcHolidaysInMonthWithoutInLieu(year, month)
+func (rs *RuleSet) calcHolidaysInMonth(year int, month time.Month) []Holiday {
+	// A substitute holiday or a citizens' holiday may be in the month next to the holidays that cause it,
+	// e.g. a holiday on Sunday, January 31st makes February 1st a substitute holiday.
+	// So the rules are applied to the holidays from the previous month to the next month,
+	// and the holidays in the month are picked.
+	// The months after 9999 are skipped because the dates are formatted in four digits.
+	var holidays []Holiday
+	for m := month - 1; m <= month+1; m++ {
+		first := time.Date(year, m, 1, 0, 0, 0, 0, time.UTC)
+		if first.Year() > 9999 {
+			break
+		}
+		holidays = append(holidays, rs.calcHolidaysInMonthWithoutInLieu(first.Year(), first.Month())...)
+	}
+	holidays = addCitizensHolidays(holidays)
+	holidays = addSubstituteHolidays(holidays)
+
+	prefix := fmt.Sprintf("%04d-%02d-", year, int(month))
+	var result []Holiday
+	for _, h := range holidays {
+		if strings.HasPrefix(h.Date, prefix) {
+			result = append(result, h)
+		}
+	}
+	return result
+}
 
+// addCitizensHolidays adds the citizens' holidays (国民の休日) between the holidays, which are sorted by date.
+func addCitizensHolidays(holidays []Holiday) []Holiday {
 	// 昭和六十年法律第百三号
 	// 国民の祝日に関する法律の一部を改正する法律
 	// 衆議院制定法律: https://www.shugiin.go.jp/internet/itdb_housei.nsf/html/houritsu/10319851227103.htm
-	if year >= 1986 {
-		var extraHolidays []Holiday
-		for i := 0; i < len(holidays)-1; i++ {
-			holidayA := mustParseDate(holidays[i].Date)
-			holidayB := mustParseDate(holidays[i+1].Date)
-
-			// > 第三条に次の一項を加える。
-			// > ３　その前日及び翌日が「国民の祝日」である日（日曜日にあたる日及び前項に規定する休日にあたる日を除く。）は、休日とする。
-			if holidayB.Sub(holidayA) == 2*24*time.Hour {
-				d := holidayA.Add(24 * time.Hour)
-				if d.Weekday() != time.Sunday {
-					extraHolidays = append(extraHolidays, Holiday{
-						Date: d.Format(dateLayout),
-						Name: "休日",
-					})
-				}
-			}
+	var extraHolidays []Holiday
+	for i := 0; i < len(holidays)-1; i++ {
+		holidayA := mustParseDate(holidays[i].Date)
+		holidayB := mustParseDate(holidays[i+1].Date)
+		d := holidayA.Add(24 * time.Hour)
+		if d.Year() < 1986 {
+			continue
 		}
 
-		// Handle edge cases that span months
-		if len(holidays) > 0 {
-			firstHolidayInMonth := mustParseDate(holidays[0].Date)
-			beforeTwoDays := firstHolidayInMonth.Add(-2 * 24 * time.Hour)
-			if firstHolidayInMonth.Month() != beforeTwoDays.Month() && firstHolidayInMonth.Weekday() != time.Monday {
-				// the first day in the month might be a holiday
-				previousHolidays := calcHolidaysInMonthWithoutInLieu(
-					beforeTwoDays.Year(), beforeTwoDays.Month(),
-				)
-				if len(previousHolidays) > 0 && previousHolidays[len(previousHolidays)-1].Date == beforeTwoDays.Format(dateLayout) {
-					extraHolidays = append(extraHolidays, Holiday{
-						Date: firstHolidayInMonth.Add(-24 * time.Hour).Format(dateLayout),
-						Name: "休日",
-					})
-				}
-			}
-
-			lastHolidayInMonth := mustParseDate(holidays[len(holidays)-1].Date)
-			afterTwoDays := lastHolidayInMonth.Add(2 * 24 * time.Hour)
-			if lastHolidayInMonth.Month() != afterTwoDays.Month() && lastHolidayInMonth.Weekday() != time.Monday {
-				// the last day in the month might be a holiday
-				nextHolidays := calcHolidaysInMonthWithoutInLieu(
-					afterTwoDays.Year(), afterTwoDays.Month(),
-				)
-				if len(nextHolidays) > 0 && nextHolidays[0].Date == afterTwoDays.Format(dateLayout) {
-					extraHolidays = append(extraHolidays, Holiday{
-						Date: lastHolidayInMonth.Add(24 * time.Hour).Format(dateLayout),
-						Name: "休日",
-					})
-				}
-			}
+		// > 第三条に次の一項を加える。
+		// > ３　その前日及び翌日が「国民の祝日」である日（日曜日にあたる日及び前項に規定する休日にあたる日を除く。）は、休日とする。
+		//
+		// The day after a Sunday holiday is a substitute holiday, which is handled by addSubstituteHolidays.
+		if holidayB.Sub(holidayA) == 2*24*time.Hour && holidayA.Weekday() != time.Saturday && holidayA.Weekday() != time.Sunday {
+			extraHolidays = append(extraHolidays, Holiday{
+				Date: d.Format(dateLayout),
+				Name: "休日",
+				Kind: KindCitizens,
+			})
 		}
-
-		holidays = append(holidays, extraHolidays...)
-		sort.Sort(withDate(holidays))
 	}
+	holidays = append(holidays, extraHolidays...)
+	SortHolidays(holidays)
+	return holidays
+}
 
-	// 昭和四十八年法律第十号
-	// 国民の祝日に関する法律の一部を改正する法律
-	// 衆議院制定法律: https://www.shugiin.go.jp/internet/itdb_housei.nsf/html/houritsu/07119730412010.htm
-	//
-	// > 第三条に次の一項を加える。
-	// > ２　「国民の祝日」が日曜日にあたるときは、その翌日を休日とする。
-	if 1973 <= year && year < 2007 {
-		var holidaysInLieu []Holiday
-		for _, holiday := range holidays {
-
-			// This law was enacted on April 12, 1973,
-			// so it did not apply to holidays before that date.
-			if holiday.Date <= "1973-04-12" {
-				continue
-			}
-
-			d, err := time.Parse(dateLayout, holiday.Date)
-			if err != nil {
-				panic(err)
-			}
-			if d.Weekday() != time.Sunday {
-				continue
-			}
-			d = d.Add(24 * time.Hour)
-			if !contains(holidays, d.Format(dateLayout)) {
-				holidaysInLieu = append(holidaysInLieu, Holiday{
-					Date: d.Format(dateLayout),
-					Name: "休日",
-				})
-			}
+// addSubstituteHolidays adds the substitute holidays (振替休日) for the holidays on Sunday, which are sorted by date.
+func addSubstituteHolidays(holidays []Holiday) []Holiday {
+	var holidaysInLieu []Holiday
+	for _, holiday := range holidays {
+		d, err := time.Parse(dateLayout, holiday.Date)
+		if err != nil {
+			panic(err)
+		}
+		if d.Weekday() != time.Sunday {
+			continue
 		}
-		holidays = append(holidays, holidaysInLieu...)
-		sort.Sort(withDate(holidays))
-	}
 
-	// 平成十七年法律第四十三号
-	// 国民の祝日に関する法律の一部を改正する法律
-	// 衆議院制定法律: https://www.shugiin.go.jp/internet/itdb_housei.nsf/html/housei/16220050520043.htm
-	// 官報: https://kanpou.npb.go.jp/old/20050520/20050520g00109/20050520g001090005f.html
-	//
-	// > 第三条第二項中「あたるときは、その翌日」を「当たるときは、その日後においてその日に最も近い「国民の祝日」でない日」に改め、
-	// > 同条第三項中「日曜日にあたる日及び前項に規定する休日にあたる日を除く。」を「「国民の祝日」でない日に限る。」に改める。
-	if year >= 2007 {
-		var holidaysInLieu []Holiday
-		for _, holiday := range holidays {
-			d, err := time.Parse(dateLayout, holiday.Date)
-			if err != nil {
-				panic(err)
-			}
-			if d.Weekday() != time.Sunday {
+		switch {
+		// This law was enacted on April 12, 1973,
+		// so it did not apply to holidays before that date.
+		case holiday.Date <= "1973-04-12":
+			continue
+
+		// 昭和四十八年法律第十号
+		// 国民の祝日に関する法律の一部を改正する法律
+		// 衆議院制定法律: https://www.shugiin.go.jp/internet/itdb_housei.nsf/html/houritsu/07119730412010.htm
+		//
+		// > 第三条に次の一項を加える。
+		// > ２　「国民の祝日」が日曜日にあたるときは、その翌日を休日とする。
+		case d.Year() < 2007:
+			d = d.Add(24 * time.Hour)
+			if contains(holidays, d.Format(dateLayout)) {
 				continue
 			}
+
+		// 平成十七年法律第四十三号
+		// 国民の祝日に関する法律の一部を改正する法律
+		// 衆議院制定法律: https://www.shugiin.go.jp/internet/itdb_housei.nsf/html/housei/16220050520043.htm
+		// 官報: https://kanpou.npb.go.jp/old/20050520/20050520g00109/20050520g001090005f.html
+		//
+		// > 第三条第二項中「あたるときは、その翌日」を「当たるときは、その日後においてその日に最も近い「国民の祝日」でない日」に改め、
+		// > 同条第三項中「日曜日にあたる日及び前項に規定する休日にあたる日を除く。」を「「国民の祝日」でない日に限る。」に改める。
+		default:
 			d = d.Add(24 * time.Hour)
 			for contains(holidays, d.Format(dateLayout)) {
 				d = d.Add(24 * time.Hour)
 			}
-			holidaysInLieu = append(holidaysInLieu, Holiday{
-				Date: d.Format(dateLayout),
-				Name: "休日",
-			})
 		}
-		holidays = append(holidays, holidaysInLieu...)
-		sort.Sort(withDate(holidays))
+		holidaysInLieu = append(holidaysInLieu, Holiday{
+			Date: d.Format(dateLayout),
+			Name: "休日",
+			Kind: KindSubstitute,
+		})
 	}
-
+	holidays = append(holidays, holidaysInLieu...)
+	SortHolidays(holidays)
 	return holidays
 }
 
+// defaultRuleSet is the rules of the current law.
+var defaultRuleSet = &RuleSet{
+	rules:   annuallyHolidaysRules,
+	special: specialHolidays,
+}
+
+func calcHolidaysInMonthWithoutInLieu(year int, month time.Month) []Holiday {
+	return defaultRuleSet.calcHolidaysInMonthWithoutInLieu(year, month)
+}
+
 func contains(holidays []Holiday, date string) bool {
 	for _, d := range holidays {
 		if d.Date == date {
@@ -391,17 +511,17 @@ func contains(holidays []Holiday, date string) bool {
 	return false
 }
 
-func calcHolidaysInYear(year int) []Holiday {
+func (rs *RuleSet) calcHolidaysInYear(year int) []Holiday {
 	var result []Holiday
 	for month := time.January; month <= time.December; month++ {
-		holidays := calcHolidaysInMonth(year, month)
+		holidays := rs.calcHolidaysInMonth(year, month)
 		result = append(result, holidays...)
 	}
 	return result
 }
 
-func calcHolidaysInRange(from, to Date) []Holiday {
-	if from.cmp(to) > 0 {
+func (rs *RuleSet) calcHolidaysInRange(from, to Date) []Holiday {
+	if from.Compare(to) > 0 {
 		from, to = to, from
 	}
 
@@ -410,8 +530,8 @@ func calcHolidaysInRange(from, to Date) []Holiday {
 	startDate := from.String()
 	endDate := to.String()
 	var result []Holiday
-	for d := from.firstDay(); d.cmp(firstDay) <= 0; d = d.nextMonth() {
-		holidays := calcHolidaysInMonth(d.Year, d.Month)
+	for d := from.firstDay(); d.Compare(firstDay) <= 0; d = d.nextMonth() {
+		holidays := rs.calcHolidaysInMonth(d.Year, d.Month)
 		for _, h := range holidays {
 			if startDate <= h.Date && h.Date <= endDate {
 				result = append(result, h)
@@ -421,72 +541,13 @@ func calcHolidaysInRange(from, to Date) []Holiday {
 	return result
 }
 
-// from 長沢 工(1999) "日の出・日の入りの計算 天体の出没時刻の求め方" 株式会社地人書館
-var sunLongitudeTable = [...][3]float64{
-	{0.0200, 355.05, 719.981},
-	{0.0048, 234.95, 19.341},
-	{0.0020, 247.1, 329.64},
-	{0.0018, 297.8, 4452.67},
-	{0.0018, 251.3, 0.20},
-	{0.0015, 343.2, 450.37},
-	{0.0013, 81.4, 225.18},
-	{0.0008, 132.5, 659.29},
-	{0.0007, 153.3, 90.38},
-	{0.0007, 206.8, 30.35},
-	{0.0006, 29.8, 337.18},
-	{0.0005, 207.4, 1.50},
-	{0.0005, 291.2, 22.81},
-	{0.0004, 234.9, 315.56},
-	{0.0004, 157.3, 299.30},
-	{0.0004, 21.1, 720.02},
-	{0.0003, 352.5, 1079.97},
-	{0.0003, 329.7, 44.43},
-}
-
-// julianYear is a number of julian years from J2000.0(2000/01/01 12:00 Terrestrial Time)
-type julianYear float64
-
-var j2000 = time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC).Unix()
-
-func time2JulianYear(t time.Time) julianYear {
-	d := t.Unix() - j2000
-
-	// convert UTC(Coordinated Universal Time) into TAI(International Atomic Time)
-	d += 36 // TAI - UTC = 36seconds (at 2015/08)
-
-	// convert TAI into TT(Terrestrial Time)
-	d += 32
-	return julianYear(float64(d) / ((365*24 + 6) * 60 * 60))
-}
-
-func sunLongitude(jy julianYear) float64 {
-	t := float64(jy)
-	l := normalizeDegree(360.00769 * t)
-	l = normalizeDegree(l + 280.4603)
-	l = normalizeDegree(l + (1.9146-0.00005*t)*sin(357.538+359.991*t))
-	for _, b := range sunLongitudeTable {
-		l = normalizeDegree(l + b[0]*sin(b[1]+b[2]*t))
-	}
-	return l
-}
-
-func sin(x float64) float64 {
-	return math.Sin(x / 180 * math.Pi)
-}
-
-func normalizeDegree(x float64) float64 {
-	x = math.Mod(x, 360)
-	if x < 0 {
-		x += 360
-	}
-	return x
-}
-
-var jst *time.Location
+// JST is the time zone of Japan, Asia/Tokyo.
+// The packages of this module use it instead of loading the location themselves.
+var JST *time.Location
 
 func init() {
 	var err error
-	jst, err = time.LoadLocation("Asia/Tokyo")
+	JST, err = time.LoadLocation("Asia/Tokyo")
 	if err != nil {
 		panic(err)
 	}
@@ -494,8 +555,8 @@ func init() {
 
 func vernalEquinoxDay(year int) int {
 	for i := 10; i <= 31; i++ {
-		t := time.Date(year, time.March, i, 0, 0, 0, 0, jst)
-		l := sunLongitude(time2JulianYear(t))
+		t := time.Date(year, time.March, i, 0, 0, 0, 0, JST)
+		l := astro.SunLongitude(astro.JulianYearOf(t))
 		if l < 180 {
 			return i - 1
 		}
@@ -505,8 +566,8 @@ func vernalEquinoxDay(year int) int {
 
 func autumnalEquinoxDay(year int) int {
 	for i := 10; i <= 30; i++ {
-		t := time.Date(year, time.September, i, 0, 0, 0, 0, jst)
-		l := sunLongitude(time2JulianYear(t))
+		t := time.Date(year, time.September, i, 0, 0, 0, 0, JST)
+		l := astro.SunLongitude(astro.JulianYearOf(t))
 		if l >= 180 {
 			return i - 1
 		}
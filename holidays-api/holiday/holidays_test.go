@@ -1,105 +1,118 @@
 package holiday
 
 import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 )
 
-func TestFindHoliday(t *testing.T) {
-	h, ok := findHoliday(2000, time.January, 1)
-	if !ok {
-		t.Error("want true, but got false")
-	}
-	if got, want := h.Date, "2000-01-01"; want != got {
+func TestHoliday_String(t *testing.T) {
+	h := Holiday{Date: "2025-01-01", Name: "元日", Kind: KindNational}
+	if got, want := fmt.Sprint(h), "2025-01-01 元日"; got != want {
 		t.Errorf("want %q, got %q", want, got)
 	}
 }
 
-func TestFindHolidaysInMonth(t *testing.T) {
-	got := findHolidaysInMonth(2000, time.January)
-	want := []Holiday{
-		{
-			Date: "2000-01-01",
-			Name: "元日",
+func TestHoliday_LogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return a
 		},
+	}))
+	logger.Info("found", "holiday", Holiday{Date: "2025-01-01", Name: "元日", Kind: KindNational})
+
+	want := "level=INFO msg=found holiday.date=2025-01-01 holiday.name=元日 holiday.kind=national\n"
+	if got := buf.String(); got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b Holiday
+		want int
+	}{
+		{Holiday{Date: "2025-01-01", Name: "元日"}, Holiday{Date: "2025-01-01", Name: "元日"}, 0},
+		{Holiday{Date: "2025-01-01", Name: "元日"}, Holiday{Date: "2025-01-13", Name: "成人の日"}, -1},
+		{Holiday{Date: "2025-01-13", Name: "成人の日"}, Holiday{Date: "2025-01-01", Name: "元日"}, 1},
+		{Holiday{Date: "2025-12-31", Name: "大晦日"}, Holiday{Date: "2025-12-31", Name: "年末年始休業日"}, -1},
 		{
-			Date: "2000-01-10",
-			Name: "成人の日",
+			Holiday{Date: "2025-08-14", Name: "お盆休み", Kind: KindCustom},
+			Holiday{Date: "2025-08-14", Name: "お盆休み", Kind: KindCustomary},
+			-1,
 		},
 	}
-	if diff := cmp.Diff(want, got); diff != "" {
-		t.Errorf("holidays not match: (-want/+got)\n%s", diff)
+	for _, tt := range tests {
+		if got := Compare(tt.a, tt.b); got != tt.want {
+			t.Errorf("Compare(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got, want := tt.a.Equal(tt.b), tt.want == 0; got != want {
+			t.Errorf("%v.Equal(%v) = %t, want %t", tt.a, tt.b, got, want)
+		}
+		if got, want := tt.a.Before(tt.b), tt.want < 0; got != want {
+			t.Errorf("%v.Before(%v) = %t, want %t", tt.a, tt.b, got, want)
+		}
+		if got, want := tt.a.After(tt.b), tt.want > 0; got != want {
+			t.Errorf("%v.After(%v) = %t, want %t", tt.a, tt.b, got, want)
+		}
+	}
+}
+
+func TestDate_Compare(t *testing.T) {
+	tests := []struct {
+		a, b Date
+		want int
+	}{
+		{Date{2025, time.May, 3}, Date{2025, time.May, 3}, 0},
+		{Date{2025, time.May, 3}, Date{2025, time.May, 4}, -1},
+		{Date{2025, time.May, 3}, Date{2025, time.April, 29}, 1},
+		{Date{2024, time.December, 31}, Date{2025, time.January, 1}, -1},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Compare(tt.b); got != tt.want {
+			t.Errorf("%s.Compare(%s) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
 	}
 }
 
-func TestFindHolidaysInYear(t *testing.T) {
-	got := findHolidaysInYear(2000)
+func TestSortHolidays(t *testing.T) {
+	holidays := []Holiday{
+		{Date: "2025-01-13", Name: "成人の日"},
+		{Date: "2025-01-01", Name: "元日"},
+		{Date: "2025-01-01", Name: "初詣", Kind: KindCustom},
+	}
+	SortHolidays(holidays)
 	want := []Holiday{
-		{
-			Date: "2000-01-01",
-			Name: "元日",
-		},
-		{
-			Date: "2000-01-10",
-			Name: "成人の日",
-		},
-		{
-			Date: "2000-02-11",
-			Name: "建国記念の日",
-		},
-		{
-			Date: "2000-03-20",
-			Name: "春分の日",
-		},
-		{
-			Date: "2000-04-29",
-			Name: "みどりの日",
-		},
-		{
-			Date: "2000-05-03",
-			Name: "憲法記念日",
-		},
-		{
-			Date: "2000-05-04",
-			Name: "休日",
-		},
-		{
-			Date: "2000-05-05",
-			Name: "こどもの日",
-		},
-		{
-			Date: "2000-07-20",
-			Name: "海の日",
-		},
-		{
-			Date: "2000-09-15",
-			Name: "敬老の日",
-		},
-		{
-			Date: "2000-09-23",
-			Name: "秋分の日",
-		},
-		{
-			Date: "2000-10-09",
-			Name: "体育の日",
-		},
-		{
-			Date: "2000-11-03",
-			Name: "文化の日",
-		},
-		{
-			Date: "2000-11-23",
-			Name: "勤労感謝の日",
-		},
-		{
-			Date: "2000-12-23",
-			Name: "天皇誕生日",
-		},
+		{Date: "2025-01-01", Name: "元日"},
+		{Date: "2025-01-01", Name: "初詣", Kind: KindCustom},
+		{Date: "2025-01-13", Name: "成人の日"},
 	}
-	if diff := cmp.Diff(want, got); diff != "" {
-		t.Errorf("holidays not match: (-want/+got)\n%s", diff)
+	if diff := cmp.Diff(want, holidays); diff != "" {
+		t.Errorf("SortHolidays() mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestFindHolidaysByMonth(t *testing.T) {
+	got := FindHolidaysByMonth(2024)
+	if len(got) != 12 {
+		t.Fatalf("want 12 months, got %d", len(got))
+	}
+	for month := time.January; month <= time.December; month++ {
+		if diff := cmp.Diff(FindHolidaysInMonth(2024, month), got[month]); diff != "" {
+			t.Errorf("%s: mismatch (-want/+got):\n%s", month, diff)
+		}
+	}
+	if got[time.June] == nil {
+		t.Error("want an empty slice for June, got nil")
 	}
 }
 
@@ -181,6 +194,22 @@ func TestCalcHolidaysInRange(t *testing.T) {
 	})
 }
 
+func TestFindHolidaysInYear_9999(t *testing.T) {
+	// the rules look into the next month, which is out of the four-digit years.
+	got := FindHolidaysInYear(9999)
+	if len(got) == 0 || got[0] != (Holiday{Date: "9999-01-01", Name: "元日"}) {
+		t.Errorf("want 元日 first, got %v", got)
+	}
+	for _, h := range got {
+		if !strings.HasPrefix(h.Date, "9999-") {
+			t.Errorf("want holidays in 9999, got %s", h)
+		}
+	}
+	if got := FindHolidaysInMonth(9999, time.December); len(got) != 0 {
+		t.Errorf("want no holidays in December 9999, got %v", got)
+	}
+}
+
 func TestCalcHolidaysInMonthWithoutInLieu(t *testing.T) {
 	got := calcHolidaysInMonthWithoutInLieu(2022, time.January)
 	want := []Holiday{
@@ -199,7 +228,8 @@ func TestCalcHolidaysInMonthWithoutInLieu(t *testing.T) {
 }
 
 func TestCalcHolidaysInYear(t *testing.T) {
-	for year := holidaysStartYear; year <= holidaysEndYear; year++ {
+	ds := currentDataset()
+	for year := ds.startYear; year <= ds.endYear; year++ {
 		want := findHolidaysInYear(year)
 		got := calcHolidaysInYear(year)
 		if diff := cmp.Diff(want, got); diff != "" {
@@ -207,3 +237,64 @@ func TestCalcHolidaysInYear(t *testing.T) {
 		}
 	}
 }
+
+func TestEquinoxDay(t *testing.T) {
+	tests := []struct {
+		year     int
+		vernal   int
+		autumnal int
+	}{
+		{1979, 21, 24},
+		{2012, 20, 22},
+		{2023, 21, 23},
+		{2025, 20, 23},
+		{2027, 21, 23},
+
+		// 2100 is not a leap year, so the equinoxes move later by a day from 2101.
+		{2096, 19, 22},
+		{2099, 20, 23},
+		{2100, 20, 23},
+		{2101, 21, 23},
+		{2103, 21, 24},
+		{2104, 20, 23},
+
+		// around MaxYear.
+		{2148, 20, 22},
+		{2149, 20, 23},
+		{2150, 21, 23},
+	}
+	for _, tt := range tests {
+		if got := vernalEquinoxDay(tt.year); got != tt.vernal {
+			t.Errorf("vernalEquinoxDay(%d): want %d, got %d", tt.year, tt.vernal, got)
+		}
+		if got := autumnalEquinoxDay(tt.year); got != tt.autumnal {
+			t.Errorf("autumnalEquinoxDay(%d): want %d, got %d", tt.year, tt.autumnal, got)
+		}
+	}
+}
+
+// TestEquinoxDay_Formula compares the equinox days with the approximate formula
+// published in 新こよみ便利帳 (海上保安庁水路部 暦計算研究会), which is valid from 1980 to 2150.
+func TestEquinoxDay_Formula(t *testing.T) {
+	// the autumnal equinox of 2107 is at about 23:40 JST.
+	// the formula gives the next day, but it is only an approximation near midnight.
+	exceptions := map[int]bool{2107: true}
+
+	for year := 1980; year <= MaxYear; year++ {
+		vernal, autumnal := 20.8431, 23.2488
+		if year >= 2100 {
+			vernal, autumnal = 21.8510, 24.2488
+		}
+		n := float64(year - 1980)
+		leap := float64((year - 1980) / 4)
+		if got, want := vernalEquinoxDay(year), int(vernal+0.242194*n-leap); got != want {
+			t.Errorf("vernalEquinoxDay(%d): want %d, got %d", year, want, got)
+		}
+		if exceptions[year] {
+			continue
+		}
+		if got, want := autumnalEquinoxDay(year), int(autumnal+0.242194*n-leap); got != want {
+			t.Errorf("autumnalEquinoxDay(%d): want %d, got %d", year, want, got)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package holiday
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestKind_String(t *testing.T) {
+	tests := []struct {
+		kind Kind
+		want string
+	}{
+		{KindNational, "national"},
+		{KindSubstitute, "substitute"},
+		{KindCitizens, "citizens"},
+		{KindSpecial, "special"},
+		{KindCustom, "custom"},
+		{Kind(-1), "Kind(-1)"},
+		{Kind(100), "Kind(100)"},
+	}
+	for _, tt := range tests {
+		if got := tt.kind.String(); got != tt.want {
+			t.Errorf("Kind(%d).String(): want %q, got %q", int(tt.kind), tt.want, got)
+		}
+	}
+}
+
+func TestKind_IsDayOff(t *testing.T) {
+	for k := KindNational; int(k) < len(kindNames); k++ {
+		if got, want := k.IsDayOff(), k != KindMourning; got != want {
+			t.Errorf("%s: want %t, got %t", k, want, got)
+		}
+	}
+}
+
+func TestCalcHolidaysInMonth_Kind(t *testing.T) {
+	tests := []struct {
+		date string
+		want Kind
+	}{
+		// the day after 憲法記念日 on Sunday is a substitute holiday, not a citizens' holiday.
+		{"1987-05-04", KindSubstitute},
+		{"1992-05-04", KindSubstitute},
+		{"1998-05-04", KindSubstitute},
+
+		// the day between 敬老の日 and 秋分の日 is a citizens' holiday.
+		{"2009-09-22", KindCitizens},
+		{"2015-09-22", KindCitizens},
+
+		// the day after 山の日 on Sunday.
+		{"2024-08-12", KindSubstitute},
+		{"2024-08-11", KindNational},
+	}
+	for _, tt := range tests {
+		d := mustParseDate(tt.date)
+		var found bool
+		for _, h := range calcHolidaysInMonth(d.Year(), d.Month()) {
+			if h.Date != tt.date {
+				continue
+			}
+			found = true
+			if h.Kind != tt.want {
+				t.Errorf("%s: want %s, got %s", tt.date, tt.want, h.Kind)
+			}
+		}
+		if !found {
+			t.Errorf("%s: want a holiday, got none", tt.date)
+		}
+	}
+}
+
+func TestCalcHolidaysInMonth_InMonth(t *testing.T) {
+	for year := 1973; year <= 2050; year++ {
+		for month := time.January; month <= time.December; month++ {
+			prefix := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).Format("2006-01-")
+			for _, h := range calcHolidaysInMonth(year, month) {
+				if !strings.HasPrefix(h.Date, prefix) {
+					t.Errorf("calcHolidaysInMonth(%d, %d) returns %s out of the month", year, month, h.Date)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package holiday
+
+import "time"
+
+// leavePlanMargin is the number of days searched out of the range of PlanLeave,
+// because the rest days just before and after the range extend the streak.
+const leavePlanMargin = 31
+
+// LeavePlan is a plan of paid leave.
+type LeavePlan struct {
+	// Leave are 00:00 of the business days to take leave on, sorted by date.
+	Leave []time.Time
+
+	// Block is the longest rest block made by taking the leave.
+	Block RestBlock
+}
+
+// PlanLeave returns the leave dates between from and to (inclusive) that make the longest rest block
+// with at most days days of paid leave, e.g. for Golden Week.
+// The block may extend out of the range by the rest days around it.
+// If some blocks have the same length, the earliest one is returned.
+func (c *Calendar) PlanLeave(from, to Date, days int) LeavePlan {
+	loc := c.location()
+	start := time.Date(from.Year, from.Month, from.Day, 0, 0, 0, 0, loc)
+	end := time.Date(to.Year, to.Month, to.Day, 0, 0, 0, 0, loc)
+	if end.Before(start) {
+		start, end = end, start
+	}
+	days = max(days, 0)
+
+	// the cost of each day: 0 for rest days, 1 for business days in the range,
+	// and more than days for business days out of the range, where no leave can be taken.
+	first := start.AddDate(0, 0, -leavePlanMargin)
+	last := end.AddDate(0, 0, leavePlanMargin)
+	var dates []time.Time
+	var costs []int
+	for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+		cost := 0
+		if c.IsBusinessDay(d) {
+			cost = 1
+			if d.Before(start) || d.After(end) {
+				cost = days + 1
+			}
+		}
+		dates = append(dates, d)
+		costs = append(costs, cost)
+	}
+	startIdx := leavePlanMargin
+	endIdx := len(dates) - 1 - leavePlanMargin
+
+	// find the longest window whose cost is at most days, by the two pointers.
+	bestLeft, bestRight := -1, -1
+	left, cost := 0, 0
+	for right := range dates {
+		cost += costs[right]
+		for cost > days {
+			cost -= costs[left]
+			left++
+		}
+		if left > right || right < startIdx || left > endIdx {
+			// empty, or out of the range.
+			continue
+		}
+		if bestLeft < 0 || right-left > bestRight-bestLeft {
+			bestLeft, bestRight = left, right
+		}
+	}
+
+	var plan LeavePlan
+	if bestLeft < 0 {
+		return plan
+	}
+	for i := bestLeft; i <= bestRight; i++ {
+		if costs[i] > 0 {
+			plan.Leave = append(plan.Leave, dates[i])
+		}
+	}
+	plan.Block = RestBlock{
+		Start: dates[bestLeft],
+		End:   dates[bestRight],
+		Days:  bestRight - bestLeft + 1,
+	}
+	return plan
+}
+
+// PlanLeave returns the leave dates in JST between from and to (inclusive) that make the longest rest block
+// with at most days days of paid leave.
+func PlanLeave(from, to Date, days int) LeavePlan {
+	return defaultCalendar.PlanLeave(from, to, days)
+}
@@ -0,0 +1,65 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPlanLeave(t *testing.T) {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2025, month, day, 0, 0, 0, 0, JST)
+	}
+	tests := []struct {
+		from, to Date
+		days     int
+		leave    []time.Time
+		start    time.Time
+		end      time.Time
+	}{
+		// Golden Week 2025: 04-29 Tue, 05-03 Sat to 05-06 Tue
+		{
+			from: Date{2025, time.April, 26}, to: Date{2025, time.May, 11}, days: 0,
+			start: date(time.May, 3), end: date(time.May, 6),
+		},
+		{
+			from: Date{2025, time.April, 26}, to: Date{2025, time.May, 11}, days: 3,
+			leave: []time.Time{date(time.May, 7), date(time.May, 8), date(time.May, 9)},
+			start: date(time.May, 3), end: date(time.May, 11),
+		},
+		{
+			from: Date{2025, time.April, 26}, to: Date{2025, time.May, 11}, days: 4,
+			leave: []time.Time{date(time.April, 28), date(time.April, 30), date(time.May, 1), date(time.May, 2)},
+			start: date(time.April, 26), end: date(time.May, 6),
+		},
+		// the rest days out of the range extend the block.
+		{
+			from: Date{2025, time.May, 7}, to: Date{2025, time.May, 9}, days: 3,
+			leave: []time.Time{date(time.May, 7), date(time.May, 8), date(time.May, 9)},
+			start: date(time.May, 3), end: date(time.May, 11),
+		},
+		// no leave can be taken out of the range.
+		{
+			from: Date{2025, time.May, 7}, to: Date{2025, time.May, 7}, days: 3,
+			leave: []time.Time{date(time.May, 7)},
+			start: date(time.May, 3), end: date(time.May, 7),
+		},
+	}
+	for _, tt := range tests {
+		plan := PlanLeave(tt.from, tt.to, tt.days)
+		if len(plan.Leave) != len(tt.leave) {
+			t.Errorf("PlanLeave(%s, %s, %d): want leave %v, got %v", tt.from, tt.to, tt.days, tt.leave, plan.Leave)
+			continue
+		}
+		for i := range tt.leave {
+			if !plan.Leave[i].Equal(tt.leave[i]) {
+				t.Errorf("PlanLeave(%s, %s, %d): want leave %v, got %v", tt.from, tt.to, tt.days, tt.leave, plan.Leave)
+				break
+			}
+		}
+		wantDays := int(tt.end.Sub(tt.start)/(24*time.Hour)) + 1
+		if !plan.Block.Start.Equal(tt.start) || !plan.Block.End.Equal(tt.end) || plan.Block.Days != wantDays {
+			t.Errorf("PlanLeave(%s, %s, %d): want block %s - %s (%d days), got %s - %s (%d days)",
+				tt.from, tt.to, tt.days, tt.start, tt.end, wantDays, plan.Block.Start, plan.Block.End, plan.Block.Days)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package holiday
+
+// the statutory references of holidays
+const (
+	legalBasisNational   = "国民の祝日に関する法律第二条"
+	legalBasisSubstitute = "国民の祝日に関する法律第三条第二項"
+	legalBasisCitizens   = "国民の祝日に関する法律第三条第三項"
+)
+
+// specialLaws are the special laws of one-off holidays.
+var specialLaws = map[string]string{
+	"1959-04-10": "皇太子明仁親王の結婚の儀の行われる日を休日とする法律（昭和三十四年法律第十六号）",
+	"1989-02-24": "昭和天皇の大喪の礼の行われる日を休日とする法律（平成元年法律第四号）",
+	"1990-11-12": "即位礼正殿の儀の行われる日を休日とする法律（平成二年法律第二十四号）",
+	"1993-06-09": "皇太子徳仁親王の結婚の儀の行われる日を休日とする法律（平成五年法律第三十二号）",
+	"2019-05-01": "天皇の即位の日及び即位礼正殿の儀の行われる日を休日とする法律（平成三十年法律第九十九号）",
+	"2019-10-22": "天皇の即位の日及び即位礼正殿の儀の行われる日を休日とする法律（平成三十年法律第九十九号）",
+}
+
+// movedHolidays are the national holidays moved for the Tokyo Olympic and Paralympic Games.
+var movedHolidays = map[string]string{
+	// 平成三十年法律第五十五号
+	"2020-07-23": "東京オリンピック競技大会・東京パラリンピック競技大会特別措置法第三十二条第一項",
+	"2020-07-24": "東京オリンピック競技大会・東京パラリンピック競技大会特別措置法第三十二条第一項",
+	"2020-08-10": "東京オリンピック競技大会・東京パラリンピック競技大会特別措置法第三十二条第一項",
+	// 令和二年法律第六十八号
+	"2021-07-22": "東京オリンピック競技大会・東京パラリンピック競技大会特別措置法第三十二条第二項",
+	"2021-07-23": "東京オリンピック競技大会・東京パラリンピック競技大会特別措置法第三十二条第二項",
+	"2021-08-08": "東京オリンピック競技大会・東京パラリンピック競技大会特別措置法第三十二条第二項",
+}
+
+// LegalBasis returns the statutory reference of the holiday, e.g. "国民の祝日に関する法律第二条".
+// For a one-off holiday, it returns the special law that established it.
+// For a national holiday moved by a special law, it returns the provision of the special law.
+// It returns an empty string for the days that are not legal holidays, such as KindCustom, KindCustomary, and KindMourning.
+func (h Holiday) LegalBasis() string {
+	switch h.Kind {
+	case KindNational:
+		if law, ok := movedHolidays[h.Date]; ok {
+			return law
+		}
+		return legalBasisNational
+	case KindSubstitute:
+		return legalBasisSubstitute
+	case KindCitizens:
+		return legalBasisCitizens
+	case KindSpecial:
+		return specialLaws[h.Date]
+	}
+	return ""
+}
@@ -0,0 +1,42 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLegalBasis(t *testing.T) {
+	tests := []struct {
+		date Date
+		want string
+	}{
+		{Date{2024, time.January, 1}, "国民の祝日に関する法律第二条"},
+		{Date{2024, time.August, 12}, "国民の祝日に関する法律第三条第二項"},
+		{Date{2019, time.April, 30}, "国民の祝日に関する法律第三条第三項"},
+		{Date{2019, time.May, 1}, "天皇の即位の日及び即位礼正殿の儀の行われる日を休日とする法律（平成三十年法律第九十九号）"},
+		{Date{2021, time.July, 23}, "東京オリンピック競技大会・東京パラリンピック競技大会特別措置法第三十二条第二項"},
+	}
+	for _, tt := range tests {
+		h, ok := FindHoliday(tt.date.Year, tt.date.Month, tt.date.Day)
+		if !ok {
+			t.Errorf("%s is not a holiday", tt.date)
+			continue
+		}
+		if got := h.LegalBasis(); got != tt.want {
+			t.Errorf("%s: want %q, got %q", tt.date, tt.want, got)
+		}
+	}
+
+	if got := (Holiday{Date: "2024-08-13", Name: "お盆休み", Kind: KindCustomary}).LegalBasis(); got != "" {
+		t.Errorf("want empty, got %q", got)
+	}
+}
+
+// all legal holidays must have their legal basis.
+func TestLegalBasis_All(t *testing.T) {
+	for _, h := range FindHolidaysInRange(Date{1955, time.January, 1}, Date{2100, time.December, 31}) {
+		if h.LegalBasis() == "" {
+			t.Errorf("%s %s: no legal basis", h.Date, h.Name)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package holiday
+
+import "time"
+
+// DataVersion returns the version of the pre-calculated holidays.
+// It is a prefix of the SHA-256 hash of the source CSV, so it changes whenever the data changes.
+func DataVersion() string {
+	return currentDataset().version
+}
+
+// EarliestOfficialYear is the first year of the holidays published by the Cabinet Office in syukujitsu.csv.
+// The holidays since the year are confirmed by the official data, and the updater rejects the data without them.
+// The holidays_min build calculates the older years from the law, but the results are the same as the data.
+const EarliestOfficialYear = 1955
+
+// CoveredYears returns the range of the years of the pre-calculated holidays (inclusive).
+// The holidays in the years are confirmed by the Cabinet Office.
+// The holidays out of the range are calculated from the current law, so they may change.
+func CoveredYears() (from, to int) {
+	ds := currentDataset()
+	return ds.startYear, ds.endYear
+}
+
+// DataSourceURL returns the URL of the source CSV of the pre-calculated holidays.
+func DataSourceURL() string {
+	return currentDataset().sourceURL
+}
+
+// DataGeneratedAt returns the time when the pre-calculated holidays were generated from the source CSV.
+// It returns the zero time if the package is built with the holidays_csv tag.
+func DataGeneratedAt() time.Time {
+	generatedAt := currentDataset().generatedAt
+	if generatedAt == "" {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339, generatedAt)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
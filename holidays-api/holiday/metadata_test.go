@@ -0,0 +1,36 @@
+package holiday
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+)
+
+func TestMetadata(t *testing.T) {
+	if !regexp.MustCompile(`^[0-9a-f]{16}$`).MatchString(DataVersion()) {
+		t.Errorf("unexpected DataVersion: %q", DataVersion())
+	}
+	if DataSourceURL() == "" {
+		t.Error("DataSourceURL is empty")
+	}
+	// the time is unknown if the data is parsed from the embedded csv.
+	if currentDataset().generatedAt != "" && DataGeneratedAt().IsZero() {
+		t.Error("DataGeneratedAt is zero")
+	}
+}
+
+func TestCoveredYears(t *testing.T) {
+	from, to := CoveredYears()
+	if from > to {
+		t.Fatalf("invalid range: %d-%d", from, to)
+	}
+	if first := FindHolidaysInYear(from)[0].Date; first != fmt.Sprintf("%04d-01-01", from) {
+		t.Errorf("want the first holiday on January 1st, got %s", first)
+	}
+	if len(findHolidaysInYear(to)) == 0 {
+		t.Errorf("no pre-calculated holidays in %d", to)
+	}
+	if len(findHolidaysInYear(to+1)) != 0 {
+		t.Errorf("unexpected pre-calculated holidays in %d", to+1)
+	}
+}
@@ -0,0 +1,30 @@
+package holiday
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// NormalizeName returns the name of a holiday in Unicode NFC,
+// which is the form of the names in the dataset.
+func NormalizeName(name string) string {
+	return norm.NFC.String(name)
+}
+
+// foldName returns the name in Unicode NFKC, which unifies the full-width and half-width variants,
+// e.g. "（" and "(", "ｽﾎﾟｰﾂ" and "スポーツ".
+func foldName(name string) string {
+	return norm.NFKC.String(name)
+}
+
+// EqualName reports whether the names of holidays are the same after Unicode NFKC normalization.
+// For example, "体育の日（スポーツの日）" equals "体育の日(スポーツの日)".
+func EqualName(a, b string) bool {
+	return a == b || foldName(a) == foldName(b)
+}
+
+// ContainsName reports whether name contains substr after Unicode NFKC normalization.
+func ContainsName(name, substr string) bool {
+	return strings.Contains(foldName(name), foldName(substr))
+}
@@ -0,0 +1,55 @@
+package holiday
+
+import "testing"
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"元日", "元日"},
+		// decomposed "ホ" + U+309A COMBINING KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK
+		{"ス\u30db\u309aーツの日", "スポーツの日"},
+		// NFC keeps the full-width and half-width variants.
+		{"休日(祝日扱い)", "休日(祝日扱い)"},
+	}
+	for _, tt := range tests {
+		if got := NormalizeName(tt.name); got != tt.want {
+			t.Errorf("NormalizeName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestEqualName(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"元日", "元日", true},
+		{"体育の日（スポーツの日）", "体育の日(スポーツの日)", true},
+		{"スポーツの日", "ｽﾎﾟｰﾂの日", true},
+		{"スポーツの日", "体育の日", false},
+	}
+	for _, tt := range tests {
+		if got := EqualName(tt.a, tt.b); got != tt.want {
+			t.Errorf("EqualName(%q, %q) = %t, want %t", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContainsName(t *testing.T) {
+	tests := []struct {
+		name, substr string
+		want         bool
+	}{
+		{"休日（祝日扱い）", "祝日扱い", true},
+		{"休日（祝日扱い）", "(祝日扱い)", true},
+		{"スポーツの日", "ｽﾎﾟｰﾂ", true},
+		{"スポーツの日", "体育", false},
+	}
+	for _, tt := range tests {
+		if got := ContainsName(tt.name, tt.substr); got != tt.want {
+			t.Errorf("ContainsName(%q, %q) = %t, want %t", tt.name, tt.substr, got, tt.want)
+		}
+	}
+}
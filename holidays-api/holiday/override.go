@@ -0,0 +1,200 @@
+package holiday
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// overrideEnv is the environment variable of the path to the override file loaded at startup.
+const overrideEnv = "HOLIDAYS_JP_DATA"
+
+func init() {
+	if path := os.Getenv(overrideEnv); path != "" {
+		if err := LoadOverrides(path); err != nil {
+			log.Printf("holiday: failed to load %s=%s: %v", overrideEnv, path, err)
+		}
+	}
+}
+
+// override is an entry of the override file.
+type override struct {
+	date Date
+	name string
+	kind Kind
+
+	// remove is true if the name is empty.
+	remove bool
+}
+
+var (
+	// fetchedDataset is the dataset fetched by Update. It is nil if Update has never succeeded.
+	fetchedDataset atomic.Pointer[dataset]
+
+	// overrides are loaded by LoadOverrides.
+	overrides atomic.Pointer[[]override]
+
+	// reloadMu serializes the reloads of loadedDataset.
+	reloadMu sync.Mutex
+)
+
+// LoadOverrides loads the file at path, and merges the entries into the holidays.
+// The environment variable HOLIDAYS_JP_DATA is loaded at startup in the same way.
+//
+// The file is a UTF-8 CSV without a header. Each line is "date,name" or "date,name,kind":
+//
+//	# 2025-01-01 is 元日 as usual, but named differently.
+//	2025-01-01,元日（訂正）
+//	# a new one-off holiday
+//	2030/5/1,即位の日,special
+//	# remove the holiday on the day
+//	2030-01-01,
+//
+// The date is in the formats accepted by ParseDate, and the kind is the same as Kind.String (national by default).
+// An empty name removes the holiday on the day. Lines beginning with # are comments.
+//
+// The entries replace the ones loaded previously. The holidays calculated from the law in the years
+// between the pre-calculated holidays and the entries are also materialized,
+// so the entries out of the range of CoveredYears are respected.
+func LoadOverrides(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	ovs, err := parseOverrides(f)
+	if err != nil {
+		return fmt.Errorf("holiday: failed to parse %s: %w", path, err)
+	}
+	overrides.Store(&ovs)
+	reloadDataset()
+	return nil
+}
+
+func parseOverrides(r io.Reader) ([]override, error) {
+	csvReader := csv.NewReader(r)
+	csvReader.Comment = '#'
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+
+	var ovs []override
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) < 2 || len(record) > 3 {
+			return nil, fmt.Errorf("unexpected record: %q", record)
+		}
+		d, err := ParseDate(strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, err
+		}
+		ov := override{
+			date:   d,
+			name:   NormalizeName(strings.TrimSpace(record[1])),
+			kind:   KindNational,
+			remove: strings.TrimSpace(record[1]) == "",
+		}
+		if len(record) == 3 {
+			kind, ok := parseKind(strings.TrimSpace(record[2]))
+			if !ok {
+				return nil, fmt.Errorf("unknown kind: %q", record[2])
+			}
+			ov.kind = kind
+		}
+		ovs = append(ovs, ov)
+	}
+	return ovs, nil
+}
+
+func parseKind(s string) (Kind, bool) {
+	i := slices.Index(kindNames[:], s)
+	if i < 0 {
+		return 0, false
+	}
+	return Kind(i), true
+}
+
+// reloadDataset merges the fetched or built-in dataset and the overrides into loadedDataset.
+func reloadDataset() {
+	reloadMu.Lock()
+	defer reloadMu.Unlock()
+
+	base := fetchedDataset.Load()
+	if base == nil {
+		base = builtinDataset()
+	}
+	ovs := overrides.Load()
+	if ovs == nil || len(*ovs) == 0 {
+		if base == builtinDataset() {
+			loadedDataset.Store(nil)
+		} else {
+			loadedDataset.Store(base)
+		}
+		return
+	}
+	loadedDataset.Store(base.withOverrides(*ovs))
+}
+
+// withOverrides returns a new dataset with the overrides applied.
+func (ds *dataset) withOverrides(ovs []override) *dataset {
+	startYear, endYear := ds.startYear, ds.endYear
+	for _, ov := range ovs {
+		startYear = min(startYear, ov.date.Year)
+		endYear = max(endYear, ov.date.Year)
+	}
+
+	// materialize the calculated holidays out of the range of ds.
+	byDate := make(map[string]Holiday, len(ds.holidays))
+	for _, h := range ds.holidays {
+		byDate[h.Date] = h
+	}
+	for year := startYear; year <= endYear; year++ {
+		if ds.covers(year, year) {
+			continue
+		}
+		for _, h := range calcHolidaysInYear(year) {
+			byDate[h.Date] = h
+		}
+	}
+
+	for _, ov := range ovs {
+		date := ov.date.String()
+		if ov.remove {
+			delete(byDate, date)
+			continue
+		}
+		byDate[date] = Holiday{
+			Date: date,
+			Name: ov.name,
+			Kind: ov.kind,
+		}
+	}
+
+	holidays := make([]Holiday, 0, len(byDate))
+	for _, h := range byDate {
+		holidays = append(holidays, h)
+	}
+	SortHolidays(holidays)
+
+	return &dataset{
+		holidays:    holidays,
+		startYear:   startYear,
+		endYear:     endYear,
+		version:     ds.version,
+		sourceURL:   ds.sourceURL,
+		generatedAt: ds.generatedAt,
+	}
+}
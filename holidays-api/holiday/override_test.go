@@ -0,0 +1,100 @@
+package holiday
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseOverrides(t *testing.T) {
+	input := `# comment
+2025-01-01,元日（訂正）
+2030/5/1, 即位の日, special
+20300101,
+`
+	got, err := parseOverrides(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []override{
+		{date: Date{2025, time.January, 1}, name: "元日（訂正）", kind: KindNational},
+		{date: Date{2030, time.May, 1}, name: "即位の日", kind: KindSpecial},
+		{date: Date{2030, time.January, 1}, kind: KindNational, remove: true},
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(override{})); diff != "" {
+		t.Errorf("parseOverrides() mismatch (-want/+got):\n%s", diff)
+	}
+
+	for _, input := range []string{
+		"2025-01-01\n",
+		"2025-02-30,invalid\n",
+		"2025-01-01,元日,unknown\n",
+		"2025-01-01,元日,national,extra\n",
+	} {
+		if _, err := parseOverrides(strings.NewReader(input)); err == nil {
+			t.Errorf("%q: want error, got nil", input)
+		}
+	}
+}
+
+func TestLoadOverrides(t *testing.T) {
+	t.Cleanup(func() {
+		overrides.Store(nil)
+		reloadDataset()
+	})
+
+	_, end := CoveredYears()
+	future := end + 3
+
+	path := filepath.Join(t.TempDir(), "extra.csv")
+	content := "2024-08-11,山の日（訂正）\n" +
+		"2024-08-12,\n" +
+		Date{future, time.May, 1}.String() + ",特別な休日,special\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadOverrides(path); err != nil {
+		t.Fatal(err)
+	}
+
+	got := FindHolidaysInRange(Date{2024, time.August, 1}, Date{2024, time.August, 31})
+	want := []Holiday{
+		{Date: "2024-08-11", Name: "山の日（訂正）"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FindHolidaysInRange() mismatch (-want/+got):\n%s", diff)
+	}
+
+	// the calculated holidays are kept in the years out of the original range.
+	if h, ok := FindHoliday(future, time.May, 1); !ok || h.Name != "特別な休日" {
+		t.Errorf("want 特別な休日, got %v, %t", h, ok)
+	}
+	if h, ok := FindHoliday(future, time.May, 3); !ok || h.Name != "憲法記念日" {
+		t.Errorf("want 憲法記念日, got %v, %t", h, ok)
+	}
+	if _, to := CoveredYears(); to != future {
+		t.Errorf("want covered until %d, got %d", future, to)
+	}
+
+	// loading again replaces the overrides.
+	if err := os.WriteFile(path, []byte("# nothing\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadOverrides(path); err != nil {
+		t.Fatal(err)
+	}
+	if h, ok := FindHoliday(2024, time.August, 12); !ok || h.Name != "休日" {
+		t.Errorf("want 休日, got %v, %t", h, ok)
+	}
+	if _, to := CoveredYears(); to != end {
+		t.Errorf("want covered until %d, got %d", end, to)
+	}
+
+	if err := LoadOverrides(filepath.Join(t.TempDir(), "not-found.csv")); err == nil {
+		t.Error("want error, got nil")
+	}
+}
@@ -0,0 +1,103 @@
+package holiday
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidDate reports that a date is malformed or does not exist.
+var ErrInvalidDate = errors.New("holiday: invalid date")
+
+// ParseError is returned by ParseDate when the string is not a date.
+// It wraps ErrInvalidDate, so errors.Is(err, ErrInvalidDate) reports true.
+type ParseError struct {
+	// Input is the string passed to ParseDate.
+	Input string
+
+	// Reason describes the problem.
+	Reason string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("holiday: cannot parse %q as a date: %s", e.Input, e.Reason)
+}
+
+func (e *ParseError) Unwrap() error {
+	return ErrInvalidDate
+}
+
+// ParseDate parses a date in one of the following formats:
+//
+//   - 2006-01-02 or 2006-1-2
+//   - 2006/01/02 or 2006/1/2
+//   - 20060102
+//
+// It returns a *ParseError if s is not in the formats, or the date does not exist, e.g. 2025-02-30.
+func ParseDate(s string) (Date, error) {
+	var y, m, d string
+	switch {
+	case strings.Contains(s, "-"):
+		y, m, d = split3(s, "-")
+	case strings.Contains(s, "/"):
+		y, m, d = split3(s, "/")
+	case len(s) == 8:
+		y, m, d = s[:4], s[4:6], s[6:]
+	}
+	if len(y) != 4 || len(m) < 1 || len(m) > 2 || len(d) < 1 || len(d) > 2 {
+		return Date{}, &ParseError{Input: s, Reason: "unknown format"}
+	}
+
+	year, ok := parseDigits(y)
+	if !ok || year < 1 {
+		return Date{}, &ParseError{Input: s, Reason: "invalid year"}
+	}
+	month, ok := parseDigits(m)
+	if !ok || month < 1 || month > 12 {
+		return Date{}, &ParseError{Input: s, Reason: "month out of range"}
+	}
+	day, ok := parseDigits(d)
+	if !ok || day < 1 || day > daysIn(year, time.Month(month)) {
+		return Date{}, &ParseError{Input: s, Reason: "day out of range"}
+	}
+	return Date{year, time.Month(month), day}, nil
+}
+
+// FindHolidayString is same as FindHoliday, but the day is given as a string in the formats accepted by ParseDate.
+func FindHolidayString(s string) (Holiday, bool, error) {
+	d, err := ParseDate(s)
+	if err != nil {
+		return Holiday{}, false, err
+	}
+	h, ok := FindHoliday(d.Year, d.Month, d.Day)
+	return h, ok, nil
+}
+
+// split3 splits s into three parts by sep.
+// It returns empty strings if s doesn't have exactly three parts.
+func split3(s, sep string) (a, b, c string) {
+	parts := strings.Split(s, sep)
+	if len(parts) != 3 {
+		return "", "", ""
+	}
+	return parts[0], parts[1], parts[2]
+}
+
+// parseDigits parses a string that consists of only ASCII digits.
+// Unlike strconv.Atoi, it rejects signs.
+func parseDigits(s string) (int, bool) {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return 0, false
+		}
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil
+}
+
+// daysIn returns the number of days in the month.
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
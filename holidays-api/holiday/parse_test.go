@@ -0,0 +1,76 @@
+package holiday
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Date
+	}{
+		{"2025-01-01", Date{2025, time.January, 1}},
+		{"2025-1-1", Date{2025, time.January, 1}},
+		{"2025/01/01", Date{2025, time.January, 1}},
+		{"2025/1/1", Date{2025, time.January, 1}},
+		{"20250101", Date{2025, time.January, 1}},
+		{"2024-02-29", Date{2024, time.February, 29}},
+	}
+	for _, tt := range tests {
+		got, err := ParseDate(tt.input)
+		if err != nil {
+			t.Errorf("ParseDate(%q): unexpected error: %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseDate(%q): want %s, got %s", tt.input, tt.want, got)
+		}
+	}
+}
+
+func TestParseDate_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"garbage",
+		"2025",
+		"2025-01",
+		"2025-01-01-01",
+		"2025/01-01",
+		"25-01-01",
+		"2025-001-01",
+		"2025-+1-01",
+		"2025-13-01",
+		"2025-02-29",
+		"2025-01-00",
+		"2025013",
+		"202501011",
+		"2025O101",
+	}
+	for _, input := range tests {
+		_, err := ParseDate(input)
+		var perr *ParseError
+		if !errors.As(err, &perr) {
+			t.Errorf("ParseDate(%q): want *ParseError, got %v", input, err)
+			continue
+		}
+		if !errors.Is(err, ErrInvalidDate) {
+			t.Errorf("ParseDate(%q): want ErrInvalidDate, got %v", input, err)
+		}
+	}
+}
+
+func TestFindHolidayString(t *testing.T) {
+	h, ok, err := FindHolidayString("2025/1/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok || h.Name != "元日" {
+		t.Errorf("want 元日, got %v, %t", h, ok)
+	}
+
+	if _, _, err := FindHolidayString("2025/1/32"); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("want ErrInvalidDate, got %v", err)
+	}
+}
@@ -0,0 +1,121 @@
+package holiday
+
+import "time"
+
+// maxRestBlockDays is the max length of a rest block to search.
+// It prevents infinite loops on calendars without business days.
+const maxRestBlockDays = 366
+
+// RestBlock is a run of consecutive days that are not business days,
+// such as weekends, 3連休 and Golden Week.
+type RestBlock struct {
+	// Start and End are 00:00 of the first and the last day of the block (inclusive).
+	Start time.Time
+	End   time.Time
+
+	// Days is the number of the days in the block, e.g. 3 for 3連休.
+	Days int
+}
+
+// RestBlockOf returns the rest block that the day of t belongs to, and the position of the day in the block starting at 1.
+// For example, it returns the block from 2025-05-03 to 2025-05-06 and 1 on 2025-05-03, which is 4連休初日.
+// It returns false if the day is a business day.
+func (c *Calendar) RestBlockOf(t time.Time) (RestBlock, int, bool) {
+	t = t.In(c.location())
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, c.location())
+	if c.IsBusinessDay(day) {
+		return RestBlock{}, 0, false
+	}
+
+	start := day
+	for i := 0; i < maxRestBlockDays; i++ {
+		prev := start.AddDate(0, 0, -1)
+		if c.IsBusinessDay(prev) {
+			break
+		}
+		start = prev
+	}
+	end := day
+	for i := 0; i < maxRestBlockDays; i++ {
+		next := end.AddDate(0, 0, 1)
+		if c.IsBusinessDay(next) {
+			break
+		}
+		end = next
+	}
+
+	block := RestBlock{
+		Start: start,
+		End:   end,
+		Days:  daysBetween(dateOf(start), dateOf(end)) + 1,
+	}
+	return block, daysBetween(dateOf(start), dateOf(day)) + 1, true
+}
+
+// IsLongWeekend reports whether the day of t belongs to a rest block of 3 days or more, e.g. 3連休.
+func (c *Calendar) IsLongWeekend(t time.Time) bool {
+	block, _, ok := c.RestBlockOf(t)
+	return ok && block.Days >= 3
+}
+
+// RestBlockOf returns the rest block that the day of t in JST belongs to, and the position of the day in the block starting at 1.
+// It returns false if the day is a business day.
+func RestBlockOf(t time.Time) (RestBlock, int, bool) {
+	return defaultCalendar.RestBlockOf(t)
+}
+
+// IsLongWeekend reports whether the day of t in JST belongs to a rest block of 3 days or more, e.g. 3連休.
+func IsLongWeekend(t time.Time) bool {
+	return defaultCalendar.IsLongWeekend(t)
+}
+
+// BridgeDay is a single business day between rest days, e.g. 飛び石連休.
+// Taking leave on the day joins the rest days before and after it.
+type BridgeDay struct {
+	// Date is 00:00 of the business day.
+	Date time.Time
+
+	// Block is the rest block made by taking leave on the day.
+	Block RestBlock
+}
+
+// BridgeDaysInRange returns the bridge days between from and to (inclusive), sorted by date.
+// For example, 2025-04-28 Mon between the weekend and 昭和の日 is a bridge day,
+// and taking leave on it makes 4連休 from 2025-04-26 to 2025-04-29.
+func (c *Calendar) BridgeDaysInRange(from, to Date) []BridgeDay {
+	loc := c.location()
+	start := time.Date(from.Year, from.Month, from.Day, 0, 0, 0, 0, loc)
+	end := time.Date(to.Year, to.Month, to.Day, 0, 0, 0, 0, loc)
+	if end.Before(start) {
+		start, end = end, start
+	}
+
+	var result []BridgeDay
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		if !c.IsBusinessDay(d) {
+			continue
+		}
+		before, _, ok := c.RestBlockOf(d.AddDate(0, 0, -1))
+		if !ok {
+			continue
+		}
+		after, _, ok := c.RestBlockOf(d.AddDate(0, 0, 1))
+		if !ok {
+			continue
+		}
+		result = append(result, BridgeDay{
+			Date: d,
+			Block: RestBlock{
+				Start: before.Start,
+				End:   after.End,
+				Days:  before.Days + 1 + after.Days,
+			},
+		})
+	}
+	return result
+}
+
+// FindBridgeDaysInRange returns the bridge days in JST between from and to (inclusive), sorted by date.
+func FindBridgeDaysInRange(from, to Date) []BridgeDay {
+	return defaultCalendar.BridgeDaysInRange(from, to)
+}
@@ -0,0 +1,93 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRestBlockOf(t *testing.T) {
+	tests := []struct {
+		t        time.Time
+		start    time.Time
+		end      time.Time
+		days     int
+		position int
+		ok       bool
+		long     bool
+	}{
+		// Golden Week 2025: 05-03 Sat to 05-06 Tue (substitute holiday)
+		{
+			t:     time.Date(2025, time.May, 3, 12, 0, 0, 0, JST),
+			start: time.Date(2025, time.May, 3, 0, 0, 0, 0, JST), end: time.Date(2025, time.May, 6, 0, 0, 0, 0, JST),
+			days: 4, position: 1, ok: true, long: true,
+		},
+		{
+			t:     time.Date(2025, time.May, 6, 23, 59, 0, 0, JST),
+			start: time.Date(2025, time.May, 3, 0, 0, 0, 0, JST), end: time.Date(2025, time.May, 6, 0, 0, 0, 0, JST),
+			days: 4, position: 4, ok: true, long: true,
+		},
+		// 成人の日 2025: 01-11 Sat to 01-13 Mon
+		{
+			t:     time.Date(2025, time.January, 12, 0, 0, 0, 0, JST),
+			start: time.Date(2025, time.January, 11, 0, 0, 0, 0, JST), end: time.Date(2025, time.January, 13, 0, 0, 0, 0, JST),
+			days: 3, position: 2, ok: true, long: true,
+		},
+		// a normal weekend
+		{
+			t:     time.Date(2025, time.January, 18, 0, 0, 0, 0, JST),
+			start: time.Date(2025, time.January, 18, 0, 0, 0, 0, JST), end: time.Date(2025, time.January, 19, 0, 0, 0, 0, JST),
+			days: 2, position: 1, ok: true, long: false,
+		},
+		// a holiday in the middle of the week: 2025-04-29 Tue
+		{
+			t:     time.Date(2025, time.April, 29, 0, 0, 0, 0, JST),
+			start: time.Date(2025, time.April, 29, 0, 0, 0, 0, JST), end: time.Date(2025, time.April, 29, 0, 0, 0, 0, JST),
+			days: 1, position: 1, ok: true, long: false,
+		},
+		// business day
+		{
+			t:  time.Date(2025, time.May, 7, 0, 0, 0, 0, JST),
+			ok: false,
+		},
+	}
+	for _, tt := range tests {
+		block, position, ok := RestBlockOf(tt.t)
+		if ok != tt.ok {
+			t.Errorf("RestBlockOf(%s): want ok %t, got %t", tt.t, tt.ok, ok)
+			continue
+		}
+		if ok && (!block.Start.Equal(tt.start) || !block.End.Equal(tt.end) || block.Days != tt.days || position != tt.position) {
+			t.Errorf("RestBlockOf(%s): want (%s - %s, %d days, #%d), got (%s - %s, %d days, #%d)",
+				tt.t, tt.start, tt.end, tt.days, tt.position, block.Start, block.End, block.Days, position)
+		}
+		if got := IsLongWeekend(tt.t); got != tt.long {
+			t.Errorf("IsLongWeekend(%s): want %t, got %t", tt.t, tt.long, got)
+		}
+	}
+}
+
+func TestFindBridgeDaysInRange(t *testing.T) {
+	got := FindBridgeDaysInRange(Date{2025, time.April, 1}, Date{2025, time.May, 31})
+	if len(got) != 1 {
+		t.Fatalf("want 1 bridge day, got %d", len(got))
+	}
+	want := BridgeDay{
+		Date: time.Date(2025, time.April, 28, 0, 0, 0, 0, JST),
+		Block: RestBlock{
+			Start: time.Date(2025, time.April, 26, 0, 0, 0, 0, JST),
+			End:   time.Date(2025, time.April, 29, 0, 0, 0, 0, JST),
+			Days:  4,
+		},
+	}
+	if !got[0].Date.Equal(want.Date) || !got[0].Block.Start.Equal(want.Block.Start) || !got[0].Block.End.Equal(want.Block.End) || got[0].Block.Days != want.Block.Days {
+		t.Errorf("want %v, got %v", want, got[0])
+	}
+
+	// the range is inclusive, and may be reversed.
+	if got := FindBridgeDaysInRange(Date{2025, time.April, 28}, Date{2025, time.April, 28}); len(got) != 1 {
+		t.Errorf("want 1 bridge day, got %d", len(got))
+	}
+	if got := FindBridgeDaysInRange(Date{2025, time.May, 31}, Date{2025, time.April, 1}); len(got) != 1 {
+		t.Errorf("want 1 bridge day, got %d", len(got))
+	}
+}
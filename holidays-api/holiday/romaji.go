@@ -0,0 +1,84 @@
+package holiday
+
+import "slices"
+
+// romajiNames are the names of holidays in the modified Hepburn romanization.
+// Long vowels are written without macrons, so that the names are in ASCII.
+var romajiNames = map[string]string{
+	"元日":           "Ganjitsu",
+	"成人の日":         "Seijin no Hi",
+	"建国記念の日":       "Kenkoku Kinen no Hi",
+	"天皇誕生日":        "Tenno Tanjobi",
+	"春分の日":         "Shunbun no Hi",
+	"昭和の日":         "Showa no Hi",
+	"憲法記念日":        "Kenpo Kinenbi",
+	"みどりの日":        "Midori no Hi",
+	"こどもの日":        "Kodomo no Hi",
+	"海の日":          "Umi no Hi",
+	"山の日":          "Yama no Hi",
+	"敬老の日":         "Keiro no Hi",
+	"秋分の日":         "Shubun no Hi",
+	"体育の日":         "Taiiku no Hi",
+	"スポーツの日":       "Supotsu no Hi",
+	"文化の日":         "Bunka no Hi",
+	"勤労感謝の日":       "Kinro Kansha no Hi",
+	"休日":           "Kyujitsu",
+	"休日（祝日扱い）":     "Kyujitsu (Shukujitsu Atsukai)",
+	"結婚の儀":         "Kekkon no Gi",
+	"大喪の礼":         "Taiso no Rei",
+	"即位礼正殿の儀":      "Sokuirei Seiden no Gi",
+	"体育の日（スポーツの日）": "Taiiku no Hi (Supotsu no Hi)",
+}
+
+// Romanize returns the name of the holiday in the modified Hepburn romanization, e.g. "Kenkoku Kinen no Hi".
+// Long vowels are written without macrons, so the result is in ASCII.
+// It returns false if the name is not a statutory holiday.
+func Romanize(name string) (string, bool) {
+	romaji, ok := romajiNames[name]
+	return romaji, ok
+}
+
+// englishNames are the names of holidays in English used by the government.
+var englishNames = map[string]string{
+	"元日":           "New Year's Day",
+	"成人の日":         "Coming of Age Day",
+	"建国記念の日":       "National Foundation Day",
+	"天皇誕生日":        "The Emperor's Birthday",
+	"春分の日":         "Vernal Equinox Day",
+	"昭和の日":         "Showa Day",
+	"憲法記念日":        "Constitution Memorial Day",
+	"みどりの日":        "Greenery Day",
+	"こどもの日":        "Children's Day",
+	"海の日":          "Marine Day",
+	"山の日":          "Mountain Day",
+	"敬老の日":         "Respect for the Aged Day",
+	"秋分の日":         "Autumnal Equinox Day",
+	"体育の日":         "Health and Sports Day",
+	"スポーツの日":       "Sports Day",
+	"文化の日":         "Culture Day",
+	"勤労感謝の日":       "Labor Thanksgiving Day",
+	"休日":           "Holiday",
+	"休日（祝日扱い）":     "National Holiday",
+	"結婚の儀":         "The Rite of Wedding",
+	"大喪の礼":         "The Funeral Ceremony of Emperor Showa",
+	"即位礼正殿の儀":      "The Ceremony of the Enthronement",
+	"体育の日（スポーツの日）": "Health and Sports Day",
+}
+
+// EnglishName returns the name of the holiday in English, e.g. "National Foundation Day".
+// It returns false if the name is not a statutory holiday.
+func EnglishName(name string) (string, bool) {
+	en, ok := englishNames[name]
+	return en, ok
+}
+
+// StatutoryNames returns the names of all statutory holidays sorted in Unicode order,
+// which are translated by Romanize and EnglishName.
+func StatutoryNames() []string {
+	names := make([]string, 0, len(romajiNames))
+	for name := range romajiNames {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,67 @@
+package holiday
+
+import "testing"
+
+func TestRomanize(t *testing.T) {
+	got, ok := Romanize("建国記念の日")
+	if !ok || got != "Kenkoku Kinen no Hi" {
+		t.Errorf("want (%q, true), got (%q, %t)", "Kenkoku Kinen no Hi", got, ok)
+	}
+	if _, ok := Romanize("都民の日"); ok {
+		t.Error("want false, got true")
+	}
+}
+
+func TestRomanize_AllHolidays(t *testing.T) {
+	names := map[string]bool{}
+	for _, h := range currentDataset().holidays {
+		names[h.Name] = true
+	}
+	for year := 1948; year <= 2100; year++ {
+		for _, h := range calcHolidaysInYear(year) {
+			names[h.Name] = true
+		}
+	}
+
+	for name := range names {
+		romaji, ok := Romanize(name)
+		if !ok {
+			t.Errorf("%s has no romanization", name)
+			continue
+		}
+		for _, r := range romaji {
+			if r >= 0x80 {
+				t.Errorf("%s: non-ASCII romanization %q", name, romaji)
+				break
+			}
+		}
+	}
+}
+
+func TestEnglishName(t *testing.T) {
+	got, ok := EnglishName("建国記念の日")
+	if !ok || got != "National Foundation Day" {
+		t.Errorf("want (%q, true), got (%q, %t)", "National Foundation Day", got, ok)
+	}
+	if _, ok := EnglishName("都民の日"); ok {
+		t.Error("want false, got true")
+	}
+}
+
+func TestStatutoryNames(t *testing.T) {
+	names := StatutoryNames()
+	if len(names) == 0 {
+		t.Fatal("no names")
+	}
+	for i, name := range names {
+		if i > 0 && names[i-1] >= name {
+			t.Errorf("the names are not sorted: %q, %q", names[i-1], name)
+		}
+		if _, ok := Romanize(name); !ok {
+			t.Errorf("%s has no romanization", name)
+		}
+		if _, ok := EnglishName(name); !ok {
+			t.Errorf("%s has no English name", name)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package holiday
+
+import (
+	"slices"
+	"time"
+)
+
+// Rule is the holidays defined by the Act on National Holidays in a period.
+type Rule struct {
+	// BeginYear is the first year when the rule is in effect.
+	BeginYear int
+
+	// EndYear is the last year when the rule is in effect.
+	// It is zero if the rule is still in effect.
+	EndYear int
+
+	// Fixed are the holidays on the same date every year, e.g. 元日.
+	Fixed []FixedHoliday
+
+	// Weekday are the holidays on the n-th weekday of the month, e.g. 成人の日.
+	Weekday []WeekdayHoliday
+
+	// VernalEquinoxDay and AutumnalEquinoxDay report whether 春分の日 and 秋分の日 are holidays.
+	VernalEquinoxDay   bool
+	AutumnalEquinoxDay bool
+}
+
+// FixedHoliday is a holiday on the same date every year.
+type FixedHoliday struct {
+	Month time.Month
+	Day   int
+	Name  string
+}
+
+// WeekdayHoliday is a holiday on the N-th weekday of the month, e.g. N = 2 and Weekday = time.Monday for the second Monday.
+type WeekdayHoliday struct {
+	Month   time.Month
+	Weekday time.Weekday
+	N       int
+	Name    string
+}
+
+// Rules returns the rules of the current law, sorted by BeginYear.
+// The returned rules are copies, so modifying them doesn't affect the package.
+func Rules() []Rule {
+	return defaultRuleSet.Rules()
+}
+
+// SpecialHolidays returns the one-off holidays established by special laws, sorted by date.
+func SpecialHolidays() []Holiday {
+	return defaultRuleSet.SpecialHolidays()
+}
+
+// Rules returns the rules of rs, sorted by BeginYear.
+func (rs *RuleSet) Rules() []Rule {
+	result := make([]Rule, 0, len(rs.rules))
+	// rs.rules are sorted in descending order.
+	for i := len(rs.rules) - 1; i >= 0; i-- {
+		r := rs.rules[i]
+		rule := Rule{
+			BeginYear:          r.BeginYear,
+			VernalEquinoxDay:   !r.NoVernalEquinoxDay,
+			AutumnalEquinoxDay: !r.NoAutumnalEquinoxDay,
+		}
+		if i > 0 {
+			rule.EndYear = rs.rules[i-1].BeginYear - 1
+		}
+		for _, h := range r.StaticHolydays {
+			d := mustParseDate("2001-" + h.Date)
+			rule.Fixed = append(rule.Fixed, FixedHoliday{
+				Month: d.Month(),
+				Day:   d.Day(),
+				Name:  h.Name,
+			})
+		}
+		for _, h := range r.WeekdayHolydays {
+			rule.Weekday = append(rule.Weekday, WeekdayHoliday{
+				Month:   h.Month,
+				Weekday: h.Weekday,
+				N:       h.Index + 1,
+				Name:    h.Name,
+			})
+		}
+		result = append(result, rule)
+	}
+	return result
+}
+
+// SpecialHolidays returns the one-off holidays of rs, sorted by date.
+func (rs *RuleSet) SpecialHolidays() []Holiday {
+	result := slices.Clone(rs.special)
+	SortHolidays(result)
+	return result
+}
@@ -0,0 +1,75 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRules(t *testing.T) {
+	rules := Rules()
+	if len(rules) == 0 {
+		t.Fatal("no rules")
+	}
+
+	first := rules[0]
+	if first.BeginYear != 1948 {
+		t.Errorf("want the first rule in 1948, got %d", first.BeginYear)
+	}
+	for i := 1; i < len(rules); i++ {
+		if rules[i-1].EndYear+1 != rules[i].BeginYear {
+			t.Errorf("rules[%d] ends in %d, but rules[%d] begins in %d", i-1, rules[i-1].EndYear, i, rules[i].BeginYear)
+		}
+	}
+
+	last := rules[len(rules)-1]
+	if last.EndYear != 0 {
+		t.Errorf("want the current rule, got EndYear %d", last.EndYear)
+	}
+	if !last.VernalEquinoxDay || !last.AutumnalEquinoxDay {
+		t.Error("want equinox days")
+	}
+	if diff := cmp.Diff(FixedHoliday{Month: time.January, Day: 1, Name: "元日"}, last.Fixed[0]); diff != "" {
+		t.Errorf("unexpected fixed holiday (-want/+got):\n%s", diff)
+	}
+	if diff := cmp.Diff(WeekdayHoliday{Month: time.January, Weekday: time.Monday, N: 2, Name: "成人の日"}, last.Weekday[0]); diff != "" {
+		t.Errorf("unexpected weekday holiday (-want/+got):\n%s", diff)
+	}
+
+	// modifying the result doesn't affect the package.
+	last.Fixed[0].Name = "modified"
+	if Rules()[len(rules)-1].Fixed[0].Name != "元日" {
+		t.Error("the rules are modified")
+	}
+}
+
+func TestSpecialHolidays(t *testing.T) {
+	holidays := SpecialHolidays()
+	if len(holidays) == 0 {
+		t.Fatal("no special holidays")
+	}
+	if holidays[0].Date != "1959-04-10" || holidays[0].Name != "結婚の儀" {
+		t.Errorf("unexpected first special holiday: %v", holidays[0])
+	}
+	for i := 1; i < len(holidays); i++ {
+		if holidays[i-1].Date > holidays[i].Date {
+			t.Errorf("not sorted: %s, %s", holidays[i-1].Date, holidays[i].Date)
+		}
+	}
+}
+
+func TestRuleSet_Rules(t *testing.T) {
+	rs := CurrentRules()
+	if err := rs.RemoveHoliday(2030, "春分の日"); err != nil {
+		t.Fatal(err)
+	}
+	rules := rs.Rules()
+	last := rules[len(rules)-1]
+	if last.BeginYear != 2030 || last.VernalEquinoxDay {
+		t.Errorf("unexpected rule: %+v", last)
+	}
+	if prev := rules[len(rules)-2]; prev.EndYear != 2029 {
+		t.Errorf("want the previous rule to end in 2029, got %d", prev.EndYear)
+	}
+}
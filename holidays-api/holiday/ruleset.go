@@ -0,0 +1,186 @@
+package holiday
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// RuleSet is a set of the rules of holidays defined by the Act on National Holidays.
+// It is used to simulate hypothetical amendments of the law, e.g. a new holiday or a moved date.
+//
+// The holidays are calculated from the rules, so they don't include the pre-calculated data.
+// The rules for substitute holidays (振替休日) and citizens' holidays (国民の休日) are the same as the current law.
+//
+// A RuleSet implements HolidayProvider, so it can be used with Calendar.
+// It is safe for concurrent use, as long as it is not modified after it is first used.
+type RuleSet struct {
+	// rules are sorted by BeginYear in descending order.
+	rules   []annuallyHolidaysRule
+	special []Holiday
+}
+
+var errNoRules = errors.New("holiday: no rules in the year")
+
+// CurrentRules returns a copy of the rules of the current law.
+func CurrentRules() *RuleSet {
+	return defaultRuleSet.Clone()
+}
+
+// Clone returns a deep copy of rs.
+func (rs *RuleSet) Clone() *RuleSet {
+	rules := make([]annuallyHolidaysRule, 0, len(rs.rules))
+	for _, r := range rs.rules {
+		rules = append(rules, r.clone())
+	}
+	return &RuleSet{
+		rules:   rules,
+		special: slices.Clone(rs.special),
+	}
+}
+
+func (r annuallyHolidaysRule) clone() annuallyHolidaysRule {
+	r.StaticHolydays = slices.Clone(r.StaticHolydays)
+	r.WeekdayHolydays = slices.Clone(r.WeekdayHolydays)
+	return r
+}
+
+// AddFixedHoliday adds a holiday on the same date every year since the year.
+func (rs *RuleSet) AddFixedHoliday(since int, month time.Month, day int, name string) error {
+	// February 29th is not allowed because it is not a date every year.
+	if t := time.Date(2001, month, day, 0, 0, 0, 0, time.UTC); t.Month() != month || t.Day() != day {
+		return fmt.Errorf("holiday: invalid date: %d-%d", int(month), day)
+	}
+	return rs.amend(since, func(r *annuallyHolidaysRule) {
+		r.StaticHolydays = append(r.StaticHolydays, staticHolyday{
+			Date: fmt.Sprintf("%02d-%02d", int(month), day),
+			Name: name,
+		})
+	})
+}
+
+// AddWeekdayHoliday adds a holiday on the n-th weekday of the month every year since the year,
+// e.g. n = 2 and weekday = time.Monday for the second Monday. n must be between 1 and 4.
+func (rs *RuleSet) AddWeekdayHoliday(since int, month time.Month, weekday time.Weekday, n int, name string) error {
+	if month < time.January || month > time.December || weekday < time.Sunday || weekday > time.Saturday || n < 1 || n > 4 {
+		return fmt.Errorf("holiday: invalid weekday: %d-%d", int(month), n)
+	}
+	return rs.amend(since, func(r *annuallyHolidaysRule) {
+		r.WeekdayHolydays = append(r.WeekdayHolydays, weekdayHolyday{
+			Month:   month,
+			Weekday: weekday,
+			Index:   n - 1,
+			Name:    name,
+		})
+	})
+}
+
+// RemoveHoliday removes the holiday named name since the year.
+// It returns an error if there is no such holiday in the year.
+func (rs *RuleSet) RemoveHoliday(since int, name string) error {
+	if !rs.hasHoliday(since, name) {
+		return fmt.Errorf("holiday: %s is not a holiday in %d", name, since)
+	}
+	return rs.amend(since, func(r *annuallyHolidaysRule) {
+		r.StaticHolydays = slices.DeleteFunc(r.StaticHolydays, func(h staticHolyday) bool {
+			return h.Name == name
+		})
+		r.WeekdayHolydays = slices.DeleteFunc(r.WeekdayHolydays, func(h weekdayHolyday) bool {
+			return h.Name == name
+		})
+		switch name {
+		case "春分の日":
+			r.NoVernalEquinoxDay = true
+		case "秋分の日":
+			r.NoAutumnalEquinoxDay = true
+		}
+	})
+}
+
+// MoveHoliday moves the holiday named name to the date since the year.
+func (rs *RuleSet) MoveHoliday(since int, name string, month time.Month, day int) error {
+	if err := rs.RemoveHoliday(since, name); err != nil {
+		return err
+	}
+	return rs.AddFixedHoliday(since, month, day, name)
+}
+
+// AddSpecialHoliday adds a one-off holiday, e.g. a holiday established by a special law.
+func (rs *RuleSet) AddSpecialHoliday(date Date, name string) error {
+	if t := time.Date(date.Year, date.Month, date.Day, 0, 0, 0, 0, time.UTC); dateOf(t) != date {
+		return fmt.Errorf("holiday: invalid date: %s", date)
+	}
+	rs.special = append(rs.special, Holiday{
+		Date: date.String(),
+		Name: name,
+		Kind: KindSpecial,
+	})
+	return nil
+}
+
+// HolidaysInYear returns the holidays in the year under the rules.
+func (rs *RuleSet) HolidaysInYear(year int) []Holiday {
+	return rs.calcHolidaysInYear(year)
+}
+
+// HolidaysInRange returns the holidays between from and to (inclusive) under the rules.
+func (rs *RuleSet) HolidaysInRange(from, to Date) []Holiday {
+	return rs.calcHolidaysInRange(from, to)
+}
+
+// hasHoliday reports whether the holiday named name is defined in the year.
+func (rs *RuleSet) hasHoliday(year int, name string) bool {
+	i := rs.ruleIndex(year)
+	if i < 0 {
+		return false
+	}
+	r := rs.rules[i]
+	for _, h := range r.StaticHolydays {
+		if h.Name == name {
+			return true
+		}
+	}
+	for _, h := range r.WeekdayHolydays {
+		if h.Name == name {
+			return true
+		}
+	}
+	switch name {
+	case "春分の日":
+		return !r.NoVernalEquinoxDay
+	case "秋分の日":
+		return !r.NoAutumnalEquinoxDay
+	}
+	return false
+}
+
+// ruleIndex returns the index of the rule in effect in the year, or -1 if there is no rule.
+func (rs *RuleSet) ruleIndex(year int) int {
+	for i, r := range rs.rules {
+		if year >= r.BeginYear {
+			return i
+		}
+	}
+	return -1
+}
+
+// amend applies fn to the rules in effect since the year.
+func (rs *RuleSet) amend(since int, fn func(r *annuallyHolidaysRule)) error {
+	i := rs.ruleIndex(since)
+	if i < 0 {
+		return errNoRules
+	}
+
+	// split the rule so that the amendment begins in the year.
+	if rs.rules[i].BeginYear != since {
+		r := rs.rules[i].clone()
+		r.BeginYear = since
+		rs.rules = slices.Insert(rs.rules, i, r)
+	}
+
+	for j := 0; j <= i; j++ {
+		fn(&rs.rules[j])
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCurrentRules(t *testing.T) {
+	rs := CurrentRules()
+	for year := 2000; year <= 2024; year++ {
+		want := FindHolidaysInYear(year)
+		got := rs.HolidaysInYear(year)
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("%d: mismatch (-want/+got):\n%s", year, diff)
+		}
+	}
+}
+
+func TestRuleSet_AddFixedHoliday(t *testing.T) {
+	rs := CurrentRules()
+	if err := rs.AddFixedHoliday(2030, time.June, 15, "梅雨の日"); err != nil {
+		t.Fatal(err)
+	}
+
+	// 2030-06-15 is Saturday.
+	got := rs.HolidaysInRange(Date{2030, time.June, 1}, Date{2030, time.June, 30})
+	want := []Holiday{{Date: "2030-06-15", Name: "梅雨の日"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want/+got):\n%s", diff)
+	}
+
+	// 2029 is not affected.
+	if got := rs.HolidaysInRange(Date{2029, time.June, 1}, Date{2029, time.June, 30}); len(got) != 0 {
+		t.Errorf("want no holidays in 2029-06, got %v", got)
+	}
+
+	// 2033-06-15 is Wednesday, and the rule continues.
+	got = rs.HolidaysInRange(Date{2033, time.June, 1}, Date{2033, time.June, 30})
+	want = []Holiday{{Date: "2033-06-15", Name: "梅雨の日"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want/+got):\n%s", diff)
+	}
+
+	// the current rules are not changed.
+	if got := FindHolidaysInMonth(2030, time.June); len(got) != 0 {
+		t.Errorf("the current rules are changed: %v", got)
+	}
+}
+
+func TestRuleSet_MoveHoliday(t *testing.T) {
+	rs := CurrentRules()
+	// move 山の日 from August 11 to August 12.
+	if err := rs.MoveHoliday(2031, "山の日", time.August, 12); err != nil {
+		t.Fatal(err)
+	}
+	got := rs.HolidaysInRange(Date{2031, time.August, 1}, Date{2031, time.August, 31})
+	want := []Holiday{{Date: "2031-08-12", Name: "山の日"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestRuleSet_RemoveHoliday(t *testing.T) {
+	rs := CurrentRules()
+	if err := rs.RemoveHoliday(2030, "春分の日"); err != nil {
+		t.Fatal(err)
+	}
+	if got := rs.HolidaysInRange(Date{2030, time.March, 1}, Date{2030, time.March, 31}); len(got) != 0 {
+		t.Errorf("want no holidays, got %v", got)
+	}
+	if err := rs.RemoveHoliday(2030, "春分の日"); err == nil {
+		t.Error("want error, got nil")
+	}
+	if err := rs.RemoveHoliday(2030, "存在しない日"); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestRuleSet_AddWeekdayHoliday(t *testing.T) {
+	rs := CurrentRules()
+	// the second Monday of June.
+	if err := rs.AddWeekdayHoliday(2030, time.June, time.Monday, 2, "新しい日"); err != nil {
+		t.Fatal(err)
+	}
+	got := rs.HolidaysInRange(Date{2030, time.June, 1}, Date{2030, time.June, 30})
+	want := []Holiday{{Date: "2030-06-10", Name: "新しい日"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestRuleSet_AddSpecialHoliday(t *testing.T) {
+	rs := CurrentRules()
+	// 2030-07-07 is Sunday, so the next day is a substitute holiday.
+	if err := rs.AddSpecialHoliday(Date{2030, time.July, 7}, "特別な日"); err != nil {
+		t.Fatal(err)
+	}
+	got := rs.HolidaysInRange(Date{2030, time.July, 1}, Date{2030, time.July, 10})
+	want := []Holiday{
+		{Date: "2030-07-07", Name: "特別な日", Kind: KindSpecial},
+		{Date: "2030-07-08", Name: "休日", Kind: KindSubstitute},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want/+got):\n%s", diff)
+	}
+
+	if err := rs.AddSpecialHoliday(Date{2030, time.February, 30}, "invalid"); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestRuleSet_InvalidAmendments(t *testing.T) {
+	rs := CurrentRules()
+	if err := rs.AddFixedHoliday(2030, time.February, 29, "invalid"); err == nil {
+		t.Error("want error, got nil")
+	}
+	if err := rs.AddWeekdayHoliday(2030, time.June, time.Monday, 5, "invalid"); err == nil {
+		t.Error("want error, got nil")
+	}
+	if err := rs.AddFixedHoliday(1900, time.June, 1, "too old"); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestRuleSet_Calendar(t *testing.T) {
+	rs := CurrentRules()
+	if err := rs.AddFixedHoliday(2030, time.June, 14, "新しい日"); err != nil {
+		t.Fatal(err)
+	}
+	c := NewCalendar(rs)
+	// 2030-06-13 is Thursday, and 2030-06-14 is a new holiday on Friday.
+	got, ok := c.NextBusinessDay(time.Date(2030, time.June, 13, 0, 0, 0, 0, JST))
+	want := time.Date(2030, time.June, 17, 0, 0, 0, 0, JST)
+	if !ok || !got.Equal(want) {
+		t.Errorf("want %s, got %s", want, got)
+	}
+}
+
+// the substitute holidays and the citizens' holidays may be in the month next to the holidays that cause them.
+func TestRuleSet_AcrossMonths(t *testing.T) {
+	rs := CurrentRules()
+	if err := rs.AddFixedHoliday(2020, time.January, 31, "一月尽"); err != nil {
+		t.Fatal(err)
+	}
+	if err := rs.AddFixedHoliday(2020, time.December, 31, "大晦日"); err != nil {
+		t.Fatal(err)
+	}
+	if err := rs.AddFixedHoliday(2020, time.April, 30, "四月尽"); err != nil {
+		t.Fatal(err)
+	}
+	if err := rs.AddFixedHoliday(2020, time.May, 2, "五月二日"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		year  int
+		month time.Month
+		want  []Holiday
+	}{
+		{
+			// 2021-01-31 is Sunday, and 2021-02-01 is the substitute holiday.
+			year:  2021,
+			month: time.January,
+			want: []Holiday{
+				{Date: "2021-01-01", Name: "元日"},
+				{Date: "2021-01-11", Name: "成人の日"},
+				{Date: "2021-01-31", Name: "一月尽"},
+			},
+		},
+		{
+			year:  2021,
+			month: time.February,
+			want: []Holiday{
+				{Date: "2021-02-01", Name: "休日", Kind: KindSubstitute},
+				{Date: "2021-02-11", Name: "建国記念の日"},
+				{Date: "2021-02-23", Name: "天皇誕生日"},
+			},
+		},
+		{
+			// 2023-12-31 is Sunday, and 2024-01-01 is 元日, so 2024-01-02 is the substitute holiday.
+			year:  2023,
+			month: time.December,
+			want: []Holiday{
+				{Date: "2023-12-31", Name: "大晦日"},
+			},
+		},
+		{
+			year:  2024,
+			month: time.January,
+			want: []Holiday{
+				{Date: "2024-01-01", Name: "元日"},
+				{Date: "2024-01-02", Name: "休日", Kind: KindSubstitute},
+				{Date: "2024-01-08", Name: "成人の日"},
+				{Date: "2024-01-31", Name: "一月尽"},
+			},
+		},
+		{
+			// 2025-05-01 Thursday is between 四月尽 and 五月二日.
+			year:  2025,
+			month: time.May,
+			want: []Holiday{
+				{Date: "2025-05-01", Name: "休日", Kind: KindCitizens},
+				{Date: "2025-05-02", Name: "五月二日"},
+				{Date: "2025-05-03", Name: "憲法記念日"},
+				{Date: "2025-05-04", Name: "みどりの日"},
+				{Date: "2025-05-05", Name: "こどもの日"},
+				{Date: "2025-05-06", Name: "休日", Kind: KindSubstitute},
+			},
+		},
+	}
+	for _, tt := range tests {
+		got := rs.calcHolidaysInMonth(tt.year, tt.month)
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("%d-%02d: mismatch (-want/+got):\n%s", tt.year, tt.month, diff)
+		}
+	}
+}
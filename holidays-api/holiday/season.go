@@ -0,0 +1,77 @@
+package holiday
+
+import (
+	"strings"
+	"time"
+)
+
+// silverWeekDays is the minimum length of シルバーウィーク.
+const silverWeekDays = 5
+
+// GoldenWeek returns the rest block of ゴールデンウィーク in the year, which contains 憲法記念日 (May 3rd).
+// The length of the block changes every year, e.g. 4 days from 2025-05-03 to 2025-05-06.
+// It returns false if May 3rd is not a holiday, e.g. before 憲法記念日 was established in 1948.
+func (c *Calendar) GoldenWeek(year int) (RestBlock, bool) {
+	day := time.Date(year, time.May, 3, 0, 0, 0, 0, c.location())
+	if !c.IsHoliday(day) {
+		return RestBlock{}, false
+	}
+	block, _, ok := c.RestBlockOf(day)
+	return block, ok
+}
+
+// SilverWeek returns the rest block of シルバーウィーク in the year.
+// It is a block of 5 days or more in September that contains both 敬老の日 and 秋分の日,
+// e.g. from 2026-09-19 to 2026-09-23, where 2026-09-22 is a citizens' holiday (国民の休日) between them.
+// It returns false if the year has no シルバーウィーク.
+// The years out of CoveredYears are calculated from the current law, so they may change.
+func (c *Calendar) SilverWeek(year int) (RestBlock, bool) {
+	from := Date{Year: year, Month: time.September, Day: 1}
+	to := Date{Year: year, Month: time.September, Day: 30}
+	var respectForTheAged, equinox time.Time
+	for _, h := range c.HolidaysInRange(from, to) {
+		switch {
+		case strings.HasPrefix(h.Name, "敬老の日"):
+			respectForTheAged = mustParseDate(h.Date)
+		case strings.HasPrefix(h.Name, "秋分の日"):
+			equinox = mustParseDate(h.Date)
+		}
+	}
+	if respectForTheAged.IsZero() || equinox.IsZero() {
+		return RestBlock{}, false
+	}
+
+	loc := c.location()
+	block, _, ok := c.RestBlockOf(time.Date(year, respectForTheAged.Month(), respectForTheAged.Day(), 0, 0, 0, 0, loc))
+	if !ok || block.Days < silverWeekDays {
+		return RestBlock{}, false
+	}
+	if e := time.Date(year, equinox.Month(), equinox.Day(), 0, 0, 0, 0, loc); e.Before(block.Start) || e.After(block.End) {
+		return RestBlock{}, false
+	}
+	return block, true
+}
+
+// HasSilverWeek reports whether the year has シルバーウィーク on the calendar.
+func (c *Calendar) HasSilverWeek(year int) bool {
+	_, ok := c.SilverWeek(year)
+	return ok
+}
+
+// GoldenWeek returns the rest block of ゴールデンウィーク in the year in JST, which contains 憲法記念日 (May 3rd).
+func GoldenWeek(year int) (RestBlock, bool) {
+	return defaultCalendar.GoldenWeek(year)
+}
+
+// SilverWeek returns the rest block of シルバーウィーク in the year in JST.
+// It returns false if the year has no シルバーウィーク.
+func SilverWeek(year int) (RestBlock, bool) {
+	return defaultCalendar.SilverWeek(year)
+}
+
+// HasSilverWeek reports whether the year has シルバーウィーク,
+// a block of 5 days or more in September that contains both 敬老の日 and 秋分の日.
+// For example, it returns true for 2015 and 2026.
+func HasSilverWeek(year int) bool {
+	return defaultCalendar.HasSilverWeek(year)
+}
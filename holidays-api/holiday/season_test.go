@@ -0,0 +1,60 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGoldenWeek(t *testing.T) {
+	tests := []struct {
+		year  int
+		start time.Time
+		days  int
+		ok    bool
+	}{
+		// 2025-05-03 Sat to 2025-05-06 Tue
+		{2025, time.Date(2025, time.May, 3, 0, 0, 0, 0, JST), 4, true},
+		// 2026-05-02 Sat to 2026-05-06 Wed
+		{2026, time.Date(2026, time.May, 2, 0, 0, 0, 0, JST), 5, true},
+		// 2019-04-27 Sat to 2019-05-06 Mon, the enthronement of the emperor
+		{2019, time.Date(2019, time.April, 27, 0, 0, 0, 0, JST), 10, true},
+		// 憲法記念日 was established in July 1948.
+		{1948, time.Time{}, 0, false},
+	}
+	for _, tt := range tests {
+		block, ok := GoldenWeek(tt.year)
+		if ok != tt.ok || !block.Start.Equal(tt.start) || block.Days != tt.days {
+			t.Errorf("GoldenWeek(%d): want (%s, %d days, %t), got (%s, %d days, %t)", tt.year, tt.start, tt.days, tt.ok, block.Start, block.Days, ok)
+		}
+	}
+}
+
+func TestSilverWeek(t *testing.T) {
+	tests := []struct {
+		year  int
+		start time.Time
+		ok    bool
+	}{
+		{2009, time.Date(2009, time.September, 19, 0, 0, 0, 0, JST), true},
+		{2015, time.Date(2015, time.September, 19, 0, 0, 0, 0, JST), true},
+		{2025, time.Time{}, false},
+		{2026, time.Date(2026, time.September, 19, 0, 0, 0, 0, JST), true},
+		// calculated from the current law
+		{2032, time.Date(2032, time.September, 18, 0, 0, 0, 0, JST), true},
+		{2033, time.Time{}, false},
+		// before 敬老の日 became the third Monday
+		{2002, time.Time{}, false},
+	}
+	for _, tt := range tests {
+		block, ok := SilverWeek(tt.year)
+		if ok != tt.ok || !block.Start.Equal(tt.start) {
+			t.Errorf("SilverWeek(%d): want (%s, %t), got (%s, %t)", tt.year, tt.start, tt.ok, block.Start, ok)
+		}
+		if ok && block.Days != 5 {
+			t.Errorf("SilverWeek(%d): want 5 days, got %d", tt.year, block.Days)
+		}
+		if got := HasSilverWeek(tt.year); got != tt.ok {
+			t.Errorf("HasSilverWeek(%d): want %t, got %t", tt.year, tt.ok, got)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package holiday
+
+import "time"
+
+// BankCalendar is the calendar of the banks in Japan.
+// Banks are closed on weekends, national holidays and from December 31st to January 3rd
+// (銀行法施行令第五条).
+var BankCalendar = NewCalendar(Japan, ProviderFunc(yearEndHolidays))
+
+// ExchangeCalendar is the calendar of Tokyo Stock Exchange (東京証券取引所).
+// The exchange is closed on the same days as banks (業務規程第十五条).
+var ExchangeCalendar = NewCalendar(Japan, ProviderFunc(yearEndHolidays))
+
+// yearEndHolidays returns the days from December 31st to January 3rd between from and to (inclusive).
+// The days are not national holidays, so they have the kind KindCustomary.
+func yearEndHolidays(from, to Date) []Holiday {
+	if from.Compare(to) > 0 {
+		from, to = to, from
+	}
+	var result []Holiday
+	for year := from.Year - 1; year <= to.Year; year++ {
+		for i := 0; i < 4; i++ {
+			d := dateOf(time.Date(year, time.December, 31+i, 0, 0, 0, 0, time.UTC))
+			if d.Compare(from) < 0 || d.Compare(to) > 0 {
+				continue
+			}
+			result = append(result, Holiday{
+				Date: d.String(),
+				Name: "年末年始休業日",
+				Kind: KindCustomary,
+			})
+		}
+	}
+	return result
+}
+
+// SettlementDate returns the day n business days after the trade on the calendar,
+// e.g. SettlementDate(trade, 2, ExchangeCalendar) for T+2.
+// If n is negative, it goes back n business days.
+// The clock time of trade in the calendar's location is kept.
+// It returns false if the calendar has no business days.
+func SettlementDate(trade time.Time, n int, calendar *Calendar) (time.Time, bool) {
+	t := trade.In(calendar.location())
+	ok := true
+	for ; n > 0 && ok; n-- {
+		t, ok = calendar.NextBusinessDay(t)
+	}
+	for ; n < 0 && ok; n++ {
+		t, ok = calendar.PreviousBusinessDay(t)
+	}
+	if !ok {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// ZenginTransferDate returns 00:00 JST of the date when the funds of a transfer arrive under the Zengin system (全銀システム).
+// cutoff is the time of day when the banks stop accepting same-day transfers, e.g. 15*time.Hour for 15:00.
+// The transfers initiated on a bank business day before cutoff arrive on the day,
+// and the others arrive on the next bank business day.
+func ZenginTransferDate(initiated time.Time, cutoff time.Duration) time.Time {
+	t := initiated.In(JST)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, JST)
+	if BankCalendar.IsBusinessDay(day) && t.Sub(day) < cutoff {
+		return day
+	}
+	next, _ := BankCalendar.NextBusinessDay(day)
+	return next
+}
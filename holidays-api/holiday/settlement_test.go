@@ -0,0 +1,65 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBankCalendar(t *testing.T) {
+	got := BankCalendar.HolidaysInRange(Date{2023, time.December, 29}, Date{2024, time.January, 8})
+	want := []Holiday{
+		{Date: "2023-12-31", Name: "年末年始休業日", Kind: KindCustomary},
+		{Date: "2024-01-01", Name: "元日", Kind: KindNational},
+		{Date: "2024-01-02", Name: "年末年始休業日", Kind: KindCustomary},
+		{Date: "2024-01-03", Name: "年末年始休業日", Kind: KindCustomary},
+		{Date: "2024-01-08", Name: "成人の日", Kind: KindNational},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("BankCalendar.HolidaysInRange() mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestSettlementDate(t *testing.T) {
+	tests := []struct {
+		trade time.Time
+		n     int
+		want  time.Time
+	}{
+		// 2024-08-08 Thu -> 2024-08-13 Tue, skipping the weekend, 山の日 and 休日
+		{time.Date(2024, time.August, 8, 15, 0, 0, 0, JST), 2, time.Date(2024, time.August, 13, 15, 0, 0, 0, JST)},
+		// 2023-12-28 Thu -> 12-29 Fri -> 2024-01-04 Thu, skipping the year-end holidays
+		{time.Date(2023, time.December, 28, 0, 0, 0, 0, JST), 2, time.Date(2024, time.January, 4, 0, 0, 0, 0, JST)},
+		{time.Date(2024, time.August, 13, 0, 0, 0, 0, JST), -1, time.Date(2024, time.August, 9, 0, 0, 0, 0, JST)},
+		{time.Date(2024, time.August, 13, 0, 0, 0, 0, JST), 0, time.Date(2024, time.August, 13, 0, 0, 0, 0, JST)},
+	}
+	for _, tt := range tests {
+		if got, ok := SettlementDate(tt.trade, tt.n, ExchangeCalendar); !ok || !got.Equal(tt.want) {
+			t.Errorf("SettlementDate(%s, %d): want %s, got %s", tt.trade, tt.n, tt.want, got)
+		}
+	}
+}
+
+func TestZenginTransferDate(t *testing.T) {
+	tests := []struct {
+		initiated time.Time
+		want      time.Time
+	}{
+		// before the cutoff on a business day
+		{time.Date(2024, time.August, 9, 14, 59, 0, 0, JST), time.Date(2024, time.August, 9, 0, 0, 0, 0, JST)},
+		// after the cutoff on Friday, and the next Monday is 休日
+		{time.Date(2024, time.August, 9, 15, 0, 0, 0, JST), time.Date(2024, time.August, 13, 0, 0, 0, 0, JST)},
+		// on a holiday
+		{time.Date(2024, time.August, 12, 9, 0, 0, 0, JST), time.Date(2024, time.August, 13, 0, 0, 0, 0, JST)},
+		// on the last business day of the year
+		{time.Date(2024, time.December, 30, 16, 0, 0, 0, JST), time.Date(2025, time.January, 6, 0, 0, 0, 0, JST)},
+		// 2024-08-09 05:00 UTC is 14:00 JST
+		{time.Date(2024, time.August, 9, 5, 0, 0, 0, time.UTC), time.Date(2024, time.August, 9, 0, 0, 0, 0, JST)},
+	}
+	for _, tt := range tests {
+		if got := ZenginTransferDate(tt.initiated, 15*time.Hour); !got.Equal(tt.want) {
+			t.Errorf("ZenginTransferDate(%s): want %s, got %s", tt.initiated, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package holiday
+
+import "time"
+
+// Stats is the statistics of holidays in a year.
+type Stats struct {
+	Year int
+
+	// Holidays is the number of holidays.
+	Holidays int
+
+	// OnWeekdays and OnWeekends are the numbers of holidays on Monday to Friday and on Saturday or Sunday.
+	OnWeekdays int
+	OnWeekends int
+
+	// ByWeekday is the number of holidays on each day of week, indexed by time.Weekday.
+	ByWeekday [7]int
+
+	// ByKind is the number of holidays of each kind.
+	ByKind map[Kind]int
+
+	// BusinessDays is the number of days that are neither weekends nor holidays.
+	BusinessDays int
+}
+
+// StatsInYear returns the statistics of holidays in the year.
+func StatsInYear(year int) Stats {
+	s := Stats{
+		Year:   year,
+		ByKind: map[Kind]int{},
+	}
+	for _, h := range FindHolidaysInYear(year) {
+		s.Holidays++
+		w := mustParseDate(h.Date).Weekday()
+		s.ByWeekday[w]++
+		if w == time.Saturday || w == time.Sunday {
+			s.OnWeekends++
+		} else {
+			s.OnWeekdays++
+		}
+		s.ByKind[h.Kind]++
+	}
+	s.BusinessDays = CountBusinessDays(Date{year, time.January, 1}, Date{year, time.December, 31})
+	return s
+}
@@ -0,0 +1,28 @@
+package holiday
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStatsInYear(t *testing.T) {
+	got := StatsInYear(2019)
+	want := Stats{
+		Year:       2019,
+		Holidays:   22,
+		OnWeekdays: 17,
+		OnWeekends: 5,
+		ByWeekday:  [7]int{3, 10, 3, 1, 2, 1, 2},
+		ByKind: map[Kind]int{
+			KindNational:   15,
+			KindSubstitute: 3,
+			KindCitizens:   2,
+			KindSpecial:    2,
+		},
+		BusinessDays: 244,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("StatsInYear(2019) mismatch (-want/+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,76 @@
+package holiday
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// MinYear is the first year supported by the E-suffixed functions.
+	// The Act on National Holidays was enacted in 1948.
+	MinYear = 1948
+
+	// MaxYear is the last year supported by the E-suffixed functions.
+	// The equinox days are calculated with ΔT extrapolated by Espenak and Meeus,
+	// which is valid until 2150, and they agree with the approximate formula of 新こよみ便利帳 until 2150.
+	// The holidays after it are calculated from the current law, but the equinox days are not reliable.
+	MaxYear = 2150
+)
+
+// ErrYearOutOfRange reports that a year is not between MinYear and MaxYear.
+var ErrYearOutOfRange = errors.New("holiday: year out of range")
+
+// FindHolidayE is same as FindHoliday, but it returns an error
+// if the date doesn't exist (ErrInvalidDate) or the year is not supported (ErrYearOutOfRange).
+func FindHolidayE(year int, month time.Month, day int) (Holiday, bool, error) {
+	if err := validateDate(Date{year, month, day}); err != nil {
+		return Holiday{}, false, err
+	}
+	h, ok := FindHoliday(year, month, day)
+	return h, ok, nil
+}
+
+// FindHolidaysInMonthE is same as FindHolidaysInMonth, but it returns an error
+// if the month is invalid (ErrInvalidDate) or the year is not supported (ErrYearOutOfRange).
+func FindHolidaysInMonthE(year int, month time.Month) ([]Holiday, error) {
+	if err := validateDate(Date{year, month, 1}); err != nil {
+		return nil, err
+	}
+	return FindHolidaysInMonth(year, month), nil
+}
+
+// FindHolidaysInYearE is same as FindHolidaysInYear, but it returns ErrYearOutOfRange
+// if the year is not supported.
+func FindHolidaysInYearE(year int) ([]Holiday, error) {
+	if err := validateYear(year); err != nil {
+		return nil, err
+	}
+	return FindHolidaysInYear(year), nil
+}
+
+// FindHolidaysInRangeE is same as FindHolidaysInRange, but it returns an error
+// if from or to doesn't exist (ErrInvalidDate) or is not supported (ErrYearOutOfRange).
+func FindHolidaysInRangeE(from, to Date) ([]Holiday, error) {
+	if err := validateDate(from); err != nil {
+		return nil, err
+	}
+	if err := validateDate(to); err != nil {
+		return nil, err
+	}
+	return FindHolidaysInRange(from, to), nil
+}
+
+func validateYear(year int) error {
+	if year < MinYear || year > MaxYear {
+		return fmt.Errorf("%w: %d", ErrYearOutOfRange, year)
+	}
+	return nil
+}
+
+func validateDate(d Date) error {
+	if d.Month < time.January || d.Month > time.December || d.Day < 1 || d.Day > daysIn(d.Year, d.Month) {
+		return fmt.Errorf("%w: %s", ErrInvalidDate, d)
+	}
+	return validateYear(d.Year)
+}
@@ -0,0 +1,80 @@
+package holiday
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFindHolidayE(t *testing.T) {
+	tests := []struct {
+		year  int
+		month time.Month
+		day   int
+		ok    bool
+		err   error
+	}{
+		{2024, time.August, 11, true, nil},
+		{2024, time.August, 13, false, nil},
+		{2025, time.February, 29, false, ErrInvalidDate},
+		{2025, 13, 1, false, ErrInvalidDate},
+		{1900, time.January, 1, false, ErrYearOutOfRange},
+		{2100, time.February, 29, false, ErrInvalidDate},
+		{2150, time.January, 1, true, nil},
+		{2151, time.January, 1, false, ErrYearOutOfRange},
+	}
+	for _, tt := range tests {
+		_, ok, err := FindHolidayE(tt.year, tt.month, tt.day)
+		if ok != tt.ok || !errors.Is(err, tt.err) {
+			t.Errorf("FindHolidayE(%d, %d, %d): want (%t, %v), got (%t, %v)", tt.year, tt.month, tt.day, tt.ok, tt.err, ok, err)
+		}
+	}
+}
+
+func TestFindHolidaysInMonthE(t *testing.T) {
+	holidays, err := FindHolidaysInMonthE(2024, time.June)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(holidays) != 0 {
+		t.Errorf("want no holidays, got %v", holidays)
+	}
+
+	if _, err := FindHolidaysInMonthE(2024, 0); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("want ErrInvalidDate, got %v", err)
+	}
+	if _, err := FindHolidaysInMonthE(1947, time.May); !errors.Is(err, ErrYearOutOfRange) {
+		t.Errorf("want ErrYearOutOfRange, got %v", err)
+	}
+}
+
+func TestFindHolidaysInYearE(t *testing.T) {
+	holidays, err := FindHolidaysInYearE(2019)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(holidays) != 22 {
+		t.Errorf("want 22 holidays, got %d", len(holidays))
+	}
+
+	if _, err := FindHolidaysInYearE(10000); !errors.Is(err, ErrYearOutOfRange) {
+		t.Errorf("want ErrYearOutOfRange, got %v", err)
+	}
+}
+
+func TestFindHolidaysInRangeE(t *testing.T) {
+	holidays, err := FindHolidaysInRangeE(Date{2024, time.August, 1}, Date{2024, time.August, 31})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(holidays) != 2 {
+		t.Errorf("want 2 holidays, got %d", len(holidays))
+	}
+
+	if _, err := FindHolidaysInRangeE(Date{2024, time.August, 1}, Date{2024, time.June, 31}); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("want ErrInvalidDate, got %v", err)
+	}
+	if _, err := FindHolidaysInRangeE(Date{1940, time.January, 1}, Date{2024, time.June, 30}); !errors.Is(err, ErrYearOutOfRange) {
+		t.Errorf("want ErrYearOutOfRange, got %v", err)
+	}
+}
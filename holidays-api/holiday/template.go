@@ -0,0 +1,51 @@
+package holiday
+
+import "time"
+
+// FuncMap returns the functions for text/template and html/template.
+//
+//	isHoliday t                  reports whether t is a holiday.
+//	holidayName t                returns the name of the holiday, or "" if t is not a holiday.
+//	nextHoliday t                returns the first holiday after t.
+//	businessDaysUntil from to    returns the number of business days from "from" (inclusive) until "to" (exclusive).
+//
+// The result can be passed to both (*text/template.Template).Funcs and (*html/template.Template).Funcs.
+func FuncMap() map[string]any {
+	return map[string]any{
+		"isHoliday":         IsHoliday,
+		"holidayName":       holidayName,
+		"nextHoliday":       nextHoliday,
+		"businessDaysUntil": businessDaysUntil,
+	}
+}
+
+func holidayName(t time.Time) string {
+	h, _ := FindHolidayAt(t)
+	return h.Name
+}
+
+func nextHoliday(t time.Time) Holiday {
+	h, _ := NextHoliday(t)
+	return h
+}
+
+func businessDaysUntil(from, to time.Time) int {
+	from = from.In(JST)
+	to = to.In(JST)
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, JST)
+	to = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, JST)
+
+	sign := 1
+	if to.Before(from) {
+		from, to = to, from
+		sign = -1
+	}
+
+	var n int
+	for d := from; d.Before(to); d = d.AddDate(0, 0, 1) {
+		if IsBusinessDay(d) {
+			n++
+		}
+	}
+	return sign * n
+}
@@ -0,0 +1,53 @@
+package holiday
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+)
+
+func TestFuncMap(t *testing.T) {
+	const text = `{{ if isHoliday .Date }}{{ holidayName .Date }}{{ end }}/` +
+		`{{ (nextHoliday .Date).Name }}/` +
+		`{{ businessDaysUntil .Date .Until }}`
+	data := map[string]any{
+		"Date":  time.Date(2024, time.August, 11, 0, 0, 0, 0, JST),
+		"Until": time.Date(2024, time.August, 19, 0, 0, 0, 0, JST),
+	}
+	const want = "山の日/休日/4"
+
+	t.Run("text/template", func(t *testing.T) {
+		tmpl := template.Must(template.New("").Funcs(FuncMap()).Parse(text))
+		var buf strings.Builder
+		if err := tmpl.Execute(&buf, data); err != nil {
+			t.Fatal(err)
+		}
+		if got := buf.String(); got != want {
+			t.Errorf("want %q, got %q", want, got)
+		}
+	})
+
+	t.Run("html/template", func(t *testing.T) {
+		tmpl := htmltemplate.Must(htmltemplate.New("").Funcs(FuncMap()).Parse(text))
+		var buf strings.Builder
+		if err := tmpl.Execute(&buf, data); err != nil {
+			t.Fatal(err)
+		}
+		if got := buf.String(); got != want {
+			t.Errorf("want %q, got %q", want, got)
+		}
+	})
+}
+
+func TestBusinessDaysUntil(t *testing.T) {
+	from := time.Date(2024, time.August, 9, 18, 0, 0, 0, JST)
+	to := time.Date(2024, time.August, 14, 9, 0, 0, 0, JST)
+	if got := businessDaysUntil(from, to); got != 2 {
+		t.Errorf("want 2, got %d", got)
+	}
+	if got := businessDaysUntil(to, from); got != -2 {
+		t.Errorf("want -2, got %d", got)
+	}
+}
@@ -0,0 +1,108 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+// the time.Time inputs in any location must be converted to JST before taking the date.
+func TestTimeZone(t *testing.T) {
+	// 2024-08-10 23:30 UTC is 2024-08-11 08:30 JST (Sun, 山の日).
+	holidayUTC := time.Date(2024, time.August, 10, 23, 30, 0, 0, time.UTC)
+	// 2024-08-12 15:00 UTC is 2024-08-13 00:00 JST (Tue).
+	businessUTC := time.Date(2024, time.August, 12, 15, 0, 0, 0, time.UTC)
+	// 2024-08-13 14:59 UTC is 2024-08-13 23:59 JST (Tue).
+	lastMinuteUTC := time.Date(2024, time.August, 13, 14, 59, 0, 0, time.UTC)
+	// 2024-08-09 15:00 in New York is 2024-08-10 04:00 JST (Sat).
+	ny := time.FixedZone("EDT", -4*60*60)
+	saturdayNY := time.Date(2024, time.August, 9, 15, 0, 0, 0, ny)
+
+	t.Run("FindHolidayAt", func(t *testing.T) {
+		if h, ok := FindHolidayAt(holidayUTC); !ok || h.Name != "山の日" {
+			t.Errorf("want 山の日, got %v, %t", h, ok)
+		}
+		if h, ok := FindHolidayAt(businessUTC); ok {
+			t.Errorf("want no holiday, got %v", h)
+		}
+	})
+
+	t.Run("IsHoliday", func(t *testing.T) {
+		if !IsHoliday(holidayUTC) {
+			t.Error("want true, got false")
+		}
+		if IsHoliday(lastMinuteUTC) {
+			t.Error("want false, got true")
+		}
+	})
+
+	t.Run("IsBusinessDay", func(t *testing.T) {
+		if IsBusinessDay(holidayUTC) {
+			t.Error("want false, got true")
+		}
+		if !IsBusinessDay(businessUTC) {
+			t.Error("want true, got false")
+		}
+		if IsBusinessDay(saturdayNY) {
+			t.Error("want false, got true")
+		}
+	})
+
+	t.Run("NextBusinessDay", func(t *testing.T) {
+		want := time.Date(2024, time.August, 13, 8, 30, 0, 0, JST)
+		if got := NextBusinessDay(holidayUTC); !got.Equal(want) {
+			t.Errorf("want %s, got %s", want, got)
+		}
+	})
+
+	t.Run("PreviousBusinessDay", func(t *testing.T) {
+		want := time.Date(2024, time.August, 9, 0, 0, 0, 0, JST)
+		if got := PreviousBusinessDay(businessUTC); !got.Equal(want) {
+			t.Errorf("want %s, got %s", want, got)
+		}
+	})
+
+	t.Run("NextHoliday", func(t *testing.T) {
+		// the next holiday after 2024-08-10 JST is 山の日, but after 2024-08-11 JST is 休日.
+		if h, ok := NextHoliday(holidayUTC); !ok || h.Date != "2024-08-12" {
+			t.Errorf("want 2024-08-12, got %v, %t", h, ok)
+		}
+	})
+
+	t.Run("UntilNextHoliday", func(t *testing.T) {
+		want := 15*time.Hour + 30*time.Minute
+		if got := UntilNextHoliday(holidayUTC); got != want {
+			t.Errorf("want %s, got %s", want, got)
+		}
+	})
+
+	t.Run("BusinessDayOfMonth", func(t *testing.T) {
+		// 2024-08-13 is the 8th business day of August.
+		if n, ok := BusinessDayOfMonth(businessUTC); !ok || n != 8 {
+			t.Errorf("want 8, got %d, %t", n, ok)
+		}
+		if n, ok := BusinessDayOfMonth(lastMinuteUTC); !ok || n != 8 {
+			t.Errorf("want 8, got %d, %t", n, ok)
+		}
+	})
+
+	t.Run("SettlementDate", func(t *testing.T) {
+		want := time.Date(2024, time.August, 14, 4, 0, 0, 0, JST)
+		if got, ok := SettlementDate(saturdayNY, 2, ExchangeCalendar); !ok || !got.Equal(want) {
+			t.Errorf("want %s, got %s", want, got)
+		}
+	})
+
+	t.Run("ZenginTransferDate", func(t *testing.T) {
+		want := time.Date(2024, time.August, 13, 0, 0, 0, 0, JST)
+		if got := ZenginTransferDate(saturdayNY, 15*time.Hour); !got.Equal(want) {
+			t.Errorf("want %s, got %s", want, got)
+		}
+	})
+
+	t.Run("EstimateDelivery", func(t *testing.T) {
+		want := time.Date(2024, time.August, 14, 0, 0, 0, 0, JST)
+		if got, _ := EstimateDelivery(saturdayNY, 2, nil); !got.Equal(want) {
+			t.Errorf("want %s, got %s", want, got)
+		}
+	})
+}
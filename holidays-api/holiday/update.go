@@ -0,0 +1,174 @@
+package holiday
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/requestid"
+)
+
+// updateURL is the URL of the source CSV. It is replaced in tests.
+var updateURL = syukujitsuURL
+
+// maxCSVSize is the max size of the source CSV. The actual size is about 20 KB.
+const maxCSVSize = 1 << 20
+
+// updateAttempts is the max number of the attempts to fetch the source CSV.
+const updateAttempts = 4
+
+// updateBackoff is the base wait time before the first retry. It is replaced in tests.
+var updateBackoff = time.Second
+
+// UpdateResult is the result of Update.
+type UpdateResult struct {
+	// Time is when Update finished.
+	Time time.Time
+
+	// Changed reports whether the pre-calculated holidays were replaced.
+	Changed bool
+
+	// Err is the error of Update, or nil on success.
+	Err error
+}
+
+var lastUpdate atomic.Pointer[UpdateResult]
+
+// LastUpdate returns the result of the last call of Update, including the calls by EnableAutoUpdate.
+// It returns false if Update has never been called.
+func LastUpdate() (UpdateResult, bool) {
+	ret := lastUpdate.Load()
+	if ret == nil {
+		return UpdateResult{}, false
+	}
+	return *ret, true
+}
+
+// Update fetches the latest syukujitsu.csv from the Cabinet Office,
+// and replaces the pre-calculated holidays in memory.
+// The transient failures of the fetch are retried a few times,
+// and after repeated failures the fetches are suspended for a while and Update returns ErrUpdateSuspended.
+// The data is validated before replacement, and the current data is kept if it is invalid.
+// It is safe to call Update concurrently with the queries; they see either the old or the new data.
+func Update(ctx context.Context) error {
+	changed, err := update(ctx)
+	lastUpdate.Store(&UpdateResult{
+		Time:    time.Now(),
+		Changed: changed,
+		Err:     err,
+	})
+	return err
+}
+
+func update(ctx context.Context) (changed bool, err error) {
+	if !updateBreaker.allow() {
+		return false, ErrUpdateSuspended
+	}
+	rawData, err := fetchCSV(ctx)
+	if err != nil && ctx.Err() != nil {
+		updateBreaker.abort()
+	} else {
+		updateBreaker.done(err)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	ds, err := parseCSV(rawData)
+	if err != nil {
+		return false, err
+	}
+	cur := fetchedDataset.Load()
+	if cur == nil {
+		cur = builtinDataset()
+	}
+	if ds.version == cur.version {
+		return false, nil
+	}
+	// the Cabinet Office only adds holidays. fewer holidays mean broken data.
+	if len(ds.holidays) < len(cur.holidays) {
+		return false, fmt.Errorf("holiday: the new data has fewer holidays than the current data: %d < %d", len(ds.holidays), len(cur.holidays))
+	}
+	ds.sourceURL = updateURL
+	ds.generatedAt = time.Now().UTC().Format(time.RFC3339)
+	previousDataset.Store(currentDataset())
+	fetchedDataset.Store(ds)
+	reloadDataset()
+	return true, nil
+}
+
+// fetchCSV fetches the source CSV.
+// The network errors and 5xx/429 responses are retried with the exponential backoff and jitter.
+func fetchCSV(ctx context.Context) ([]byte, error) {
+	backoff := updateBackoff
+	for attempt := 1; ; attempt++ {
+		data, retryable, err := fetchCSVOnce(ctx)
+		if err == nil || !retryable || attempt == updateAttempts {
+			return data, err
+		}
+
+		// wait between backoff/2 and backoff, so that many instances don't retry at the same time.
+		wait := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+func fetchCSVOnce(ctx context.Context) (data []byte, retryable bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, updateURL, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	req.Header.Set("User-Agent", "https://github.com/shogo82148/holidays-jp")
+	requestid.SetHeader(req)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		retryable := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+		return nil, retryable, fmt.Errorf("holiday: unexpected status code: %d", resp.StatusCode)
+	}
+	data, err = io.ReadAll(io.LimitReader(resp.Body, maxCSVSize))
+	if err != nil {
+		return nil, ctx.Err() == nil, err
+	}
+	return data, false, nil
+}
+
+// EnableAutoUpdate calls Update now, and then every interval in the background until ctx is canceled.
+// It is intended for long-running daemons that embed the package.
+// It returns the error of the first update. The errors of the following updates are logged, and the current data is kept.
+func EnableAutoUpdate(ctx context.Context, interval time.Duration) error {
+	if err := Update(ctx); err != nil {
+		return err
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if err := Update(ctx); err != nil {
+				log.Printf("holiday: failed to update the holidays: %v", err)
+			}
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,130 @@
+package holiday
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/requestid"
+	"golang.org/x/text/encoding/japanese"
+)
+
+// testUpdateServer serves the csv, and restores the dataset after the test.
+func testUpdateServer(t *testing.T, body *atomic.Value) {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(body.Load().([]byte))
+	}))
+	origURL := updateURL
+	updateURL = ts.URL
+	t.Cleanup(func() {
+		ts.Close()
+		updateURL = origURL
+		fetchedDataset.Store(nil)
+		previousDataset.Store(nil)
+		reloadDataset()
+		updateBreaker = newUpdateBreaker(nil)
+	})
+}
+
+func addHolidayToCSV(t *testing.T, rawData []byte, line string) []byte {
+	t.Helper()
+	encoded, err := japanese.ShiftJIS.NewEncoder().Bytes([]byte(line))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(bytes.Clone(rawData), encoded...)
+}
+
+func TestUpdate_RequestID(t *testing.T) {
+	rawData, err := os.ReadFile("syukujitsu.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got atomic.Value
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got.Store(r.Header.Get(requestid.Header))
+		w.Write(rawData)
+	}))
+	defer ts.Close()
+	origURL := updateURL
+	updateURL = ts.URL
+	defer func() { updateURL = origURL }()
+
+	ctx := requestid.NewContext(context.Background(), "abc-123")
+	if err := Update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got.Load() != "abc-123" {
+		t.Errorf("want %q, got %q", "abc-123", got.Load())
+	}
+}
+
+func TestEnableAutoUpdate(t *testing.T) {
+	var body atomic.Value
+	body.Store([]byte("broken"))
+	testUpdateServer(t, &body)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := EnableAutoUpdate(ctx, time.Hour); err == nil {
+		t.Error("want error, got nil")
+	}
+}
+
+func TestUpdate_Retry(t *testing.T) {
+	rawData, err := os.ReadFile("syukujitsu.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	origBackoff := updateBackoff
+	updateBackoff = time.Millisecond
+	t.Cleanup(func() {
+		updateBackoff = origBackoff
+		fetchedDataset.Store(nil)
+		previousDataset.Store(nil)
+		reloadDataset()
+		updateBreaker = newUpdateBreaker(nil)
+	})
+
+	tests := []struct {
+		name     string
+		statuses []int
+		wantErr  bool
+		wantReqs int32
+	}{
+		{"recovered", []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}, false, 3},
+		{"exhausted", []int{500, 502, 503, 504, 500}, true, updateAttempts},
+		{"not retried", []int{http.StatusNotFound}, true, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reqs atomic.Int32
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(reqs.Add(1))
+				if n <= len(tt.statuses) {
+					w.WriteHeader(tt.statuses[n-1])
+					return
+				}
+				w.Write(rawData)
+			}))
+			defer ts.Close()
+			origURL := updateURL
+			updateURL = ts.URL
+			defer func() { updateURL = origURL }()
+
+			err := Update(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("want error %t, got %v", tt.wantErr, err)
+			}
+			if got := reqs.Load(); got != tt.wantReqs {
+				t.Errorf("want %d requests, got %d", tt.wantReqs, got)
+			}
+		})
+	}
+}
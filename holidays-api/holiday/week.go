@@ -0,0 +1,47 @@
+package holiday
+
+import (
+	"fmt"
+	"time"
+)
+
+// Week is a numbered week of a year.
+type Week struct {
+	Year int
+	Week int
+}
+
+// String returns the week in the format of ISO 8601, e.g. "2025-W19".
+func (w Week) String() string {
+	return fmt.Sprintf("%04d-W%02d", w.Year, w.Week)
+}
+
+// ISOWeekOf returns the ISO 8601 week of t in the location of the calendar.
+// The weeks start on Monday, and the first week of a year contains its first Thursday,
+// so the first days of January may belong to the last week of the previous year.
+func (c *Calendar) ISOWeekOf(t time.Time) Week {
+	year, week := t.In(c.location()).ISOWeek()
+	return Week{Year: year, Week: week}
+}
+
+// WeekOf returns the week of t in the location of the calendar in the Japanese convention.
+// The weeks start on Sunday, and the first week of a year contains January 1st,
+// so the first week may have less than 7 days. It is the numbering of most Japanese calendars and notebooks.
+func (c *Calendar) WeekOf(t time.Time) Week {
+	t = t.In(c.location())
+	jan1 := time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location())
+	return Week{
+		Year: t.Year(),
+		Week: (t.YearDay()-1+int(jan1.Weekday()))/7 + 1,
+	}
+}
+
+// ISOWeekOf returns the ISO 8601 week of t in JST.
+func ISOWeekOf(t time.Time) Week {
+	return defaultCalendar.ISOWeekOf(t)
+}
+
+// WeekOf returns the week of t in JST in the Japanese convention, where the weeks start on Sunday.
+func WeekOf(t time.Time) Week {
+	return defaultCalendar.WeekOf(t)
+}
@@ -0,0 +1,37 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeekOf(t *testing.T) {
+	tests := []struct {
+		t    time.Time
+		iso  string
+		week string
+	}{
+		{time.Date(2025, time.January, 1, 0, 0, 0, 0, JST), "2025-W01", "2025-W01"},
+		{time.Date(2025, time.January, 4, 0, 0, 0, 0, JST), "2025-W01", "2025-W01"},
+		{time.Date(2025, time.January, 5, 0, 0, 0, 0, JST), "2025-W01", "2025-W02"},
+		{time.Date(2025, time.January, 6, 0, 0, 0, 0, JST), "2025-W02", "2025-W02"},
+		{time.Date(2025, time.May, 6, 0, 0, 0, 0, JST), "2025-W19", "2025-W19"},
+
+		// the first days of January belong to the last ISO week of the previous year.
+		{time.Date(2021, time.January, 1, 0, 0, 0, 0, JST), "2020-W53", "2021-W01"},
+
+		// the last days of December belong to the first ISO week of the next year.
+		{time.Date(2024, time.December, 30, 0, 0, 0, 0, JST), "2025-W01", "2024-W53"},
+
+		// the week is in JST: 2025-01-04 15:00 UTC is 2025-01-05 00:00 JST.
+		{time.Date(2025, time.January, 4, 15, 0, 0, 0, time.UTC), "2025-W01", "2025-W02"},
+	}
+	for _, tt := range tests {
+		if got := ISOWeekOf(tt.t).String(); got != tt.iso {
+			t.Errorf("ISOWeekOf(%s): want %s, got %s", tt.t, tt.iso, got)
+		}
+		if got := WeekOf(tt.t).String(); got != tt.week {
+			t.Errorf("WeekOf(%s): want %s, got %s", tt.t, tt.week, got)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package holiday
+
+import (
+	"fmt"
+	"time"
+)
+
+// NthWeekdayOfMonth returns 00:00 JST of the n-th weekday of the month,
+// e.g. NthWeekdayOfMonth(2025, time.January, time.Monday, 2) returns 2025-01-13 (成人の日).
+// If n is negative, it counts from the end of the month, e.g. -1 for the last weekday.
+// It returns an error if the month has no such day.
+func NthWeekdayOfMonth(year int, month time.Month, weekday time.Weekday, n int) (time.Time, error) {
+	if month < time.January || month > time.December || weekday < time.Sunday || weekday > time.Saturday {
+		return time.Time{}, fmt.Errorf("%w: %d-%d", ErrInvalidDate, year, int(month))
+	}
+
+	var day int
+	if n > 0 {
+		day = nthWeekday(year, month, weekday, n)
+	} else if n < 0 {
+		last := daysIn(year, month)
+		diff := int(time.Date(year, month, last, 0, 0, 0, 0, time.UTC).Weekday() - weekday)
+		if diff < 0 {
+			diff += 7
+		}
+		day = last - diff + (n+1)*7
+	}
+	if day < 1 || day > daysIn(year, month) {
+		return time.Time{}, fmt.Errorf("holiday: no %s #%d in %04d-%02d", weekday, n, year, int(month))
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, JST), nil
+}
+
+// nthWeekday returns the day of the n-th weekday of the month (n starts at 1).
+// The result may exceed the last day of the month.
+func nthWeekday(year int, month time.Month, weekday time.Weekday, n int) int {
+	weekdayOfFirstDay := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).Weekday()
+	day := int(weekday - weekdayOfFirstDay)
+	if day < 0 {
+		day += 7
+	}
+	return day + (n-1)*7 + 1
+}
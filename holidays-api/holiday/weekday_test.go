@@ -0,0 +1,48 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNthWeekdayOfMonth(t *testing.T) {
+	tests := []struct {
+		year    int
+		month   time.Month
+		weekday time.Weekday
+		n       int
+		want    time.Time
+		err     bool
+	}{
+		// 成人の日
+		{year: 2025, month: time.January, weekday: time.Monday, n: 2, want: time.Date(2025, time.January, 13, 0, 0, 0, 0, JST)},
+		// the first day of the month
+		{year: 2024, month: time.June, weekday: time.Saturday, n: 1, want: time.Date(2024, time.June, 1, 0, 0, 0, 0, JST)},
+		{year: 2024, month: time.June, weekday: time.Sunday, n: 5, want: time.Date(2024, time.June, 30, 0, 0, 0, 0, JST)},
+		{year: 2024, month: time.June, weekday: time.Monday, n: 5, err: true},
+		// the last Friday of the month, aka Premium Friday
+		{year: 2024, month: time.February, weekday: time.Friday, n: -1, want: time.Date(2024, time.February, 23, 0, 0, 0, 0, JST)},
+		{year: 2024, month: time.February, weekday: time.Thursday, n: -1, want: time.Date(2024, time.February, 29, 0, 0, 0, 0, JST)},
+		{year: 2024, month: time.February, weekday: time.Thursday, n: -5, want: time.Date(2024, time.February, 1, 0, 0, 0, 0, JST)},
+		{year: 2024, month: time.February, weekday: time.Friday, n: -5, err: true},
+		{year: 2024, month: time.February, weekday: time.Friday, n: 0, err: true},
+		{year: 2024, month: 13, weekday: time.Friday, n: 1, err: true},
+		{year: 2024, month: time.February, weekday: 7, n: 1, err: true},
+	}
+	for _, tt := range tests {
+		got, err := NthWeekdayOfMonth(tt.year, tt.month, tt.weekday, tt.n)
+		if tt.err {
+			if err == nil {
+				t.Errorf("NthWeekdayOfMonth(%d, %d, %d, %d): want error, got %s", tt.year, tt.month, tt.weekday, tt.n, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("NthWeekdayOfMonth(%d, %d, %d, %d): unexpected error: %v", tt.year, tt.month, tt.weekday, tt.n, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("NthWeekdayOfMonth(%d, %d, %d, %d): want %s, got %s", tt.year, tt.month, tt.weekday, tt.n, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+// Package holidaypb implements the messages defined in holiday.proto.
+//
+// The messages are encoded in and decoded from the Protocol Buffers wire format by hand,
+// so that this package has no dependency on the protobuf runtime.
+// The encoding is compatible with the code generated from holiday.proto by protoc-gen-go.
+package holidaypb
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// Kind is the kind of a holiday.
+type Kind int32
+
+const (
+	Kind_KIND_NATIONAL   Kind = 0
+	Kind_KIND_SUBSTITUTE Kind = 1
+	Kind_KIND_CITIZENS   Kind = 2
+	Kind_KIND_SPECIAL    Kind = 3
+	Kind_KIND_CUSTOM     Kind = 4
+	Kind_KIND_LOCAL      Kind = 5
+	Kind_KIND_CUSTOMARY  Kind = 6
+	Kind_KIND_MOURNING   Kind = 7
+)
+
+// Holiday is a holiday.
+type Holiday struct {
+	// Date in the format YYYY-MM-DD.
+	Date string
+	Name string
+	Kind Kind
+}
+
+// HolidayList is a list of holidays sorted by date.
+type HolidayList struct {
+	Holidays []*Holiday
+}
+
+// the wire types.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+var errTruncated = errors.New("holidaypb: truncated message")
+
+// FromHoliday converts holiday.Holiday to Holiday.
+func FromHoliday(h holiday.Holiday) *Holiday {
+	return &Holiday{
+		Date: h.Date,
+		Name: h.Name,
+		Kind: Kind(h.Kind),
+	}
+}
+
+// ToHoliday converts the message to holiday.Holiday.
+func (m *Holiday) ToHoliday() holiday.Holiday {
+	return holiday.Holiday{
+		Date: m.Date,
+		Name: m.Name,
+		Kind: holiday.Kind(m.Kind),
+	}
+}
+
+// FromHolidays converts a list of holiday.Holiday to HolidayList.
+func FromHolidays(holidays []holiday.Holiday) *HolidayList {
+	list := &HolidayList{
+		Holidays: make([]*Holiday, 0, len(holidays)),
+	}
+	for _, h := range holidays {
+		list.Holidays = append(list.Holidays, FromHoliday(h))
+	}
+	return list
+}
+
+// ToHolidays converts the message to a list of holiday.Holiday.
+func (m *HolidayList) ToHolidays() []holiday.Holiday {
+	holidays := make([]holiday.Holiday, 0, len(m.Holidays))
+	for _, h := range m.Holidays {
+		holidays = append(holidays, h.ToHoliday())
+	}
+	return holidays
+}
+
+// Marshal returns the wire-format encoding of the message.
+func (m *Holiday) Marshal() ([]byte, error) {
+	return m.appendTo(nil), nil
+}
+
+func (m *Holiday) appendTo(b []byte) []byte {
+	if m.Date != "" {
+		b = appendString(b, 1, m.Date)
+	}
+	if m.Name != "" {
+		b = appendString(b, 2, m.Name)
+	}
+	if m.Kind != 0 {
+		b = binary.AppendUvarint(b, 3<<3|wireVarint)
+		b = binary.AppendUvarint(b, uint64(int64(m.Kind)))
+	}
+	return b
+}
+
+// Unmarshal parses the wire-format message in b and stores the result in m.
+// Unknown fields are ignored.
+func (m *Holiday) Unmarshal(b []byte) error {
+	*m = Holiday{}
+	return parse(b, func(num int, typ int, v uint64, data []byte) error {
+		switch {
+		case num == 1 && typ == wireBytes:
+			m.Date = string(data)
+		case num == 2 && typ == wireBytes:
+			m.Name = string(data)
+		case num == 3 && typ == wireVarint:
+			m.Kind = Kind(int32(v))
+		}
+		return nil
+	})
+}
+
+// Marshal returns the wire-format encoding of the message.
+func (m *HolidayList) Marshal() ([]byte, error) {
+	var b []byte
+	for _, h := range m.Holidays {
+		msg := h.appendTo(nil)
+		b = binary.AppendUvarint(b, 1<<3|wireBytes)
+		b = binary.AppendUvarint(b, uint64(len(msg)))
+		b = append(b, msg...)
+	}
+	return b, nil
+}
+
+// Unmarshal parses the wire-format message in b and stores the result in m.
+// Unknown fields are ignored.
+func (m *HolidayList) Unmarshal(b []byte) error {
+	*m = HolidayList{}
+	return parse(b, func(num int, typ int, v uint64, data []byte) error {
+		if num == 1 && typ == wireBytes {
+			h := &Holiday{}
+			if err := h.Unmarshal(data); err != nil {
+				return err
+			}
+			m.Holidays = append(m.Holidays, h)
+		}
+		return nil
+	})
+}
+
+func appendString(b []byte, num int, s string) []byte {
+	b = binary.AppendUvarint(b, uint64(num)<<3|wireBytes)
+	b = binary.AppendUvarint(b, uint64(len(s)))
+	return append(b, s...)
+}
+
+// parse calls fn for each field in b.
+// v is the value of varint and fixed fields, and data is the payload of length-delimited fields.
+func parse(b []byte, fn func(num int, typ int, v uint64, data []byte) error) error {
+	for len(b) > 0 {
+		tag, n := binary.Uvarint(b)
+		if n <= 0 {
+			return errTruncated
+		}
+		b = b[n:]
+		num, typ := int(tag>>3), int(tag&7)
+		if num == 0 {
+			return errors.New("holidaypb: invalid field number 0")
+		}
+
+		var v uint64
+		var data []byte
+		switch typ {
+		case wireVarint:
+			v, n = binary.Uvarint(b)
+			if n <= 0 {
+				return errTruncated
+			}
+			b = b[n:]
+		case wireFixed64:
+			if len(b) < 8 {
+				return errTruncated
+			}
+			v = binary.LittleEndian.Uint64(b)
+			b = b[8:]
+		case wireBytes:
+			l, n := binary.Uvarint(b)
+			if n <= 0 || uint64(len(b)-n) < l {
+				return errTruncated
+			}
+			data = b[n : n+int(l)]
+			b = b[n+int(l):]
+		case wireFixed32:
+			if len(b) < 4 {
+				return errTruncated
+			}
+			v = uint64(binary.LittleEndian.Uint32(b))
+			b = b[4:]
+		default:
+			return fmt.Errorf("holidaypb: unsupported wire type %d", typ)
+		}
+		if err := fn(num, typ, v, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
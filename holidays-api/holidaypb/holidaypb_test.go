@@ -0,0 +1,91 @@
+package holidaypb
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestHoliday_Marshal(t *testing.T) {
+	m := &Holiday{Date: "2000-05-04", Name: "休日", Kind: Kind_KIND_CITIZENS}
+	got, err := m.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{
+		0x0a, 0x0a, '2', '0', '0', '0', '-', '0', '5', '-', '0', '4',
+		0x12, 0x06, 0xe4, 0xbc, 0x91, 0xe6, 0x97, 0xa5,
+		0x18, 0x02,
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("want %x, got %x", want, got)
+	}
+}
+
+// Kind must mirror every holiday.Kind with the same value.
+func TestKind(t *testing.T) {
+	mirrored := map[holiday.Kind]Kind{
+		holiday.KindNational:   Kind_KIND_NATIONAL,
+		holiday.KindSubstitute: Kind_KIND_SUBSTITUTE,
+		holiday.KindCitizens:   Kind_KIND_CITIZENS,
+		holiday.KindSpecial:    Kind_KIND_SPECIAL,
+		holiday.KindCustom:     Kind_KIND_CUSTOM,
+		holiday.KindLocal:      Kind_KIND_LOCAL,
+		holiday.KindCustomary:  Kind_KIND_CUSTOMARY,
+		holiday.KindMourning:   Kind_KIND_MOURNING,
+	}
+	for k := holiday.Kind(0); !strings.HasPrefix(k.String(), "Kind("); k++ {
+		v, ok := mirrored[k]
+		if !ok {
+			t.Errorf("%s is not mirrored in holidaypb.Kind", k)
+			continue
+		}
+		if int32(v) != int32(k) {
+			t.Errorf("%s: want %d, got %d", k, int32(k), int32(v))
+		}
+		if got := FromHoliday(holiday.Holiday{Kind: k}).ToHoliday().Kind; got != k {
+			t.Errorf("%s: round trip mismatch, got %s", k, got)
+		}
+	}
+}
+
+func TestHolidayList_RoundTrip(t *testing.T) {
+	want := holiday.FindHolidaysInYear(2000)
+	b, err := FromHolidays(want).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var m HolidayList
+	if err := m.Unmarshal(b); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, m.ToHolidays()); diff != "" {
+		t.Errorf("round trip mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestHoliday_UnmarshalUnknownFields(t *testing.T) {
+	b := []byte{
+		0x12, 0x06, 0xe5, 0x85, 0x83, 0xe6, 0x97, 0xa5, // name: 元日
+		0x20, 0x96, 0x01, // field 4, varint
+		0x2d, 0x01, 0x02, 0x03, 0x04, // field 5, fixed32
+	}
+	var m Holiday
+	if err := m.Unmarshal(b); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(Holiday{Name: "元日"}, m); diff != "" {
+		t.Errorf("mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestHoliday_UnmarshalTruncated(t *testing.T) {
+	var m Holiday
+	if err := m.Unmarshal([]byte{0x0a, 0x0a, '2'}); err == nil {
+		t.Error("want error, got nil")
+	}
+}
@@ -1,11 +1,13 @@
 package holidaysapi
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"log"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -13,48 +15,19 @@ import (
 	"time"
 
 	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+	"github.com/shogo82148/holidays-jp/holidays-api/ics"
+	"github.com/shogo82148/holidays-jp/holidays-api/render"
+	"github.com/shogo82148/holidays-jp/holidays-api/wareki"
 )
 
-var jst *time.Location
-
-func init() {
-	var err error
-	jst, err = time.LoadLocation("Asia/Tokyo")
-	if err != nil {
-		panic(err)
-	}
-}
-
-var errInvalidDateFormat = errors.New("holidaysapi: invalid date format")
-
+// parseDate parses a date in the query parameters.
+// It accepts the formats of holiday.ParseDate, e.g. 2006-01-02, 2006/1/2 and 20060102,
+// and the dates in the Japanese calendar accepted by wareki.Parse, e.g. 令和7年1月1日 and R7.1.1.
 func parseDate(s string) (holiday.Date, error) {
-	y, s, ok := strings.Cut(s, "-")
-	if !ok {
-		return holiday.Date{}, errInvalidDateFormat
-	}
-	m, s, ok := strings.Cut(s, "-")
-	if !ok {
-		return holiday.Date{}, errInvalidDateFormat
+	if d, err := wareki.Parse(s); err == nil {
+		return d, nil
 	}
-	d := s
-
-	year, err := parseInt(y, 4)
-	if err != nil || year < 1 || year > 9999 {
-		return holiday.Date{}, errInvalidDateFormat
-	}
-	month, err := parseInt(m, 2)
-	if err != nil || month < 1 || month > 12 {
-		return holiday.Date{}, errInvalidDateFormat
-	}
-	day, err := parseInt(d, 2)
-	if err != nil || day < 1 || day > 31 {
-		return holiday.Date{}, errInvalidDateFormat
-	}
-	return holiday.Date{
-		Year:  year,
-		Month: time.Month(month),
-		Day:   day,
-	}, nil
+	return holiday.ParseDate(s)
 }
 
 // Response is the response of Handler.
@@ -66,19 +39,80 @@ type Response struct {
 type Holiday struct {
 	Date string `json:"date"`
 	Name string `json:"name"`
+
+	// ISOWeek is the ISO 8601 week, e.g. 2025-W19. It is set if the week parameter is true.
+	ISOWeek string `json:"iso_week,omitempty"`
+
+	// Week is the week starting on Sunday, in the format of ISO 8601, e.g. 2025-W19.
+	// The first week of a year contains January 1st. It is set if the week parameter is true.
+	Week string `json:"week,omitempty"`
+
+	// EraDate is the date in the Japanese calendar, e.g. 令和7年1月1日. It is set if the era parameter is true.
+	EraDate string `json:"era_date,omitempty"`
+}
+
+// StatsResponse is the response of the stats endpoint.
+type StatsResponse struct {
+	Year         int            `json:"year"`
+	Holidays     int            `json:"holidays"`
+	OnWeekdays   int            `json:"on_weekdays"`
+	OnWeekends   int            `json:"on_weekends"`
+	ByWeekday    map[string]int `json:"by_weekday"`
+	ByKind       map[string]int `json:"by_kind"`
+	BusinessDays int            `json:"business_days"`
+}
+
+// DateResponse is the response of the date endpoint.
+type DateResponse struct {
+	Date        string `json:"date"`
+	Weekday     string `json:"weekday"`
+	Holiday     bool   `json:"holiday"`
+	Name        string `json:"name,omitempty"`
+	Kind        string `json:"kind,omitempty"`
+	BusinessDay bool   `json:"business_day"`
+
+	// EraDate is the date in the Japanese calendar, e.g. 令和7年5月6日.
+	// It is empty before 1873.
+	EraDate string `json:"era_date,omitempty"`
 }
 
 // Handler provides a holiday api.
 type Handler struct {
+	// Clock provides the current time for the endpoints relative to now, such as /upcoming.
+	// If nil, holiday.SystemClock is used.
+	Clock holiday.Clock
 }
 
 func NewHandler() *Handler {
 	return &Handler{}
 }
 
+func (h *Handler) now() time.Time {
+	if h.Clock == nil {
+		return holiday.SystemClock.Now().In(holiday.JST)
+	}
+	return h.Clock.Now().In(holiday.JST)
+}
+
 func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// JSONP for legacy environments that cannot use CORS.
+	if r.URL.Query().Has("callback") {
+		callback := r.URL.Query().Get("callback")
+		if !validCallback(callback) {
+			h.responseAPIError(w, invalidParameter("callback %q is not a valid JavaScript identifier", callback))
+			return
+		}
+		jw := &jsonpWriter{ResponseWriter: w}
+		h.serveHTTP(jw, r)
+		jw.flush(callback)
+		return
+	}
+	h.serveHTTP(w, r)
+}
+
+func (h *Handler) serveHTTP(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
-		h.responseNotFound(w)
+		h.responseMethodNotAllowed(w)
 		return
 	}
 
@@ -87,8 +121,107 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	path = strings.TrimSuffix(path, "/")
 	if path == "holidays" {
 		if err := h.holidaysInRange(w, r.URL); err != nil {
+			h.responseAPIError(w, err)
+		}
+		return
+	}
+	if path == "" {
+		h.index(w)
+		return
+	}
+	if path == "holidays.csv" {
+		if err := h.holidaysCSV(w, r.URL); err != nil {
+			h.responseAPIError(w, err)
+		}
+		return
+	}
+	if path == "holidays.ics" {
+		h.icsFeed(w, r)
+		return
+	}
+	if d, ok := strings.CutPrefix(path, "date/"); ok {
+		date, err := holiday.ParseDate(d)
+		if err != nil {
+			h.responseAPIError(w, invalidDate("date", d))
+			return
+		}
+		h.date(w, date)
+		return
+	}
+	if path == "check" {
+		if err := h.check(w, r.URL); err != nil {
+			h.responseAPIError(w, err)
+		}
+		return
+	}
+	if path == "names" {
+		if err := h.names(w, r.URL); err != nil {
+			h.responseAPIError(w, err)
+		}
+		return
+	}
+	if path == "countdown" {
+		h.countdown(w)
+		return
+	}
+	if path == "plan" {
+		if err := h.plan(w, r.URL); err != nil {
+			h.responseAPIError(w, err)
+		}
+		return
+	}
+	if path == "upcoming" {
+		if err := h.upcoming(w, r.URL); err != nil {
+			h.responseAPIError(w, err)
+		}
+		return
+	}
+	if ym, ok := strings.CutPrefix(path, "non-working-days/"); ok {
+		if err := h.nonWorkingDays(w, ym, r.URL); err != nil {
+			h.responseAPIError(w, err)
+		}
+		return
+	}
+	if y, ok := strings.CutPrefix(path, "calendar/"); ok {
+		year, err := parseInt(y, 4)
+		if err != nil || year == 0 {
+			h.responseNotFound(w)
+			return
+		}
+		h.calendar(w, year, r.URL.Query().Get("format"))
+		return
+	}
+	if y, ok := strings.CutPrefix(path, "bridge-days/"); ok {
+		year, err := parseInt(y, 4)
+		if err != nil || year == 0 {
 			h.responseNotFound(w)
+			return
 		}
+		h.bridgeDays(w, year)
+		return
+	}
+	if d, ok := strings.CutPrefix(path, "sun/"); ok {
+		if err := h.sun(w, d, r.URL); err != nil {
+			h.responseAPIError(w, err)
+		}
+		return
+	}
+	if y, ok := strings.CutPrefix(path, "equinox/"); ok {
+		year, err := parseInt(y, 4)
+		if err != nil || year == 0 {
+			h.responseNotFound(w)
+			return
+		}
+		h.equinox(w, year)
+		return
+	}
+	if y, ok := strings.CutPrefix(path, "stats/"); ok {
+		year, err := parseInt(y, 4)
+		if err != nil || year == 0 {
+			h.responseNotFound(w)
+			return
+		}
+		h.stats(w, year)
 		return
 	}
 
@@ -102,22 +235,22 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		h.responseNotFound(w)
 	case month == 0:
 		// 2006
-		h.holidaysInYear(w, year)
+		h.holidaysInYear(w, year, r.URL.Query())
 	case day == 0:
 		// 2006/01
 		if month < 1 || month > 12 {
-			h.responseNotFound(w)
+			h.responseAPIError(w, invalidDate("month", fmt.Sprintf("%04d/%02d", year, month)))
 			return
 		}
-		h.holidaysInMonth(w, year, time.Month(month))
+		h.holidaysInMonth(w, year, time.Month(month), r.URL.Query())
 	default:
 		// 2006/01/02
-		_, err := time.Parse("2006/01/02", fmt.Sprintf("%04d/%02d/%02d", year, month, day))
-		if err != nil {
-			h.responseNotFound(w)
+		date := fmt.Sprintf("%04d/%02d/%02d", year, month, day)
+		if _, err := time.Parse("2006/01/02", date); err != nil {
+			h.responseAPIError(w, invalidDate("date", date))
 			return
 		}
-		h.holiday(w, year, time.Month(month), day)
+		h.holiday(w, year, time.Month(month), day, r.URL.Query())
 	}
 }
 
@@ -166,104 +299,394 @@ func parseInt(s string, digits int) (int, error) {
 	return ret, nil
 }
 
-func (h *Handler) holiday(w http.ResponseWriter, year int, month time.Month, day int) {
-	now := time.Now().In(jst)
+func (h *Handler) holiday(w http.ResponseWriter, year int, month time.Month, day int, q url.Values) {
+	now := h.now()
+	maxAge := 24 * time.Hour
 	if year < now.Year() || (year == now.Year() && month < now.Month()) || (year == now.Year() && month == now.Month() && day < now.Day()) {
-		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 365*24*60*60))
-	} else {
-		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 24*60*60))
+		maxAge = 365 * 24 * time.Hour
 	}
 
 	d, ok := holiday.FindHoliday(year, month, day)
 	if ok {
-		h.responseHolidays(w, []holiday.Holiday{d})
+		h.responseHolidays(w, []holiday.Holiday{d}, q, maxAge)
 	} else {
-		h.responseHolidays(w, []holiday.Holiday{})
+		h.responseHolidays(w, []holiday.Holiday{}, q, maxAge)
 	}
 }
 
-func (h *Handler) holidaysInMonth(w http.ResponseWriter, year int, month time.Month) {
-	now := time.Now().In(jst)
+func (h *Handler) holidaysInMonth(w http.ResponseWriter, year int, month time.Month, q url.Values) {
+	now := h.now()
+	maxAge := 24 * time.Hour
 	if year < now.Year() || (year == now.Year() && month < now.Month()) {
-		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 365*24*60*60))
-	} else {
-		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 24*60*60))
+		maxAge = 365 * 24 * time.Hour
 	}
 
-	holidays := holiday.FindHolidaysInMonth(year, month)
-	h.responseHolidays(w, holidays)
+	holidays := filterByName(holiday.FindHolidaysInMonth(year, month), q.Get("name"))
+	h.responseHolidays(w, holidays, q, maxAge)
 }
 
-func (h *Handler) holidaysInYear(w http.ResponseWriter, year int) {
-	now := time.Now().In(jst)
+func (h *Handler) holidaysInYear(w http.ResponseWriter, year int, q url.Values) {
+	now := h.now()
+	maxAge := 24 * time.Hour
 	if year < now.Year() {
-		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 365*24*60*60))
-	} else {
-		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 24*60*60))
+		maxAge = 365 * 24 * time.Hour
 	}
 
-	holidays := holiday.FindHolidaysInYear(year)
-	h.responseHolidays(w, holidays)
+	holidays := filterByName(holiday.FindHolidaysInYear(year), q.Get("name"))
+	h.responseHolidays(w, holidays, q, maxAge)
 }
 
-func (h *Handler) holidaysInRange(w http.ResponseWriter, u *url.URL) error {
-	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 24*60*60))
-
+func (h *Handler) holidaysInRange(w http.ResponseWriter, u *url.URL) *apiError {
 	q := u.Query()
 	if !q.Has("from") || !q.Has("to") {
-		h.holidaysInYear(w, time.Now().In(jst).Year())
+		h.holidaysInYear(w, h.now().Year(), q)
 		return nil
 	}
 	from, err := parseDate(q.Get("from"))
 	if err != nil {
-		return err
+		return invalidDate("from", q.Get("from"))
 	}
 	to, err := parseDate(q.Get("to"))
 	if err != nil {
-		return err
+		return invalidDate("to", q.Get("to"))
+	}
+
+	holidays := filterByName(holiday.FindHolidaysInRange(from, to), q.Get("name"))
+	holidays, apiErr := paginate(w, u, holidays)
+	if apiErr != nil {
+		return apiErr
+	}
+	h.responseHolidays(w, holidays, q, 24*time.Hour)
+	return nil
+}
+
+// filterByName returns the holidays whose names contain name, e.g. "敬老" for 敬老の日.
+// Full-width and half-width variants of name match the same holidays.
+// It returns all holidays if name is empty.
+func filterByName(holidays []holiday.Holiday, name string) []holiday.Holiday {
+	if name == "" {
+		return holidays
+	}
+	return holiday.FilterByName(holidays, func(n string) bool {
+		return holiday.ContainsName(n, name)
+	})
+}
+
+// paginate returns the page of holidays specified by the limit and offset parameters.
+// It returns all holidays if neither of them is specified.
+// It sets the total number of holidays to the X-Total-Count header,
+// and the links to the next and previous pages to the Link header.
+func paginate(w http.ResponseWriter, u *url.URL, holidays []holiday.Holiday) ([]holiday.Holiday, *apiError) {
+	q := u.Query()
+	if !q.Has("limit") && !q.Has("offset") {
+		return holidays, nil
+	}
+
+	total := len(holidays)
+	limit, offset := total, 0
+	if q.Has("limit") {
+		v, err := strconv.Atoi(q.Get("limit"))
+		if err != nil || v < 1 {
+			return nil, invalidParameter("limit %q must be a positive integer", q.Get("limit"))
+		}
+		limit = v
+	}
+	if q.Has("offset") {
+		v, err := strconv.Atoi(q.Get("offset"))
+		if err != nil || v < 0 {
+			return nil, invalidParameter("offset %q must be a non-negative integer", q.Get("offset"))
+		}
+		offset = v
+	}
+
+	link := func(offset int, rel string) {
+		q.Set("limit", strconv.Itoa(limit))
+		q.Set("offset", strconv.Itoa(offset))
+		w.Header().Add("Link", fmt.Sprintf("<%s?%s>; rel=\"%s\"", u.Path, q.Encode(), rel))
+	}
+	offset = min(offset, total)
+	end := total
+	if limit < total-offset {
+		end = offset + limit
+		link(end, "next")
+	}
+	if offset > 0 {
+		link(max(offset-limit, 0), "prev")
+	}
+	w.Header().Set("X-Total-Count", strconv.Itoa(total))
+	return holidays[offset:end], nil
+}
+
+func (h *Handler) calendar(w http.ResponseWriter, year int, format string) {
+	var buf bytes.Buffer
+	var contentType string
+	switch format {
+	case "", "html":
+		if err := render.HTML(&buf, year); err != nil {
+			h.responseInternalServerError(w, err)
+			return
+		}
+		contentType = "text/html; charset=utf-8"
+	case "markdown", "md":
+		if err := render.Markdown(&buf, year); err != nil {
+			h.responseInternalServerError(w, err)
+			return
+		}
+		contentType = "text/markdown; charset=utf-8"
+	default:
+		h.responseAPIError(w, invalidParameter("format %q must be html or markdown", format))
+		return
+	}
+
+	now := h.now()
+	maxAge := 24 * time.Hour
+	if year < now.Year() {
+		maxAge = 365 * 24 * time.Hour
+	}
+	setCommonHeaders(w, maxAge)
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// date serves everything about the day.
+func (h *Handler) date(w http.ResponseWriter, d holiday.Date) {
+	data, err := json.Marshal(newDateResponse(d))
+	if err != nil {
+		h.responseInternalServerError(w, err)
+		return
+	}
+
+	t := time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, holiday.JST)
+	now := h.now()
+	maxAge := 24 * time.Hour
+	if t.Before(now.AddDate(0, 0, -1)) {
+		maxAge = 365 * 24 * time.Hour
+	}
+	setCommonHeaders(w, maxAge)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// parseBoolParameter parses the boolean parameter in q. It returns false if the parameter is missing.
+func parseBoolParameter(q url.Values, name string) (bool, *apiError) {
+	if !q.Has(name) {
+		return false, nil
+	}
+	v, err := strconv.ParseBool(q.Get(name))
+	if err != nil {
+		return false, invalidParameter("%s %q is not a boolean", name, q.Get(name))
+	}
+	return v, nil
+}
+
+func newDateResponse(d holiday.Date) DateResponse {
+	t := time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, holiday.JST)
+	res := DateResponse{
+		Date:        d.String(),
+		Weekday:     strings.ToLower(t.Weekday().String()),
+		BusinessDay: holiday.IsBusinessDay(t),
+	}
+	if hd, ok := holiday.FindHoliday(d.Year, d.Month, d.Day); ok {
+		res.Holiday = true
+		res.Name = hd.Name
+		res.Kind = hd.Kind.String()
+	}
+	if wd, err := wareki.FromDate(d); err == nil {
+		res.EraDate = wd.String()
+	}
+	return res
+}
+
+// holidaysCSV serves the holidays in the range as a CSV with the header "date,name,kind".
+// The range is this year if from and to are not specified.
+func (h *Handler) holidaysCSV(w http.ResponseWriter, u *url.URL) *apiError {
+	q := u.Query()
+	year := h.now().Year()
+	from := holiday.Date{Year: year, Month: time.January, Day: 1}
+	to := holiday.Date{Year: year, Month: time.December, Day: 31}
+	if q.Has("from") || q.Has("to") {
+		var err error
+		from, err = parseDate(q.Get("from"))
+		if err != nil {
+			return invalidDate("from", q.Get("from"))
+		}
+		to, err = parseDate(q.Get("to"))
+		if err != nil {
+			return invalidDate("to", q.Get("to"))
+		}
 	}
+	holidays := filterByName(holiday.FindHolidaysInRange(from, to), q.Get("name"))
 
-	holidays := holiday.FindHolidaysInRange(from, to)
-	h.responseHolidays(w, holidays)
+	var buf bytes.Buffer
+	cw := csv.NewWriter(&buf)
+	cw.Write([]string{"date", "name", "kind"})
+	for _, d := range holidays {
+		cw.Write([]string{d.Date, d.Name, d.Kind.String()})
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		h.responseInternalServerError(w, err)
+		return nil
+	}
+
+	setCommonHeaders(w, 24*time.Hour)
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8; header=present")
+	w.Header().Set("Content-Disposition", `attachment; filename="holidays.csv"`)
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
 	return nil
 }
 
-func (h *Handler) responseHolidays(w http.ResponseWriter, holidays []holiday.Holiday) {
+// icsFeed serves the holidays from the last year to two years later as an iCalendar feed.
+// The feed is deterministic and has an ETag, so the calendar clients polling it
+// get 304 Not Modified until the data changes.
+func (h *Handler) icsFeed(w http.ResponseWriter, r *http.Request) {
+	now := h.now()
+	from := holiday.Date{Year: now.Year() - 1, Month: time.January, Day: 1}
+	to := holiday.Date{Year: now.Year() + 2, Month: time.December, Day: 31}
+
+	stamp := holiday.DataGeneratedAt()
+	if stamp.IsZero() {
+		stamp = time.Date(from.Year, from.Month, from.Day, 0, 0, 0, 0, holiday.JST)
+	}
+	feed := &ics.Feed{
+		Name:   "日本の祝日",
+		Domain: "holidays-jp.shogo82148.com",
+		Stamp:  stamp,
+	}
+	var buf bytes.Buffer
+	if err := feed.Write(&buf, holiday.FindHolidaysInRange(from, to)); err != nil {
+		h.responseInternalServerError(w, err)
+		return
+	}
+	sum := sha256.Sum256(buf.Bytes())
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	setCommonHeaders(w, time.Hour)
+	w.Header().Set("ETag", etag)
+	if etagMatch(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// etagMatch reports whether the If-None-Match header matches etag, using the weak comparison.
+func etagMatch(ifNoneMatch, etag string) bool {
+	for _, v := range strings.Split(ifNoneMatch, ",") {
+		v = strings.TrimSpace(v)
+		if v == "*" || strings.TrimPrefix(v, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *Handler) stats(w http.ResponseWriter, year int) {
+	s := holiday.StatsInYear(year)
+	res := StatsResponse{
+		Year:         s.Year,
+		Holidays:     s.Holidays,
+		OnWeekdays:   s.OnWeekdays,
+		OnWeekends:   s.OnWeekends,
+		ByWeekday:    make(map[string]int, len(s.ByWeekday)),
+		ByKind:       make(map[string]int, len(s.ByKind)),
+		BusinessDays: s.BusinessDays,
+	}
+	for w, n := range s.ByWeekday {
+		res.ByWeekday[strings.ToLower(time.Weekday(w).String())] = n
+	}
+	for k, n := range s.ByKind {
+		res.ByKind[k.String()] = n
+	}
+	data, err := json.Marshal(res)
+	if err != nil {
+		h.responseInternalServerError(w, err)
+		return
+	}
+
+	now := h.now()
+	maxAge := 24 * time.Hour
+	if year < now.Year() {
+		maxAge = 365 * 24 * time.Hour
+	}
+	setCommonHeaders(w, maxAge)
 	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Link", "<https://github.com/sponsors/shogo82148>; rel=\"author\"")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// setCommonHeaders sets the headers shared by all responses.
+// If maxAge is zero, Cache-Control is not set, and the caller should set it.
+func setCommonHeaders(w http.ResponseWriter, maxAge time.Duration) {
+	if maxAge > 0 {
+		w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", int(maxAge.Seconds())))
+	}
+	w.Header().Add("Link", "<https://github.com/sponsors/shogo82148>; rel=\"author\"")
 
 	// ref. https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Strict-Transport-Security#examples
 	w.Header().Set("Strict-Transport-Security", "max-age=63072000")
+}
+
+// responseHolidays responds the holidays.
+// If the week parameter in q is true, the holidays have the week numbers.
+// If the era parameter in q is true, the holidays have the dates in the Japanese calendar.
+func (h *Handler) responseHolidays(w http.ResponseWriter, holidays []holiday.Holiday, q url.Values, maxAge time.Duration) {
+	week, apiErr := parseBoolParameter(q, "week")
+	if apiErr != nil {
+		h.responseAPIError(w, apiErr)
+		return
+	}
+	era, apiErr := parseBoolParameter(q, "era")
+	if apiErr != nil {
+		h.responseAPIError(w, apiErr)
+		return
+	}
+
+	setCommonHeaders(w, maxAge)
+	w.Header().Set("Content-Type", "application/json")
 
 	res := make([]Holiday, 0, len(holidays))
 	for _, d := range holidays {
-		res = append(res, Holiday{
+		hd := Holiday{
 			Date: d.Date,
 			Name: d.Name,
-		})
+		}
+		date, err := holiday.ParseDate(d.Date)
+		if err != nil {
+			h.responseInternalServerError(w, err)
+			return
+		}
+		if week {
+			t := time.Date(date.Year, date.Month, date.Day, 0, 0, 0, 0, holiday.JST)
+			hd.ISOWeek = holiday.ISOWeekOf(t).String()
+			hd.Week = holiday.WeekOf(t).String()
+		}
+		if era {
+			if wd, err := wareki.FromDate(date); err == nil {
+				hd.EraDate = wd.String()
+			}
+		}
+		res = append(res, hd)
 	}
 	data, err := json.Marshal(Response{
 		Holidays: res,
 	})
 	if err != nil {
-		log.Printf("failed to marshal response: %v", err)
-		w.WriteHeader(http.StatusInternalServerError)
-		io.WriteString(w, `{"error":"internal server error"}`)
+		h.responseInternalServerError(w, err)
+		return
 	}
 
 	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
 	w.WriteHeader(http.StatusOK)
 	w.Write(data)
 }
-
-func (h *Handler) responseNotFound(w http.ResponseWriter) {
-	w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", 24*60*60))
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Link", "<https://github.com/sponsors/shogo82148>; rel=\"author\"")
-
-	// ref. https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Strict-Transport-Security#examples
-	w.Header().Set("Strict-Transport-Security", "max-age=63072000")
-
-	w.WriteHeader(http.StatusNotFound)
-	io.WriteString(w, `{"error":"not found","message":"see https://github.com/shogo82148/holidays-jp/ for more information."}`)
-}
@@ -5,15 +5,20 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+	"github.com/shogo82148/holidays-jp/holidays-api/requestid"
 )
 
 func TestServeHTTP(t *testing.T) {
 	h := NewHandler()
 	t.Run("not found", func(t *testing.T) {
-		req := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/unknown", nil)
 		w := httptest.NewRecorder()
 		h.ServeHTTP(w, req)
 
@@ -46,7 +51,7 @@ func TestServeHTTP(t *testing.T) {
 	})
 
 	t.Run("range", func(t *testing.T) {
-		req := httptest.NewRequest(http.MethodGet, "http://example.com/holidays?from=2000-01-01&to=2000-06-31", nil)
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/holidays?from=2000-01-01&to=2000-06-30", nil)
 		w := httptest.NewRecorder()
 		h.ServeHTTP(w, req)
 
@@ -264,6 +269,712 @@ func TestServeHTTP(t *testing.T) {
 			t.Errorf("unexpected response: (-want/+got)\n%s", diff)
 		}
 	})
+
+	t.Run("year 9999", func(t *testing.T) {
+		urls := []string{
+			"http://example.com/9999",
+			"http://example.com/9999/12",
+			"http://example.com/holidays?from=9999-12-01&to=9999-12-31",
+		}
+		for _, u := range urls {
+			req := httptest.NewRequest(http.MethodGet, u, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			resp := w.Result()
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("%s: unexpected status code: want %d, got %d", u, http.StatusOK, resp.StatusCode)
+			}
+			var got Response
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatalf("%s: %v", u, err)
+			}
+			for _, h := range got.Holidays {
+				if !strings.HasPrefix(h.Date, "9999-") {
+					t.Errorf("%s: want holidays in 9999, got %s", u, h.Date)
+				}
+			}
+		}
+	})
+}
+
+func TestServeHTTP_Calendar(t *testing.T) {
+	h := NewHandler()
+	t.Run("html", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/calendar/2000", nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		if got, want := resp.Header.Get("Content-Type"), "text/html; charset=utf-8"; got != want {
+			t.Errorf("unexpected Content-Type: want %q, got %q", want, got)
+		}
+	})
+
+	t.Run("markdown", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/calendar/2000?format=markdown", nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		if got, want := resp.Header.Get("Content-Type"), "text/markdown; charset=utf-8"; got != want {
+			t.Errorf("unexpected Content-Type: want %q, got %q", want, got)
+		}
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/calendar/2000?format=pdf", nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusBadRequest, resp.StatusCode)
+		}
+	})
+}
+
+func TestServeHTTP_Stats(t *testing.T) {
+	h := NewHandler()
+	t.Run("2019", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/stats/2019", nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+
+		var got StatsResponse
+		if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+			t.Fatal(err)
+		}
+		want := StatsResponse{
+			Year:       2019,
+			Holidays:   22,
+			OnWeekdays: 17,
+			OnWeekends: 5,
+			ByWeekday: map[string]int{
+				"sunday":    3,
+				"monday":    10,
+				"tuesday":   3,
+				"wednesday": 1,
+				"thursday":  2,
+				"friday":    1,
+				"saturday":  2,
+			},
+			ByKind: map[string]int{
+				"national":   15,
+				"substitute": 3,
+				"citizens":   2,
+				"special":    2,
+			},
+			BusinessDays: 244,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("unexpected response: (-want/+got)\n%s", diff)
+		}
+	})
+
+	t.Run("invalid year", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/stats/abcd", nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusNotFound, resp.StatusCode)
+		}
+	})
+}
+
+func TestServeHTTP_Pagination(t *testing.T) {
+	h := NewHandler()
+	tests := []struct {
+		query  string
+		status int
+		dates  []string
+		links  []string
+	}{
+		{
+			query:  "limit=3&offset=5",
+			status: http.StatusOK,
+			dates:  []string{"2021-04-29", "2021-05-03", "2021-05-04"},
+			links: []string{
+				`</holidays?from=2021-01-01&limit=3&offset=8&to=2021-12-31>; rel="next"`,
+				`</holidays?from=2021-01-01&limit=3&offset=2&to=2021-12-31>; rel="prev"`,
+				`<https://github.com/sponsors/shogo82148>; rel="author"`,
+			},
+		},
+		{
+			query:  "limit=3",
+			status: http.StatusOK,
+			dates:  []string{"2021-01-01", "2021-01-11", "2021-02-11"},
+			links: []string{
+				`</holidays?from=2021-01-01&limit=3&offset=3&to=2021-12-31>; rel="next"`,
+				`<https://github.com/sponsors/shogo82148>; rel="author"`,
+			},
+		},
+		{
+			query:  "offset=15",
+			status: http.StatusOK,
+			dates:  []string{"2021-11-03", "2021-11-23"},
+			links: []string{
+				`</holidays?from=2021-01-01&limit=17&offset=0&to=2021-12-31>; rel="prev"`,
+				`<https://github.com/sponsors/shogo82148>; rel="author"`,
+			},
+		},
+		{
+			query:  "limit=3&offset=100",
+			status: http.StatusOK,
+			dates:  []string{},
+			links: []string{
+				`</holidays?from=2021-01-01&limit=3&offset=14&to=2021-12-31>; rel="prev"`,
+				`<https://github.com/sponsors/shogo82148>; rel="author"`,
+			},
+		},
+		{
+			query:  "limit=0",
+			status: http.StatusBadRequest,
+		},
+		{
+			query:  "offset=-1",
+			status: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "http://example.com/holidays?from=2021-01-01&to=2021-12-31&"+tt.query, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.status {
+				t.Fatalf("unexpected status code: want %d, got %d", tt.status, resp.StatusCode)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			if got := resp.Header.Get("X-Total-Count"); got != "17" {
+				t.Errorf("unexpected X-Total-Count: want %q, got %q", "17", got)
+			}
+			if diff := cmp.Diff(tt.links, resp.Header.Values("Link")); diff != "" {
+				t.Errorf("Link mismatch (-want/+got):\n%s", diff)
+			}
+
+			var got Response
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			dates := []string{}
+			for _, d := range got.Holidays {
+				dates = append(dates, d.Date)
+			}
+			if diff := cmp.Diff(tt.dates, dates); diff != "" {
+				t.Errorf("holidays mismatch (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServeHTTP_Name(t *testing.T) {
+	h := NewHandler()
+	tests := []struct {
+		path string
+		want []Holiday
+	}{
+		{
+			path: "/holidays?from=2019-01-01&to=2021-12-31&name=" + url.QueryEscape("敬老"),
+			want: []Holiday{
+				{Date: "2019-09-16", Name: "敬老の日"},
+				{Date: "2020-09-21", Name: "敬老の日"},
+				{Date: "2021-09-20", Name: "敬老の日"},
+			},
+		},
+		{
+			path: "/2019?name=" + url.QueryEscape("祝日扱い"),
+			want: []Holiday{
+				{Date: "2019-05-01", Name: "休日（祝日扱い）"},
+				{Date: "2019-10-22", Name: "休日（祝日扱い）"},
+			},
+		},
+		{
+			// the half-width parenthesis matches the full-width one.
+			path: "/2019?name=" + url.QueryEscape("(祝日扱い)"),
+			want: []Holiday{
+				{Date: "2019-05-01", Name: "休日（祝日扱い）"},
+				{Date: "2019-10-22", Name: "休日（祝日扱い）"},
+			},
+		},
+		{
+			path: "/2021/09?name=" + url.QueryEscape("秋分"),
+			want: []Holiday{
+				{Date: "2021-09-23", Name: "秋分の日"},
+			},
+		},
+		{
+			path: "/2021/01?name=" + url.QueryEscape("秋分"),
+			want: []Holiday{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "http://example.com"+tt.path, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			resp := w.Result()
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+			}
+			var got Response
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got.Holidays); diff != "" {
+				t.Errorf("holidays mismatch (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServeHTTP_Week(t *testing.T) {
+	h := NewHandler()
+	tests := []struct {
+		path   string
+		status int
+		want   []Holiday
+	}{
+		{
+			path:   "/2021/01?week=true",
+			status: http.StatusOK,
+			want: []Holiday{
+				{Date: "2021-01-01", Name: "元日", ISOWeek: "2020-W53", Week: "2021-W01"},
+				{Date: "2021-01-11", Name: "成人の日", ISOWeek: "2021-W02", Week: "2021-W03"},
+			},
+		},
+		{
+			path:   "/2021/01/01?week=1",
+			status: http.StatusOK,
+			want: []Holiday{
+				{Date: "2021-01-01", Name: "元日", ISOWeek: "2020-W53", Week: "2021-W01"},
+			},
+		},
+		{
+			path:   "/2021/01?week=false",
+			status: http.StatusOK,
+			want: []Holiday{
+				{Date: "2021-01-01", Name: "元日"},
+				{Date: "2021-01-11", Name: "成人の日"},
+			},
+		},
+		{
+			path:   "/2021/01?week=yes",
+			status: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "http://example.com"+tt.path, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.status {
+				t.Fatalf("unexpected status code: want %d, got %d", tt.status, resp.StatusCode)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			var got Response
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got.Holidays); diff != "" {
+				t.Errorf("holidays mismatch (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServeHTTP_Era(t *testing.T) {
+	h := NewHandler()
+	tests := []struct {
+		path   string
+		status int
+		want   []Holiday
+	}{
+		{
+			path:   "/holidays?from=2019-04-29&to=2019-05-01&era=true",
+			status: http.StatusOK,
+			want: []Holiday{
+				{Date: "2019-04-29", Name: "昭和の日", EraDate: "平成31年4月29日"},
+				{Date: "2019-04-30", Name: "休日", EraDate: "平成31年4月30日"},
+				{Date: "2019-05-01", Name: "休日（祝日扱い）", EraDate: "令和元年5月1日"},
+			},
+		},
+		{
+			path:   "/2025/01/01?era=true&week=true",
+			status: http.StatusOK,
+			want: []Holiday{
+				{Date: "2025-01-01", Name: "元日", ISOWeek: "2025-W01", Week: "2025-W01", EraDate: "令和7年1月1日"},
+			},
+		},
+		{
+			// the range in the Japanese calendar
+			path:   "/holidays?from=R7.1.1&to=" + url.QueryEscape("令和7年1月31日"),
+			status: http.StatusOK,
+			want: []Holiday{
+				{Date: "2025-01-01", Name: "元日"},
+				{Date: "2025-01-13", Name: "成人の日"},
+			},
+		},
+		{
+			path:   "/2025/01?era=false",
+			status: http.StatusOK,
+			want: []Holiday{
+				{Date: "2025-01-01", Name: "元日"},
+				{Date: "2025-01-13", Name: "成人の日"},
+			},
+		},
+		{
+			path:   "/2025/01?era=yes",
+			status: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "http://example.com"+tt.path, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.status {
+				t.Fatalf("unexpected status code: want %d, got %d", tt.status, resp.StatusCode)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			var got Response
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got.Holidays); diff != "" {
+				t.Errorf("holidays mismatch (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServeHTTP_ICS(t *testing.T) {
+	h := Compress(NewHandler())
+	get := func(ifNoneMatch, acceptEncoding string) *http.Response {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/holidays.ics", nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		if acceptEncoding != "" {
+			req.Header.Set("Accept-Encoding", acceptEncoding)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w.Result()
+	}
+
+	resp := get("", "")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if got := resp.Header.Get("Content-Type"); got != "text/calendar; charset=utf-8" {
+		t.Errorf("unexpected Content-Type: %q", got)
+	}
+	etag := resp.Header.Get("ETag")
+	if etag == "" {
+		t.Fatal("ETag is not set")
+	}
+
+	// the feed is stable.
+	if got := get("", "").Header.Get("ETag"); got != etag {
+		t.Errorf("ETag is not stable: %q, %q", etag, got)
+	}
+
+	if resp := get(etag, ""); resp.StatusCode != http.StatusNotModified {
+		t.Errorf("unexpected status code: want %d, got %d", http.StatusNotModified, resp.StatusCode)
+	}
+	if resp := get(`"other", `+etag, ""); resp.StatusCode != http.StatusNotModified {
+		t.Errorf("unexpected status code: want %d, got %d", http.StatusNotModified, resp.StatusCode)
+	}
+	if resp := get(`"other"`, ""); resp.StatusCode != http.StatusOK {
+		t.Errorf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+
+	// the compressed feed has the weak ETag, and it matches the original.
+	resp = get("", "gzip")
+	if got := resp.Header.Get("ETag"); got != "W/"+etag {
+		t.Errorf("unexpected ETag of the compressed feed: want %q, got %q", "W/"+etag, got)
+	}
+	resp = get("W/"+etag, "gzip")
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("unexpected status code: want %d, got %d", http.StatusNotModified, resp.StatusCode)
+	}
+	if got := resp.Header.Get("ETag"); got != "W/"+etag {
+		t.Errorf("unexpected ETag of 304: want %q, got %q", "W/"+etag, got)
+	}
+}
+
+func TestServeHTTP_Date(t *testing.T) {
+	h := NewHandler()
+	tests := []struct {
+		path   string
+		status int
+		want   DateResponse
+	}{
+		{
+			path:   "/date/2025-05-06",
+			status: http.StatusOK,
+			want: DateResponse{
+				Date:        "2025-05-06",
+				Weekday:     "tuesday",
+				Holiday:     true,
+				Name:        "休日",
+				Kind:        "substitute",
+				BusinessDay: false,
+				EraDate:     "令和7年5月6日",
+			},
+		},
+		{
+			path:   "/date/20250507",
+			status: http.StatusOK,
+			want: DateResponse{
+				Date:        "2025-05-07",
+				Weekday:     "wednesday",
+				BusinessDay: true,
+				EraDate:     "令和7年5月7日",
+			},
+		},
+		{
+			path:   "/date/2025-05-10",
+			status: http.StatusOK,
+			want: DateResponse{
+				Date:    "2025-05-10",
+				Weekday: "saturday",
+				EraDate: "令和7年5月10日",
+			},
+		},
+		{
+			path:   "/date/2025-02-29",
+			status: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "http://example.com"+tt.path, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.status {
+				t.Fatalf("unexpected status code: want %d, got %d", tt.status, resp.StatusCode)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			var got DateResponse
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("response mismatch (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestServeHTTP_Check(t *testing.T) {
+	h := NewHandler()
+	tests := []struct {
+		query  string
+		status int
+		want   CheckResponse
+	}{
+		{
+			// 2025-05-05 09:00 in Los Angeles is 2025-05-06 01:00 in Japan.
+			query:  "?at=2025-05-05T09:00&tz=America/Los_Angeles",
+			status: http.StatusOK,
+			want: CheckResponse{
+				Time:      "2025-05-05T09:00:00-07:00",
+				TimeZone:  "America/Los_Angeles",
+				JapanTime: "2025-05-06T01:00:00+09:00",
+				DateResponse: DateResponse{
+					Date:        "2025-05-06",
+					Weekday:     "tuesday",
+					Holiday:     true,
+					Name:        "休日",
+					Kind:        "substitute",
+					BusinessDay: false,
+					EraDate:     "令和7年5月6日",
+				},
+			},
+		},
+		{
+			// the offset in at wins over tz.
+			query:  "?at=2025-05-06T23:30:00%2B09:00&tz=UTC",
+			status: http.StatusOK,
+			want: CheckResponse{
+				Time:      "2025-05-06T14:30:00Z",
+				TimeZone:  "UTC",
+				JapanTime: "2025-05-06T23:30:00+09:00",
+				DateResponse: DateResponse{
+					Date:        "2025-05-06",
+					Weekday:     "tuesday",
+					Holiday:     true,
+					Name:        "休日",
+					Kind:        "substitute",
+					BusinessDay: false,
+					EraDate:     "令和7年5月6日",
+				},
+			},
+		},
+		{
+			// a date in the Japanese calendar means 00:00 of the day.
+			query:  "?at=" + url.QueryEscape("令和7年5月6日"),
+			status: http.StatusOK,
+			want: CheckResponse{
+				Time:      "2025-05-06T00:00:00+09:00",
+				TimeZone:  "Asia/Tokyo",
+				JapanTime: "2025-05-06T00:00:00+09:00",
+				DateResponse: DateResponse{
+					Date:        "2025-05-06",
+					Weekday:     "tuesday",
+					Holiday:     true,
+					Name:        "休日",
+					Kind:        "substitute",
+					BusinessDay: false,
+					EraDate:     "令和7年5月6日",
+				},
+			},
+		},
+		{
+			// Asia/Tokyo by default.
+			query:  "?at=2025-05-07T00:00",
+			status: http.StatusOK,
+			want: CheckResponse{
+				Time:      "2025-05-07T00:00:00+09:00",
+				TimeZone:  "Asia/Tokyo",
+				JapanTime: "2025-05-07T00:00:00+09:00",
+				DateResponse: DateResponse{
+					Date:        "2025-05-07",
+					Weekday:     "wednesday",
+					BusinessDay: true,
+					EraDate:     "令和7年5月7日",
+				},
+			},
+		},
+		{
+			query:  "?at=2025-05-07&tz=Asia/Tokyo",
+			status: http.StatusBadRequest,
+		},
+		{
+			query:  "?at=2025-05-07T00:00&tz=Mars/Olympus_Mons",
+			status: http.StatusBadRequest,
+		},
+		{
+			query:  "?tz=Local",
+			status: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "http://example.com/check"+tt.query, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.status {
+				t.Fatalf("unexpected status code: want %d, got %d", tt.status, resp.StatusCode)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			var got CheckResponse
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("response mismatch (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// the endpoints relative to now must use Handler.Clock.
+func TestServeHTTP_Clock(t *testing.T) {
+	h := NewHandler()
+	// 2030-06-01 12:00 JST
+	h.Clock = holiday.ClockFunc(func() time.Time {
+		return time.Date(2030, time.June, 1, 3, 0, 0, 0, time.UTC)
+	})
+
+	// the default year is this year.
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/holidays.csv", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	body, err := io.ReadAll(w.Result().Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "2030-01-01,元日,national"; !strings.Contains(string(body), want) {
+		t.Errorf("want %q in the body, got %q", want, body)
+	}
+
+	// the past days never change.
+	tests := []struct {
+		path  string
+		cache string
+	}{
+		{"/2030/05/31", "max-age=31536000"},
+		{"/2030/06/01", "max-age=86400"},
+		{"/check?at=2030-05-30T12:00:00%2B09:00", "max-age=31536000"},
+		{"/check?at=2030-06-01T12:00:00%2B09:00", "max-age=86400"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com"+tt.path, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if got := w.Result().Header.Get("Cache-Control"); got != tt.cache {
+			t.Errorf("%s: want Cache-Control %q, got %q", tt.path, tt.cache, got)
+		}
+	}
+}
+
+func TestServeHTTP_RequestID(t *testing.T) {
+	h := requestid.Handler(AccessLog(NewHandler()))
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/not-found", nil)
+	req.Header.Set(requestid.Header, "abc-123")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	resp := w.Result()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("unexpected status code: want %d, got %d", http.StatusNotFound, resp.StatusCode)
+	}
+	if got := resp.Header.Get(requestid.Header); got != "abc-123" {
+		t.Errorf("unexpected %s: want %q, got %q", requestid.Header, "abc-123", got)
+	}
+	var got ErrorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Error.RequestID != "abc-123" {
+		t.Errorf("unexpected request_id: want %q, got %q", "abc-123", got.Error.RequestID)
+	}
 }
 
 func TestParsePath(t *testing.T) {
@@ -340,6 +1051,41 @@ func TestParsePath(t *testing.T) {
 	}
 }
 
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		input string
+		want  holiday.Date
+		err   bool
+	}{
+		{input: "2000-01-02", want: holiday.Date{Year: 2000, Month: time.January, Day: 2}},
+		{input: "2000-1-2", want: holiday.Date{Year: 2000, Month: time.January, Day: 2}},
+		{input: "2000/01/02", want: holiday.Date{Year: 2000, Month: time.January, Day: 2}},
+		{input: "2000/1/2", want: holiday.Date{Year: 2000, Month: time.January, Day: 2}},
+		{input: "20000102", want: holiday.Date{Year: 2000, Month: time.January, Day: 2}},
+		{input: "2000-06-31", err: true},
+		{input: "9999-12-31", want: holiday.Date{Year: 9999, Month: time.December, Day: 31}},
+		{input: "0000-01-01", err: true},
+		{input: "10000-01-01", err: true},
+		{input: "", err: true},
+		{input: "2000-01", err: true},
+		{input: "2000/01-02", err: true},
+		{input: "2000-001-02", err: true},
+		{input: "2000-13-01", err: true},
+		{input: "2000-01-32", err: true},
+		{input: "2000010", err: true},
+		{input: "garbage!", err: true},
+	}
+	for _, tt := range tests {
+		got, err := parseDate(tt.input)
+		if tt.err != (err != nil) {
+			t.Errorf("%q: unexpected error: %v", tt.input, err)
+		}
+		if got != tt.want {
+			t.Errorf("%q: unexpected date: want %s, got %s", tt.input, tt.want, got)
+		}
+	}
+}
+
 func FuzzParseDate(f *testing.F) {
 	f.Add("2006-01-02")
 	f.Fuzz(func(t *testing.T, date string) {
@@ -357,3 +1103,22 @@ func FuzzParseDate(f *testing.F) {
 		}
 	})
 }
+
+func TestSetCommonHeaders(t *testing.T) {
+	w := httptest.NewRecorder()
+	setCommonHeaders(w, 36*time.Hour)
+	if got, want := w.Header().Get("Cache-Control"), "max-age=129600"; got != want {
+		t.Errorf("Cache-Control: want %q, got %q", want, got)
+	}
+	if got := w.Header().Get("Strict-Transport-Security"); got == "" {
+		t.Error("Strict-Transport-Security is not set")
+	}
+
+	// zero keeps Cache-Control set by the caller.
+	w = httptest.NewRecorder()
+	w.Header().Set("Cache-Control", "no-store")
+	setCommonHeaders(w, 0)
+	if got, want := w.Header().Get("Cache-Control"), "no-store"; got != want {
+		t.Errorf("Cache-Control: want %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,92 @@
+// Package holidaytest provides utilities for testing the code that depends on holidays.
+//
+// A Provider replaces the national holidays with a deterministic dataset,
+// and it is scoped to a holiday.Calendar, so tests don't affect each other:
+//
+//	p := holidaytest.New(nil)
+//	p.Add(holiday.Holiday{Date: "2030-01-01", Name: "元日"})
+//	c := p.Calendar()
+//	// use c instead of the package-level functions of holiday.
+package holidaytest
+
+import (
+	"sync"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// Provider is a holiday.HolidayProvider for tests.
+// It returns the holidays of the base provider, with the added holidays and without the removed ones.
+// It is safe for concurrent use by multiple goroutines.
+type Provider struct {
+	base holiday.HolidayProvider
+
+	mu      sync.RWMutex
+	added   map[string]holiday.Holiday
+	removed map[string]bool
+}
+
+// New returns a new provider based on base.
+// If base is nil, the provider has no holidays except the added ones.
+// Use holiday.Japan as base to modify the real national holidays.
+func New(base holiday.HolidayProvider) *Provider {
+	return &Provider{
+		base:    base,
+		added:   map[string]holiday.Holiday{},
+		removed: map[string]bool{},
+	}
+}
+
+// Add adds the holiday. It replaces the holiday on the same date.
+func (p *Provider) Add(h holiday.Holiday) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.added[h.Date] = h
+	delete(p.removed, h.Date)
+}
+
+// Remove removes the holiday on the date.
+func (p *Provider) Remove(date holiday.Date) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	d := date.String()
+	delete(p.added, d)
+	p.removed[d] = true
+}
+
+// HolidaysInRange implements holiday.HolidayProvider.
+func (p *Provider) HolidaysInRange(from, to holiday.Date) []holiday.Holiday {
+	start, end := from.String(), to.String()
+	if start > end {
+		start, end = end, start
+		from, to = to, from
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	var result []holiday.Holiday
+	if p.base != nil {
+		for _, h := range p.base.HolidaysInRange(from, to) {
+			if p.removed[h.Date] {
+				continue
+			}
+			if _, ok := p.added[h.Date]; ok {
+				continue
+			}
+			result = append(result, h)
+		}
+	}
+	for date, h := range p.added {
+		if start <= date && date <= end {
+			result = append(result, h)
+		}
+	}
+	holiday.SortHolidays(result)
+	return result
+}
+
+// Calendar returns a new calendar that uses only p.
+func (p *Provider) Calendar() *holiday.Calendar {
+	return holiday.NewCalendar(p)
+}
@@ -0,0 +1,51 @@
+package holidaytest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestProvider(t *testing.T) {
+	p := New(nil)
+	p.Add(holiday.Holiday{Date: "2030-01-02", Name: "テストの日"})
+	p.Add(holiday.Holiday{Date: "2030-01-01", Name: "元日"})
+
+	got := p.HolidaysInRange(holiday.Date{Year: 2030, Month: time.January, Day: 1}, holiday.Date{Year: 2030, Month: time.December, Day: 31})
+	want := []holiday.Holiday{
+		{Date: "2030-01-01", Name: "元日"},
+		{Date: "2030-01-02", Name: "テストの日"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("HolidaysInRange() mismatch (-want/+got):\n%s", diff)
+	}
+
+	c := p.Calendar()
+	// 2030-01-02 is Wednesday.
+	if c.IsBusinessDay(time.Date(2030, time.January, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Error("2030-01-02 should not be a business day")
+	}
+}
+
+func TestProvider_Japan(t *testing.T) {
+	p := New(holiday.Japan)
+	p.Remove(holiday.Date{Year: 2024, Month: time.August, Day: 12})
+	p.Add(holiday.Holiday{Date: "2024-08-13", Name: "お盆", Kind: holiday.KindCustom})
+	p.Add(holiday.Holiday{Date: "2024-08-11", Name: "山の日（テスト）"})
+
+	got := p.HolidaysInRange(holiday.Date{Year: 2024, Month: time.August, Day: 31}, holiday.Date{Year: 2024, Month: time.August, Day: 1})
+	want := []holiday.Holiday{
+		{Date: "2024-08-11", Name: "山の日（テスト）"},
+		{Date: "2024-08-13", Name: "お盆", Kind: holiday.KindCustom},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("HolidaysInRange() mismatch (-want/+got):\n%s", diff)
+	}
+
+	// the real data is not affected.
+	if !holiday.IsHoliday(time.Date(2024, time.August, 12, 0, 0, 0, 0, time.UTC)) {
+		t.Error("2024-08-12 should be a holiday")
+	}
+}
@@ -0,0 +1,563 @@
+// Package ics imports iCalendar (RFC 5545) files as holidays,
+// e.g. closure calendars exported from Outlook or Google Calendar,
+// and exports holidays as iCalendar feeds.
+package ics
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// Calendar is a parsed iCalendar file.
+// Its methods are safe for concurrent use, as long as the calendar is not modified after it is parsed.
+type Calendar struct {
+	Events []Event
+}
+
+// Event is a VEVENT component.
+type Event struct {
+	UID     string
+	Summary string
+
+	// Start is the first day of the event in JST.
+	Start holiday.Date
+
+	// Days is the number of days that the event spans. It is at least 1.
+	Days int
+
+	// Rule is the recurrence rule of the event. It is nil if the event doesn't recur.
+	Rule *Rule
+
+	// ExDates are the excluded days of the recurrence.
+	ExDates []holiday.Date
+}
+
+// Rule is a recurrence rule (RRULE).
+// FREQ, INTERVAL, COUNT, UNTIL, BYMONTH, BYMONTHDAY, and BYDAY are supported.
+type Rule struct {
+	Freq       string // DAILY, WEEKLY, MONTHLY, or YEARLY
+	Interval   int
+	Count      int
+	Until      holiday.Date
+	ByMonth    []time.Month
+	ByMonthDay []int
+	ByDay      []WeekdayNum
+}
+
+// WeekdayNum is an element of BYDAY, e.g. "2MO" is the second Monday and "-1FR" is the last Friday.
+// N is zero if no ordinal is specified.
+type WeekdayNum struct {
+	N       int
+	Weekday time.Weekday
+}
+
+// ParseError is an error while parsing an iCalendar file.
+type ParseError struct {
+	Line int
+	Err  error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("ics: line %d: %v", e.Line, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Parse parses an iCalendar file.
+func Parse(r io.Reader) (*Calendar, error) {
+	cal := &Calendar{}
+	var ev *Event
+	var dtend holiday.Date
+	var hasEnd bool
+
+	err := readProperties(r, func(line int, p property) error {
+		switch {
+		case p.name == "BEGIN" && strings.EqualFold(p.value, "VEVENT"):
+			ev = &Event{}
+			hasEnd = false
+		case p.name == "END" && strings.EqualFold(p.value, "VEVENT"):
+			if ev == nil {
+				return errors.New("unexpected END:VEVENT")
+			}
+			if ev.Start == (holiday.Date{}) {
+				return errors.New("DTSTART is missing")
+			}
+			ev.Days = 1
+			if hasEnd {
+				days := daysBetween(ev.Start, dtend)
+				if days > 1 {
+					ev.Days = days
+				}
+			}
+			cal.Events = append(cal.Events, *ev)
+			ev = nil
+		case ev == nil:
+			// ignore properties out of VEVENT
+		case p.name == "UID":
+			ev.UID = p.value
+		case p.name == "SUMMARY":
+			ev.Summary = unescape(p.value)
+		case p.name == "DTSTART":
+			d, err := parseDate(p)
+			if err != nil {
+				return err
+			}
+			ev.Start = d
+		case p.name == "DTEND":
+			d, err := parseDate(p)
+			if err != nil {
+				return err
+			}
+			dtend = d
+			hasEnd = true
+		case p.name == "RRULE":
+			rule, err := parseRule(p.value)
+			if err != nil {
+				return err
+			}
+			ev.Rule = rule
+		case p.name == "EXDATE":
+			for _, v := range strings.Split(p.value, ",") {
+				d, err := parseDate(property{name: p.name, params: p.params, value: v})
+				if err != nil {
+					return err
+				}
+				ev.ExDates = append(ev.ExDates, d)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cal, nil
+}
+
+// readProperties unfolds the content lines and calls fn for each property.
+func readProperties(r io.Reader, fn func(line int, p property) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var current string
+	var currentLine, lineNum int
+	flush := func() error {
+		if current == "" {
+			return nil
+		}
+		p, err := parseProperty(current)
+		if err == nil {
+			err = fn(currentLine, p)
+		}
+		if err != nil {
+			return &ParseError{Line: currentLine, Err: err}
+		}
+		return nil
+	}
+
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if lineNum == 1 {
+			line = strings.TrimPrefix(line, "\ufeff")
+		}
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			// folded line
+			current += line[1:]
+			continue
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+		current = line
+		currentLine = lineNum
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	return flush()
+}
+
+func parseProperty(line string) (property, error) {
+	// find the colon that is not quoted.
+	idx := -1
+	quoted := false
+	for i, ch := range line {
+		if ch == '"' {
+			quoted = !quoted
+		} else if ch == ':' && !quoted {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return property{}, fmt.Errorf("invalid content line: %q", line)
+	}
+
+	head, value := line[:idx], line[idx+1:]
+	parts := strings.Split(head, ";")
+	p := property{
+		name:   strings.ToUpper(parts[0]),
+		params: map[string]string{},
+		value:  value,
+	}
+	for _, param := range parts[1:] {
+		k, v, _ := strings.Cut(param, "=")
+		p.params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+	}
+	return p, nil
+}
+
+// parseDate parses DATE or DATE-TIME values and returns the date in JST.
+func parseDate(p property) (holiday.Date, error) {
+	v := strings.TrimSpace(p.value)
+	if len(v) == 8 {
+		t, err := time.Parse("20060102", v)
+		if err != nil {
+			return holiday.Date{}, fmt.Errorf("invalid %s: %q", p.name, v)
+		}
+		return holiday.Date{Year: t.Year(), Month: t.Month(), Day: t.Day()}, nil
+	}
+
+	loc := holiday.JST
+	if tzid, ok := p.params["TZID"]; ok {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		}
+	}
+	var t time.Time
+	var err error
+	if strings.HasSuffix(v, "Z") {
+		t, err = time.Parse("20060102T150405Z", v)
+	} else {
+		t, err = time.ParseInLocation("20060102T150405", v, loc)
+	}
+	if err != nil {
+		return holiday.Date{}, fmt.Errorf("invalid %s: %q", p.name, v)
+	}
+	t = t.In(holiday.JST)
+	return holiday.Date{Year: t.Year(), Month: t.Month(), Day: t.Day()}, nil
+}
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+func parseRule(s string) (*Rule, error) {
+	rule := &Rule{Interval: 1}
+	for _, part := range strings.Split(s, ";") {
+		k, v, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid RRULE: %q", s)
+		}
+		switch strings.ToUpper(k) {
+		case "FREQ":
+			switch v = strings.ToUpper(v); v {
+			case "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+				rule.Freq = v
+			default:
+				return nil, fmt.Errorf("unsupported FREQ: %q", v)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(v)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("invalid INTERVAL: %q", v)
+			}
+			rule.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(v)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("invalid COUNT: %q", v)
+			}
+			rule.Count = n
+		case "UNTIL":
+			d, err := parseDate(property{name: "UNTIL", value: v})
+			if err != nil {
+				return nil, err
+			}
+			rule.Until = d
+		case "BYMONTH":
+			for _, m := range strings.Split(v, ",") {
+				n, err := strconv.Atoi(m)
+				if err != nil || n < 1 || n > 12 {
+					return nil, fmt.Errorf("invalid BYMONTH: %q", v)
+				}
+				rule.ByMonth = append(rule.ByMonth, time.Month(n))
+			}
+		case "BYMONTHDAY":
+			for _, d := range strings.Split(v, ",") {
+				n, err := strconv.Atoi(d)
+				if err != nil || n == 0 || n < -31 || n > 31 {
+					return nil, fmt.Errorf("invalid BYMONTHDAY: %q", v)
+				}
+				rule.ByMonthDay = append(rule.ByMonthDay, n)
+			}
+		case "BYDAY":
+			for _, d := range strings.Split(v, ",") {
+				d = strings.ToUpper(d)
+				if len(d) < 2 {
+					return nil, fmt.Errorf("invalid BYDAY: %q", v)
+				}
+				wd, ok := weekdays[d[len(d)-2:]]
+				if !ok {
+					return nil, fmt.Errorf("invalid BYDAY: %q", v)
+				}
+				var n int
+				if num := d[:len(d)-2]; num != "" {
+					var err error
+					n, err = strconv.Atoi(num)
+					if err != nil || n == 0 || n < -53 || n > 53 {
+						return nil, fmt.Errorf("invalid BYDAY: %q", v)
+					}
+				}
+				rule.ByDay = append(rule.ByDay, WeekdayNum{N: n, Weekday: wd})
+			}
+		}
+	}
+	if rule.Freq == "" {
+		return nil, fmt.Errorf("FREQ is missing: %q", s)
+	}
+	return rule, nil
+}
+
+func unescape(s string) string {
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+			switch s[i] {
+			case 'n', 'N':
+				buf.WriteByte('\n')
+			default:
+				buf.WriteByte(s[i])
+			}
+			continue
+		}
+		buf.WriteByte(s[i])
+	}
+	return buf.String()
+}
+
+// HolidaysInRange returns the days of the events between from and to (inclusive).
+// The results are sorted by date. If some events overlap, the first event in the file wins.
+func (c *Calendar) HolidaysInRange(from, to holiday.Date) []holiday.Holiday {
+	if from.Compare(to) > 0 {
+		from, to = to, from
+	}
+
+	seen := map[holiday.Date]bool{}
+	var result []holiday.Holiday
+	for _, ev := range c.Events {
+		for _, start := range ev.occurrences(to) {
+			for i := 0; i < ev.Days; i++ {
+				d := addDays(start, i)
+				if d.Compare(from) < 0 || d.Compare(to) > 0 || seen[d] {
+					continue
+				}
+				seen[d] = true
+				result = append(result, holiday.Holiday{
+					Date: d.String(),
+					Name: ev.Summary,
+					Kind: holiday.KindCustom,
+				})
+			}
+		}
+	}
+	holiday.SortHolidays(result)
+	return result
+}
+
+// HolidaysWithNational returns the national holidays and the days of the events between from and to (inclusive).
+// National holidays take precedence over the events on the same day.
+func (c *Calendar) HolidaysWithNational(from, to holiday.Date) []holiday.Holiday {
+	return holiday.Merge(holiday.FindHolidaysInRange(from, to), c.HolidaysInRange(from, to))
+}
+
+// maxOccurrences limits the expansion of the recurrence rules.
+const maxOccurrences = 100000
+
+// occurrences returns the start days of the event until the limit.
+func (ev *Event) occurrences(limit holiday.Date) []holiday.Date {
+	if ev.Rule == nil {
+		return []holiday.Date{ev.Start}
+	}
+
+	excluded := map[holiday.Date]bool{}
+	for _, d := range ev.ExDates {
+		excluded[d] = true
+	}
+
+	rule := ev.Rule
+	var result []holiday.Date
+	var count int
+	for period := 0; count < maxOccurrences; period++ {
+		candidates, periodStart := rule.expand(ev.Start, period)
+		if periodStart.Compare(limit) > 0 {
+			break
+		}
+		for _, d := range candidates {
+			if d.Compare(ev.Start) < 0 {
+				continue
+			}
+			if rule.Until != (holiday.Date{}) && d.Compare(rule.Until) > 0 {
+				return result
+			}
+			count++
+			if rule.Count > 0 && count > rule.Count {
+				return result
+			}
+			if !excluded[d] {
+				result = append(result, d)
+			}
+		}
+	}
+	return result
+}
+
+// expand returns the candidates in the n-th period of the rule, and the first day of the period.
+func (r *Rule) expand(start holiday.Date, n int) ([]holiday.Date, holiday.Date) {
+	step := n * r.Interval
+	switch r.Freq {
+	case "DAILY":
+		d := addDays(start, step)
+		return []holiday.Date{d}, d
+
+	case "WEEKLY":
+		// weeks start on Monday (WKST=MO).
+		t := toTime(start)
+		offset := (int(t.Weekday()) + 6) % 7
+		monday := addDays(start, step*7-offset)
+		if len(r.ByDay) == 0 {
+			d := addDays(start, step*7)
+			return []holiday.Date{d}, monday
+		}
+		var result []holiday.Date
+		for i := 0; i < 7; i++ {
+			d := addDays(monday, i)
+			for _, wd := range r.ByDay {
+				if toTime(d).Weekday() == wd.Weekday {
+					result = append(result, d)
+					break
+				}
+			}
+		}
+		return result, monday
+
+	case "MONTHLY":
+		t := time.Date(start.Year, start.Month+time.Month(step), 1, 0, 0, 0, 0, time.UTC)
+		first := holiday.Date{Year: t.Year(), Month: t.Month(), Day: 1}
+		return r.expandMonth(start, first.Year, first.Month), first
+
+	case "YEARLY":
+		year := start.Year + step
+		months := r.ByMonth
+		if len(months) == 0 {
+			months = []time.Month{start.Month}
+		}
+		var result []holiday.Date
+		for _, m := range months {
+			result = append(result, r.expandMonth(start, year, m)...)
+		}
+		sortDates(result)
+		return result, holiday.Date{Year: year, Month: time.January, Day: 1}
+	}
+	return nil, start
+}
+
+// expandMonth returns the days in the month that match BYMONTHDAY and BYDAY.
+func (r *Rule) expandMonth(start holiday.Date, year int, month time.Month) []holiday.Date {
+	if len(r.ByMonth) > 0 && r.Freq == "MONTHLY" && !containsMonth(r.ByMonth, month) {
+		return nil
+	}
+
+	days := daysIn(year, month)
+	var result []holiday.Date
+	switch {
+	case len(r.ByMonthDay) > 0:
+		for _, d := range r.ByMonthDay {
+			if d < 0 {
+				d = days + d + 1
+			}
+			if 1 <= d && d <= days {
+				result = append(result, holiday.Date{Year: year, Month: month, Day: d})
+			}
+		}
+	case len(r.ByDay) > 0:
+		for _, wd := range r.ByDay {
+			var matches []holiday.Date
+			for d := 1; d <= days; d++ {
+				date := holiday.Date{Year: year, Month: month, Day: d}
+				if toTime(date).Weekday() == wd.Weekday {
+					matches = append(matches, date)
+				}
+			}
+			switch {
+			case wd.N == 0:
+				result = append(result, matches...)
+			case wd.N > 0 && wd.N <= len(matches):
+				result = append(result, matches[wd.N-1])
+			case wd.N < 0 && -wd.N <= len(matches):
+				result = append(result, matches[len(matches)+wd.N])
+			}
+		}
+	default:
+		if start.Day <= days {
+			result = append(result, holiday.Date{Year: year, Month: month, Day: start.Day})
+		}
+	}
+	sortDates(result)
+	return result
+}
+
+func containsMonth(months []time.Month, m time.Month) bool {
+	for _, v := range months {
+		if v == m {
+			return true
+		}
+	}
+	return false
+}
+
+func toTime(d holiday.Date) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+func addDays(d holiday.Date, n int) holiday.Date {
+	t := time.Date(d.Year, d.Month, d.Day+n, 0, 0, 0, 0, time.UTC)
+	return holiday.Date{Year: t.Year(), Month: t.Month(), Day: t.Day()}
+}
+
+func daysBetween(a, b holiday.Date) int {
+	return int(toTime(b).Sub(toTime(a)) / (24 * time.Hour))
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func sortDates(dates []holiday.Date) {
+	sort.Slice(dates, func(i, j int) bool {
+		return dates[i].Compare(dates[j]) < 0
+	})
+}
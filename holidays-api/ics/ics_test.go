@@ -0,0 +1,146 @@
+package ics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+const closureCalendar = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Microsoft Corporation//Outlook 16.0 MIMEDIR//EN\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:obon\r\n" +
+	"SUMMARY:夏季休業\r\n" +
+	"DTSTART;VALUE=DATE:20240813\r\n" +
+	"DTEND;VALUE=DATE:20240816\r\n" +
+	"RRULE:FREQ=YEARLY;COUNT=3\r\n" +
+	"EXDATE;VALUE=DATE:20250813\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:foundation\r\n" +
+	"SUMMARY:創立記念日\\, 全社休業\r\n" +
+	"DTSTART;TZID=Asia/Tokyo:20240610T000000\r\n" +
+	"DTEND;TZID=Asia/Tokyo:20240610T235959\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:inventory\r\n" +
+	"SUMMARY:棚卸\r\n" +
+	" 日\r\n" +
+	"DTSTART;VALUE=DATE:20240126\r\n" +
+	"RRULE:FREQ=MONTHLY;BYMONTH=1,7;BYDAY=-1FR;UNTIL=20250101\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParse(t *testing.T) {
+	cal, err := Parse(strings.NewReader(closureCalendar))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := cal.HolidaysInRange(
+		holiday.Date{Year: 2024, Month: time.January, Day: 1},
+		holiday.Date{Year: 2027, Month: time.December, Day: 31},
+	)
+	want := []holiday.Holiday{
+		{Date: "2024-01-26", Name: "棚卸日", Kind: holiday.KindCustom},
+		{Date: "2024-06-10", Name: "創立記念日, 全社休業", Kind: holiday.KindCustom},
+		{Date: "2024-07-26", Name: "棚卸日", Kind: holiday.KindCustom},
+		{Date: "2024-08-13", Name: "夏季休業", Kind: holiday.KindCustom},
+		{Date: "2024-08-14", Name: "夏季休業", Kind: holiday.KindCustom},
+		{Date: "2024-08-15", Name: "夏季休業", Kind: holiday.KindCustom},
+		// the occurrence on 2025-08-13 is excluded.
+		{Date: "2026-08-13", Name: "夏季休業", Kind: holiday.KindCustom},
+		{Date: "2026-08-14", Name: "夏季休業", Kind: holiday.KindCustom},
+		{Date: "2026-08-15", Name: "夏季休業", Kind: holiday.KindCustom},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("holidays mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestHolidaysWithNational(t *testing.T) {
+	cal, err := Parse(strings.NewReader(closureCalendar))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := cal.HolidaysWithNational(
+		holiday.Date{Year: 2024, Month: time.August, Day: 1},
+		holiday.Date{Year: 2024, Month: time.August, Day: 31},
+	)
+	want := []holiday.Holiday{
+		{Date: "2024-08-11", Name: "山の日"},
+		{Date: "2024-08-12", Name: "休日", Kind: holiday.KindSubstitute},
+		{Date: "2024-08-13", Name: "夏季休業", Kind: holiday.KindCustom},
+		{Date: "2024-08-14", Name: "夏季休業", Kind: holiday.KindCustom},
+		{Date: "2024-08-15", Name: "夏季休業", Kind: holiday.KindCustom},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("holidays mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestRuleExpand(t *testing.T) {
+	tests := []struct {
+		rrule string
+		start holiday.Date
+		want  []string
+	}{
+		{
+			rrule: "FREQ=DAILY;INTERVAL=2;COUNT=3",
+			start: holiday.Date{Year: 2024, Month: time.December, Day: 30},
+			want:  []string{"2024-12-30", "2025-01-01", "2025-01-03"},
+		},
+		{
+			rrule: "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=4",
+			start: holiday.Date{Year: 2024, Month: time.January, Day: 3},
+			want:  []string{"2024-01-03", "2024-01-08", "2024-01-10", "2024-01-15"},
+		},
+		{
+			rrule: "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3",
+			start: holiday.Date{Year: 2024, Month: time.January, Day: 31},
+			want:  []string{"2024-01-31", "2024-02-29", "2024-03-31"},
+		},
+		{
+			rrule: "FREQ=YEARLY;BYMONTH=1;BYDAY=2MO;COUNT=2",
+			start: holiday.Date{Year: 2024, Month: time.January, Day: 8},
+			want:  []string{"2024-01-08", "2025-01-13"},
+		},
+	}
+
+	for _, tt := range tests {
+		rule, err := parseRule(tt.rrule)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ev := &Event{Start: tt.start, Days: 1, Rule: rule}
+		var got []string
+		for _, d := range ev.occurrences(holiday.Date{Year: 2100, Month: time.January, Day: 1}) {
+			got = append(got, d.String())
+		}
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("%s: occurrences mismatch (-want/+got):\n%s", tt.rrule, diff)
+		}
+	}
+}
+
+func TestParse_Error(t *testing.T) {
+	const data = "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"DTSTART;VALUE=DATE:2024\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+	_, err := Parse(strings.NewReader(data))
+	var perr *ParseError
+	if !errors.As(err, &perr) {
+		t.Fatalf("want ParseError, got %v", err)
+	}
+	if perr.Line != 3 {
+		t.Errorf("want line 3, got %d", perr.Line)
+	}
+}
@@ -0,0 +1,92 @@
+package ics
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// Feed writes holidays as an iCalendar feed for calendar subscriptions.
+type Feed struct {
+	// Name is the name of the calendar shown in the calendar clients (X-WR-CALNAME).
+	Name string
+
+	// Domain is the right-hand side of the UIDs.
+	// The UID of an event is "YYYYMMDD@Domain", so it is stable between the fetches,
+	// and the clients update the events instead of duplicating them.
+	Domain string
+
+	// Stamp is the DTSTAMP of the events.
+	// It should be stable between the fetches too, e.g. the time when the data was generated.
+	Stamp time.Time
+}
+
+// Write writes the holidays as a VCALENDAR.
+// The output is deterministic, so it can be compared to detect changes.
+//
+// The events have no SEQUENCE, which means 0 (RFC 5545 Section 3.8.7.4).
+// Deriving it from the revisions of each date, e.g. a renamed holiday, needs the history of the datasets,
+// which is not kept, so it is out of scope.
+func (f *Feed) Write(w io.Writer, holidays []holiday.Holiday) error {
+	bw := bufio.NewWriter(w)
+	writeLine(bw, "BEGIN:VCALENDAR")
+	writeLine(bw, "VERSION:2.0")
+	writeLine(bw, "PRODID:-//shogo82148//holidays-jp//JA")
+	writeLine(bw, "CALSCALE:GREGORIAN")
+	writeLine(bw, "METHOD:PUBLISH")
+	if f.Name != "" {
+		writeLine(bw, "X-WR-CALNAME:"+escape(f.Name))
+	}
+	writeLine(bw, "X-WR-TIMEZONE:Asia/Tokyo")
+
+	stamp := f.Stamp.UTC().Format("20060102T150405Z")
+	for _, h := range holidays {
+		d, err := holiday.ParseDate(h.Date)
+		if err != nil {
+			return err
+		}
+		start := time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+		end := start.AddDate(0, 0, 1)
+		writeLine(bw, "BEGIN:VEVENT")
+		writeLine(bw, "UID:"+start.Format("20060102")+"@"+f.Domain)
+		writeLine(bw, "DTSTAMP:"+stamp)
+		writeLine(bw, "DTSTART;VALUE=DATE:"+start.Format("20060102"))
+		writeLine(bw, "DTEND;VALUE=DATE:"+end.Format("20060102"))
+		writeLine(bw, "SUMMARY:"+escape(h.Name))
+		writeLine(bw, "CATEGORIES:"+escape(h.Kind.String()))
+		writeLine(bw, "TRANSP:TRANSPARENT")
+		writeLine(bw, "END:VEVENT")
+	}
+	writeLine(bw, "END:VCALENDAR")
+	return bw.Flush()
+}
+
+// writeLine writes a content line, folding it at 75 octets without breaking UTF-8 sequences.
+func writeLine(w *bufio.Writer, line string) {
+	const maxOctets = 75
+	limit := maxOctets
+	for len(line) > limit {
+		i := limit
+		for i > 0 && !utf8.RuneStart(line[i]) {
+			i--
+		}
+		w.WriteString(line[:i])
+		w.WriteString("\r\n ")
+		line = line[i:]
+		// the leading space of the continuation line counts.
+		limit = maxOctets - 1
+	}
+	w.WriteString(line)
+	w.WriteString("\r\n")
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+
+// escape escapes TEXT values. It is the inverse of unescape.
+func escape(s string) string {
+	return escaper.Replace(s)
+}
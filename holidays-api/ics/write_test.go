@@ -0,0 +1,82 @@
+package ics
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestFeedWrite(t *testing.T) {
+	f := &Feed{
+		Name:   "日本の祝日",
+		Domain: "holidays-jp.example.com",
+		Stamp:  time.Date(2024, time.February, 1, 9, 0, 0, 0, holiday.JST),
+	}
+	holidays := holiday.FindHolidaysInRange(holiday.Date{Year: 2021, Month: time.January, Day: 1}, holiday.Date{Year: 2021, Month: time.December, Day: 31})
+
+	var buf bytes.Buffer
+	if err := f.Write(&buf, holidays); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "BEGIN:VEVENT\r\nUID:20210101@holidays-jp.example.com\r\nDTSTAMP:20240201T000000Z\r\nDTSTART;VALUE=DATE:20210101\r\nDTEND;VALUE=DATE:20210102\r\nSUMMARY:元日\r\n") {
+		t.Errorf("unexpected event:\n%s", buf.String())
+	}
+
+	if strings.Contains(buf.String(), "SEQUENCE:") {
+		t.Error("want no SEQUENCE, got one")
+	}
+
+	// the output is deterministic.
+	var again bytes.Buffer
+	if err := f.Write(&again, holidays); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), again.Bytes()) {
+		t.Error("the output is not deterministic")
+	}
+
+	// the output can be parsed.
+	cal, err := Parse(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := cal.HolidaysInRange(holiday.Date{Year: 2021, Month: time.January, Day: 1}, holiday.Date{Year: 2021, Month: time.December, Day: 31})
+	var want []holiday.Holiday
+	for _, h := range holidays {
+		want = append(want, holiday.Holiday{Date: h.Date, Name: h.Name, Kind: holiday.KindCustom})
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("holidays mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestFeedWrite_Fold(t *testing.T) {
+	f := &Feed{Domain: "example.com"}
+	name := strings.Repeat("長い名前の休日、", 10)
+
+	var buf bytes.Buffer
+	if err := f.Write(&buf, []holiday.Holiday{{Date: "2021-01-01", Name: name}}); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(buf.String(), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("too long line: %q", line)
+		}
+		if !utf8.ValidString(line) {
+			t.Errorf("broken UTF-8 sequence: %q", line)
+		}
+	}
+
+	cal, err := Parse(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cal.Events[0].Summary; got != name {
+		t.Errorf("want %q, got %q", name, got)
+	}
+}
@@ -0,0 +1,162 @@
+// Package astro calculates the position of the sun for the equinox days and the sunrise and sunset.
+package astro
+
+import (
+	"math"
+	"time"
+)
+
+// from 長沢 工(1999) "日の出・日の入りの計算 天体の出没時刻の求め方" 株式会社地人書館
+var sunLongitudeTable = [...][3]float64{
+	{0.0200, 355.05, 719.981},
+	{0.0048, 234.95, 19.341},
+	{0.0020, 247.1, 329.64},
+	{0.0018, 297.8, 4452.67},
+	{0.0018, 251.3, 0.20},
+	{0.0015, 343.2, 450.37},
+	{0.0013, 81.4, 225.18},
+	{0.0008, 132.5, 659.29},
+	{0.0007, 153.3, 90.38},
+	{0.0007, 206.8, 30.35},
+	{0.0006, 29.8, 337.18},
+	{0.0005, 207.4, 1.50},
+	{0.0005, 291.2, 22.81},
+	{0.0004, 234.9, 315.56},
+	{0.0004, 157.3, 299.30},
+	{0.0004, 21.1, 720.02},
+	{0.0003, 352.5, 1079.97},
+	{0.0003, 329.7, 44.43},
+}
+
+// JulianYear is a number of julian years from J2000.0(2000/01/01 12:00 Terrestrial Time)
+type JulianYear float64
+
+var j2000 = time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC).Unix()
+
+// JulianYearOf converts t in UT(Universal Time) into JulianYear.
+func JulianYearOf(t time.Time) JulianYear {
+	d := float64(t.Unix() - j2000)
+
+	// convert UT(Universal Time) into TT(Terrestrial Time)
+	d += DeltaT(DecimalYear(t))
+	return JulianYear(d / ((365*24 + 6) * 60 * 60))
+}
+
+// DecimalYear returns the year of t with the fraction, e.g. 2000.5 for the middle of 2000.
+// It follows the Gregorian leap rules, so 2100 has 365 days.
+func DecimalYear(t time.Time) float64 {
+	t = t.UTC()
+	start := time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(t.Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return float64(t.Year()) + float64(t.Sub(start))/float64(end.Sub(start))
+}
+
+// DeltaT returns ΔT = TT - UT in seconds.
+// from Espenak and Meeus (2006) "Five Millennium Canon of Solar Eclipses: -1999 to +3000"
+// https://eclipse.gsfc.nasa.gov/SEhelp/deltatpoly2004.html
+//
+// The polynomials are fitted to the observations until 2005, and extrapolated after that.
+// The error of ΔT grows to a few minutes around 2150, which is small enough to find the equinox days
+// unless the equinox is just around midnight.
+func DeltaT(y float64) float64 {
+	switch {
+	case y < 1860:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	case y < 1900:
+		t := y - 1860
+		return 7.62 + 0.5737*t - 0.251754*t*t + 0.01680668*t*t*t - 0.0004473624*t*t*t*t + t*t*t*t*t/233174
+	case y < 1920:
+		t := y - 1900
+		return -2.79 + 1.494119*t - 0.0598939*t*t + 0.0061966*t*t*t - 0.000197*t*t*t*t
+	case y < 1941:
+		t := y - 1920
+		return 21.20 + 0.84493*t - 0.076100*t*t + 0.0020936*t*t*t
+	case y < 1961:
+		t := y - 1950
+		return 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+	case y < 1986:
+		t := y - 1975
+		return 45.45 + 1.067*t - t*t/260 - t*t*t/718
+	case y < 2005:
+		t := y - 2000
+		return 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*t*t*t*t + 0.00002373599*t*t*t*t*t
+	case y < 2050:
+		t := y - 2000
+		return 62.92 + 0.32217*t + 0.005589*t*t
+	case y < 2150:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-y)
+	default:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	}
+}
+
+// SunLongitude returns the ecliptic longitude of the sun in degrees.
+func SunLongitude(jy JulianYear) float64 {
+	t := float64(jy)
+	l := normalizeDegree(360.00769 * t)
+	l = normalizeDegree(l + 280.4603)
+	l = normalizeDegree(l + (1.9146-0.00005*t)*sin(357.538+359.991*t))
+	for _, b := range sunLongitudeTable {
+		l = normalizeDegree(l + b[0]*sin(b[1]+b[2]*t))
+	}
+	return l
+}
+
+// SunDistance returns the distance between the earth and the sun in astronomical units.
+func SunDistance(jy JulianYear) float64 {
+	t := float64(jy)
+	q := (0.007256 - 0.0000002*t) * sin(267.54+359.991*t)
+	q += 0.000091 * sin(265.1+719.98*t)
+	q += 0.000030 * sin(90.0)
+	q += 0.000013 * sin(27.8+4452.67*t)
+	q += 0.000007 * sin(254+450.4*t)
+	q += 0.000007 * sin(156+329.6*t)
+	return math.Pow(10, q)
+}
+
+// Obliquity returns the obliquity of the ecliptic in degrees.
+func Obliquity(jy JulianYear) float64 {
+	return 23.439291 - 0.000130042*float64(jy)
+}
+
+// SunEquatorial returns the right ascension and the declination of the sun in degrees.
+func SunEquatorial(jy JulianYear) (ra, dec float64) {
+	l := SunLongitude(jy)
+	e := Obliquity(jy)
+	ra = normalizeDegree(atan2(cos(e)*sin(l), cos(l)))
+	dec = asin(sin(e) * sin(l))
+	return
+}
+
+// SiderealTime returns the local sidereal time in degrees at the longitude at t in UT.
+func SiderealTime(t time.Time, longitude float64) float64 {
+	d := float64(t.UnixNano()-j2000*int64(time.Second)) / float64(24*time.Hour)
+	return normalizeDegree(280.46061837 + 360.98564736629*d + longitude)
+}
+
+func sin(x float64) float64 {
+	return math.Sin(x / 180 * math.Pi)
+}
+
+func normalizeDegree(x float64) float64 {
+	x = math.Mod(x, 360)
+	if x < 0 {
+		x += 360
+	}
+	return x
+}
+
+func cos(x float64) float64 {
+	return math.Cos(x / 180 * math.Pi)
+}
+
+func asin(x float64) float64 {
+	return math.Asin(x) / math.Pi * 180
+}
+
+func atan2(y, x float64) float64 {
+	return math.Atan2(y, x) / math.Pi * 180
+}
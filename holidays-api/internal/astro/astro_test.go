@@ -0,0 +1,72 @@
+package astro
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDecimalYear(t *testing.T) {
+	tests := []struct {
+		t    time.Time
+		want float64
+	}{
+		{time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), 2000},
+		{time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC), 2100},
+
+		// 2024 is a leap year, and 2100 is not.
+		{time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), 2024 + 60.0/366},
+		{time.Date(2100, time.March, 1, 0, 0, 0, 0, time.UTC), 2100 + 59.0/365},
+	}
+	for _, tt := range tests {
+		if got := DecimalYear(tt.t); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("DecimalYear(%s): want %f, got %f", tt.t, tt.want, got)
+		}
+	}
+}
+
+func TestDeltaT(t *testing.T) {
+	tests := []struct {
+		year float64
+		want float64
+	}{
+		{1950, 29.07},
+		{2000, 63.86},
+		{2150, 328.48},
+	}
+	for _, tt := range tests {
+		if got := DeltaT(tt.year); math.Abs(got-tt.want) > 0.01 {
+			t.Errorf("DeltaT(%f): want %f, got %f", tt.year, tt.want, got)
+		}
+	}
+}
+
+func TestSunEquatorial(t *testing.T) {
+	// the vernal equinox of 2024 is at 2024-03-20 03:06 UTC.
+	ra, dec := SunEquatorial(JulianYearOf(time.Date(2024, time.March, 20, 3, 6, 0, 0, time.UTC)))
+	if math.Min(ra, 360-ra) > 0.01 || math.Abs(dec) > 0.01 {
+		t.Errorf("want (0, 0), got (%f, %f)", ra, dec)
+	}
+
+	// the summer solstice of 2024 is at 2024-06-20 20:51 UTC.
+	ra, dec = SunEquatorial(JulianYearOf(time.Date(2024, time.June, 20, 20, 51, 0, 0, time.UTC)))
+	if math.Abs(ra-90) > 0.01 || math.Abs(dec-Obliquity(JulianYearOf(time.Date(2024, time.June, 20, 20, 51, 0, 0, time.UTC)))) > 0.01 {
+		t.Errorf("want (90, obliquity), got (%f, %f)", ra, dec)
+	}
+}
+
+func TestSiderealTime(t *testing.T) {
+	tests := []struct {
+		t         time.Time
+		longitude float64
+		want      float64
+	}{
+		{time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC), 0, 280.46061837},
+		{time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC), 139.7414, 60.20201837},
+	}
+	for _, tt := range tests {
+		if got := SiderealTime(tt.t, tt.longitude); math.Abs(got-tt.want) > 1e-6 {
+			t.Errorf("SiderealTime(%s, %f): want %f, got %f", tt.t, tt.longitude, tt.want, got)
+		}
+	}
+}
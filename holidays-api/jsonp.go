@@ -0,0 +1,85 @@
+package holidaysapi
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// maxCallbackLength is the max length of JSONP callback names.
+const maxCallbackLength = 64
+
+// validCallback reports whether name is safe as a JSONP callback,
+// i.e. JavaScript identifiers joined with dots, such as "jQuery123.cb".
+// Anything else is rejected to prevent script injection.
+func validCallback(name string) bool {
+	if name == "" || len(name) > maxCallbackLength {
+		return false
+	}
+	for _, ident := range strings.Split(name, ".") {
+		if ident == "" {
+			return false
+		}
+		for i := 0; i < len(ident); i++ {
+			c := ident[i]
+			switch {
+			case c == '_' || c == '$':
+			case 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z':
+			case '0' <= c && c <= '9' && i > 0:
+			default:
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// jsonpWriter buffers a JSON response to wrap it with the callback.
+// The header is shared with the underlying ResponseWriter.
+type jsonpWriter struct {
+	http.ResponseWriter
+	status int
+	buf    bytes.Buffer
+}
+
+func (w *jsonpWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *jsonpWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.buf.Write(b)
+}
+
+// flush writes the buffered response to the underlying ResponseWriter.
+// JSON responses are wrapped as callback(...), and the others are written as is.
+func (w *jsonpWriter) flush(callback string) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+
+	header := w.Header()
+	body := w.buf.Bytes()
+	if header.Get("Content-Type") == "application/json" {
+		var buf bytes.Buffer
+		// the leading comment prevents the response from being interpreted as other content types,
+		// e.g. Rosetta Flash (CVE-2014-4671).
+		buf.WriteString("/**/")
+		buf.WriteString(callback)
+		buf.WriteByte('(')
+		buf.Write(body)
+		buf.WriteString(");")
+		body = buf.Bytes()
+		header.Set("Content-Type", "application/javascript; charset=utf-8")
+		header.Set("X-Content-Type-Options", "nosniff")
+		header.Set("Content-Length", strconv.Itoa(len(body)))
+	}
+
+	w.ResponseWriter.WriteHeader(w.status)
+	w.ResponseWriter.Write(body)
+}
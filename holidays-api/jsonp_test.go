@@ -0,0 +1,99 @@
+package holidaysapi
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestValidCallback(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"cb", true},
+		{"_cb", true},
+		{"$", true},
+		{"jQuery3600123_456", true},
+		{"ns.holidays.cb", true},
+		{"", false},
+		{"1cb", false},
+		{"cb.", false},
+		{".cb", false},
+		{"ns..cb", false},
+		{"cb()", false},
+		{"alert(1);cb", false},
+		{"cb[0]", false},
+		{"コールバック", false},
+		{strings.Repeat("a", maxCallbackLength+1), false},
+	}
+	for _, tt := range tests {
+		if got := validCallback(tt.name); got != tt.want {
+			t.Errorf("validCallback(%q): want %t, got %t", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestServeHTTP_JSONP(t *testing.T) {
+	h := NewHandler()
+	t.Run("valid", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/2021/01/01?callback=ns.cb", nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		if got := resp.Header.Get("Content-Type"); got != "application/javascript; charset=utf-8" {
+			t.Errorf("unexpected Content-Type: %q", got)
+		}
+		if got := resp.Header.Get("X-Content-Type-Options"); got != "nosniff" {
+			t.Errorf("unexpected X-Content-Type-Options: %q", got)
+		}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := `/**/ns.cb({"holidays":[{"date":"2021-01-01","name":"元日"}]});`
+		if string(body) != want {
+			t.Errorf("want %s, got %s", want, body)
+		}
+		if got := resp.Header.Get("Content-Length"); got != strconv.Itoa(len(body)) {
+			t.Errorf("unexpected Content-Length: want %d, got %s", len(body), got)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/2021/01/01?callback="+url.QueryEscape("alert(1);cb"), nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusBadRequest, resp.StatusCode)
+		}
+		if got := resp.Header.Get("Content-Type"); got != "application/json" {
+			t.Errorf("unexpected Content-Type: %q", got)
+		}
+	})
+
+	t.Run("not json", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/calendar/2021?format=markdown&callback=cb", nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(string(body), "# 2021年") {
+			t.Errorf("markdown must not be wrapped, got %.20q", body)
+		}
+	})
+}
@@ -0,0 +1,97 @@
+// Package local provides local observances of prefectures, such as 都民の日 and 沖縄慰霊の日.
+//
+// They are not legal holidays, but public schools and many local businesses close on them.
+// The observances are selected by the prefecture code defined in JIS X 0401, e.g. 13 for Tokyo.
+//
+// The data is a partial seed, not a complete list: it has the observances of only a few prefectures,
+// and no observances of municipalities. Use Prefectures to check whether a prefecture is covered;
+// an empty result for a prefecture that is not covered doesn't mean that it has no observances.
+package local
+
+import (
+	"slices"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// Observance is an annual local observance of a prefecture.
+type Observance struct {
+	// Prefecture is the prefecture code defined in JIS X 0401.
+	Prefecture int
+
+	Month time.Month
+	Day   int
+	Name  string
+
+	// Since is the first year of the observance.
+	Since int
+}
+
+var observances = []Observance{
+	{Prefecture: 8, Month: time.November, Day: 13, Name: "茨城県民の日", Since: 1968},
+	{Prefecture: 9, Month: time.June, Day: 15, Name: "栃木県民の日", Since: 1988},
+	{Prefecture: 10, Month: time.October, Day: 28, Name: "群馬県民の日", Since: 1985},
+	{Prefecture: 11, Month: time.November, Day: 14, Name: "埼玉県民の日", Since: 1971},
+	{Prefecture: 12, Month: time.June, Day: 15, Name: "千葉県民の日", Since: 1984},
+	{Prefecture: 13, Month: time.October, Day: 1, Name: "都民の日", Since: 1952},
+	{Prefecture: 47, Month: time.June, Day: 23, Name: "慰霊の日", Since: 1974},
+}
+
+// Prefectures returns the codes of the prefectures that have observances in the data, in ascending order.
+func Prefectures() []int {
+	var result []int
+	for _, o := range observances {
+		if !slices.Contains(result, o.Prefecture) {
+			result = append(result, o.Prefecture)
+		}
+	}
+	slices.Sort(result)
+	return result
+}
+
+// Observances returns the observances of the prefecture.
+// It returns nil if the prefecture is not covered by the data.
+func Observances(prefecture int) []Observance {
+	var result []Observance
+	for _, o := range observances {
+		if o.Prefecture == prefecture {
+			result = append(result, o)
+		}
+	}
+	return result
+}
+
+// FindObservancesInRange returns the observances of the prefecture between from and to (inclusive).
+func FindObservancesInRange(prefecture int, from, to holiday.Date) []holiday.Holiday {
+	if from.Compare(to) > 0 {
+		from, to = to, from
+	}
+
+	var result []holiday.Holiday
+	list := Observances(prefecture)
+	for year := from.Year; year <= to.Year; year++ {
+		for _, o := range list {
+			if year < o.Since {
+				continue
+			}
+			d := holiday.Date{Year: year, Month: o.Month, Day: o.Day}
+			if d.Compare(from) < 0 || d.Compare(to) > 0 {
+				continue
+			}
+			result = append(result, holiday.Holiday{
+				Date: d.String(),
+				Name: o.Name,
+				Kind: holiday.KindLocal,
+			})
+		}
+	}
+	holiday.SortHolidays(result)
+	return result
+}
+
+// FindHolidaysInRange returns the national holidays and the observances of the prefecture between from and to (inclusive).
+// National holidays take precedence over the observances on the same day.
+func FindHolidaysInRange(prefecture int, from, to holiday.Date) []holiday.Holiday {
+	return holiday.Merge(holiday.FindHolidaysInRange(from, to), FindObservancesInRange(prefecture, from, to))
+}
@@ -0,0 +1,83 @@
+package local
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestFindObservancesInRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		prefecture int
+		from, to   holiday.Date
+		want       []holiday.Holiday
+	}{
+		{
+			name:       "tokyo",
+			prefecture: 13,
+			from:       holiday.Date{Year: 2021, Month: time.January, Day: 1},
+			to:         holiday.Date{Year: 2022, Month: time.December, Day: 31},
+			want: []holiday.Holiday{
+				{Date: "2021-10-01", Name: "都民の日", Kind: holiday.KindLocal},
+				{Date: "2022-10-01", Name: "都民の日", Kind: holiday.KindLocal},
+			},
+		},
+		{
+			name:       "before established",
+			prefecture: 12,
+			from:       holiday.Date{Year: 1983, Month: time.January, Day: 1},
+			to:         holiday.Date{Year: 1984, Month: time.December, Day: 31},
+			want: []holiday.Holiday{
+				{Date: "1984-06-15", Name: "千葉県民の日", Kind: holiday.KindLocal},
+			},
+		},
+		{
+			name:       "reversed range",
+			prefecture: 47,
+			from:       holiday.Date{Year: 2021, Month: time.June, Day: 30},
+			to:         holiday.Date{Year: 2021, Month: time.June, Day: 1},
+			want: []holiday.Holiday{
+				{Date: "2021-06-23", Name: "慰霊の日", Kind: holiday.KindLocal},
+			},
+		},
+		{
+			name:       "no observances",
+			prefecture: 14,
+			from:       holiday.Date{Year: 2021, Month: time.January, Day: 1},
+			to:         holiday.Date{Year: 2021, Month: time.December, Day: 31},
+			want:       nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FindObservancesInRange(tt.prefecture, tt.from, tt.to)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("FindObservancesInRange() mismatch (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindHolidaysInRange(t *testing.T) {
+	from := holiday.Date{Year: 2021, Month: time.October, Day: 1}
+	to := holiday.Date{Year: 2021, Month: time.November, Day: 3}
+	got := FindHolidaysInRange(13, from, to)
+	want := []holiday.Holiday{
+		{Date: "2021-10-01", Name: "都民の日", Kind: holiday.KindLocal},
+		{Date: "2021-11-03", Name: "文化の日"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("FindHolidaysInRange() mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestPrefectures(t *testing.T) {
+	want := []int{8, 9, 10, 11, 12, 13, 47}
+	if diff := cmp.Diff(want, Prefectures()); diff != "" {
+		t.Errorf("Prefectures() mismatch (-want/+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,292 @@
+// Package msgpack encodes and decodes holidays in MessagePack.
+//
+// Each holiday is a map with the keys "date", "name", and "kind".
+// A stream is a sequence of the maps, so that the holidays can be written and read one by one.
+// Marshal and Unmarshal encode and decode a whole list of holidays as an array of the maps instead.
+package msgpack
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// Encoder writes holidays to an output stream.
+type Encoder struct {
+	w   io.Writer
+	buf []byte
+}
+
+// NewEncoder returns a new encoder that writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Encode writes the MessagePack encoding of h to the stream.
+func (e *Encoder) Encode(h holiday.Holiday) error {
+	e.buf = appendHoliday(e.buf[:0], h)
+	_, err := e.w.Write(e.buf)
+	return err
+}
+
+// Marshal returns the MessagePack encoding of the holidays as an array.
+func Marshal(holidays []holiday.Holiday) []byte {
+	b := appendArrayHeader(nil, len(holidays))
+	for _, h := range holidays {
+		b = appendHoliday(b, h)
+	}
+	return b
+}
+
+func appendHoliday(b []byte, h holiday.Holiday) []byte {
+	b = append(b, 0x83) // fixmap with 3 elements
+	b = appendString(b, "date")
+	b = appendString(b, h.Date)
+	b = appendString(b, "name")
+	b = appendString(b, h.Name)
+	b = appendString(b, "kind")
+	b = appendUint(b, uint64(h.Kind))
+	return b
+}
+
+func appendArrayHeader(b []byte, n int) []byte {
+	switch {
+	case n < 16:
+		return append(b, 0x90|byte(n))
+	case n < 1<<16:
+		return binary.BigEndian.AppendUint16(append(b, 0xdc), uint16(n))
+	default:
+		return binary.BigEndian.AppendUint32(append(b, 0xdd), uint32(n))
+	}
+}
+
+func appendString(b []byte, s string) []byte {
+	n := len(s)
+	switch {
+	case n < 32:
+		b = append(b, 0xa0|byte(n))
+	case n < 1<<8:
+		b = append(b, 0xd9, byte(n))
+	case n < 1<<16:
+		b = binary.BigEndian.AppendUint16(append(b, 0xda), uint16(n))
+	default:
+		b = binary.BigEndian.AppendUint32(append(b, 0xdb), uint32(n))
+	}
+	return append(b, s...)
+}
+
+func appendUint(b []byte, v uint64) []byte {
+	switch {
+	case v < 128:
+		return append(b, byte(v))
+	case v < 1<<8:
+		return append(b, 0xcc, byte(v))
+	case v < 1<<16:
+		return binary.BigEndian.AppendUint16(append(b, 0xcd), uint16(v))
+	case v < 1<<32:
+		return binary.BigEndian.AppendUint32(append(b, 0xce), uint32(v))
+	default:
+		return binary.BigEndian.AppendUint64(append(b, 0xcf), v)
+	}
+}
+
+// Decoder reads holidays from an input stream.
+type Decoder struct {
+	r *bufio.Reader
+}
+
+// NewDecoder returns a new decoder that reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: bufio.NewReader(r)}
+}
+
+// Decode reads the next holiday from the stream.
+// It returns io.EOF at the end of the stream.
+// Unknown keys are ignored if their values are nil, booleans, integers, or strings.
+func (d *Decoder) Decode(h *holiday.Holiday) error {
+	c, err := d.r.ReadByte()
+	if err != nil {
+		return err
+	}
+	n, err := d.mapLength(c)
+	if err != nil {
+		return err
+	}
+
+	*h = holiday.Holiday{}
+	for i := 0; i < n; i++ {
+		key, err := d.readString()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		v, err := d.readValue()
+		if err != nil {
+			return unexpectedEOF(err)
+		}
+		switch key {
+		case "date":
+			s, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("msgpack: date must be a string, got %T", v)
+			}
+			h.Date = s
+		case "name":
+			s, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("msgpack: name must be a string, got %T", v)
+			}
+			h.Name = s
+		case "kind":
+			k, ok := v.(uint64)
+			if !ok {
+				return fmt.Errorf("msgpack: kind must be an unsigned integer, got %T", v)
+			}
+			h.Kind = holiday.Kind(k)
+		}
+	}
+	return nil
+}
+
+// Unmarshal decodes the array of holidays encoded by Marshal.
+func Unmarshal(data []byte) ([]holiday.Holiday, error) {
+	d := NewDecoder(bytes.NewReader(data))
+	c, err := d.r.ReadByte()
+	if err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	n, err := d.arrayLength(c)
+	if err != nil {
+		return nil, err
+	}
+	// each holiday takes at least one byte.
+	if n > len(data) {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	holidays := make([]holiday.Holiday, n)
+	for i := range holidays {
+		if err := d.Decode(&holidays[i]); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+	}
+	if _, err := d.r.ReadByte(); err != io.EOF {
+		return nil, errors.New("msgpack: unexpected data after the array")
+	}
+	return holidays, nil
+}
+
+func (d *Decoder) arrayLength(c byte) (int, error) {
+	switch {
+	case c&0xf0 == 0x90:
+		return int(c & 0x0f), nil
+	case c == 0xdc:
+		v, err := d.readUint(2)
+		return int(v), unexpectedEOF(err)
+	case c == 0xdd:
+		v, err := d.readUint(4)
+		return int(v), unexpectedEOF(err)
+	}
+	return 0, fmt.Errorf("msgpack: want an array, got 0x%02x", c)
+}
+
+func (d *Decoder) mapLength(c byte) (int, error) {
+	switch {
+	case c&0xf0 == 0x80:
+		return int(c & 0x0f), nil
+	case c == 0xde:
+		v, err := d.readUint(2)
+		return int(v), unexpectedEOF(err)
+	case c == 0xdf:
+		v, err := d.readUint(4)
+		return int(v), unexpectedEOF(err)
+	}
+	return 0, fmt.Errorf("msgpack: want a map, got 0x%02x", c)
+}
+
+func (d *Decoder) readString() (string, error) {
+	v, err := d.readValue()
+	if err != nil {
+		return "", err
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("msgpack: want a string, got %T", v)
+	}
+	return s, nil
+}
+
+// readValue reads a scalar value.
+func (d *Decoder) readValue() (any, error) {
+	c, err := d.r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case c < 0x80: // positive fixint
+		return uint64(c), nil
+	case c >= 0xe0: // negative fixint
+		return int64(int8(c)), nil
+	case c&0xe0 == 0xa0: // fixstr
+		return d.readBytes(int(c & 0x1f))
+	}
+
+	switch c {
+	case 0xc0:
+		return nil, nil
+	case 0xc2:
+		return false, nil
+	case 0xc3:
+		return true, nil
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		return d.readUint(1 << (c - 0xcc))
+	case 0xd0, 0xd1, 0xd2, 0xd3:
+		size := 1 << (c - 0xd0)
+		v, err := d.readUint(size)
+		if err != nil {
+			return nil, err
+		}
+		shift := 64 - 8*size
+		return int64(v<<shift) >> shift, nil
+	case 0xd9, 0xda, 0xdb:
+		n, err := d.readUint(1 << (c - 0xd9))
+		if err != nil {
+			return nil, err
+		}
+		return d.readBytes(int(n))
+	}
+	return nil, fmt.Errorf("msgpack: unsupported type 0x%02x", c)
+}
+
+func (d *Decoder) readUint(size int) (uint64, error) {
+	var buf [8]byte
+	if _, err := io.ReadFull(d.r, buf[8-size:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(buf[:]), nil
+}
+
+// maxStringLength limits the length of strings to avoid huge allocations by broken input.
+const maxStringLength = 1 << 20
+
+func (d *Decoder) readBytes(n int) (string, error) {
+	if n > maxStringLength {
+		return "", fmt.Errorf("msgpack: string too long: %d bytes", n)
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(d.r, buf); err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
+
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,133 @@
+package msgpack
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestEncoder(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf).Encode(holiday.Holiday{Date: "2000-05-04", Name: "休日", Kind: holiday.KindCitizens}); err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{
+		0x83,
+		0xa4, 'd', 'a', 't', 'e',
+		0xaa, '2', '0', '0', '0', '-', '0', '5', '-', '0', '4',
+		0xa4, 'n', 'a', 'm', 'e',
+		0xa6, 0xe4, 0xbc, 0x91, 0xe6, 0x97, 0xa5,
+		0xa4, 'k', 'i', 'n', 'd',
+		0x02,
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("want %x, got %x", want, buf.Bytes())
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	want := holiday.FindHolidaysInYear(2000)
+
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+	for _, h := range want {
+		if err := enc.Encode(h); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var got []holiday.Holiday
+	dec := NewDecoder(&buf)
+	for {
+		var h holiday.Holiday
+		err := dec.Decode(&h)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, h)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("round trip mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	holidays := holiday.FindHolidaysInYear(2019)
+	b := Marshal(holidays)
+	// 2019 has 22 holidays, so the header is array 16.
+	if !bytes.HasPrefix(b, []byte{0xdc, 0x00, 22}) {
+		t.Errorf("unexpected header: %x", b[:3])
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	want := holiday.FindHolidaysInYear(2025)
+	got, err := Unmarshal(Marshal(want))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("round trip mismatch (-want/+got):\n%s", diff)
+	}
+
+	// empty array
+	got, err = Unmarshal(Marshal(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Errorf("want no holidays, got %v", got)
+	}
+}
+
+func TestUnmarshal_Invalid(t *testing.T) {
+	b := Marshal(holiday.FindHolidaysInYear(2025))
+	if _, err := Unmarshal(b[:len(b)-1]); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("want io.ErrUnexpectedEOF, got %v", err)
+	}
+	if _, err := Unmarshal(append(b, 0xc0)); err == nil {
+		t.Error("want an error for the trailing data, got nil")
+	}
+
+	// a single holiday is not an array.
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf).Encode(holiday.Holiday{Date: "2025-01-01", Name: "元日"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Unmarshal(buf.Bytes()); err == nil {
+		t.Error("want an error for a map, got nil")
+	}
+}
+
+func TestDecoder_UnknownKeys(t *testing.T) {
+	b := []byte{
+		0x82,
+		0xa4, 'n', 'a', 'm', 'e',
+		0xa6, 0xe5, 0x85, 0x83, 0xe6, 0x97, 0xa5, // 元日
+		0xa3, 'f', 'o', 'o',
+		0xd1, 0xff, 0x00, // int 16
+	}
+	var h holiday.Holiday
+	if err := NewDecoder(bytes.NewReader(b)).Decode(&h); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(holiday.Holiday{Name: "元日"}, h); diff != "" {
+		t.Errorf("mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestDecoder_Truncated(t *testing.T) {
+	b := []byte{0x83, 0xa4, 'd', 'a'}
+	var h holiday.Holiday
+	err := NewDecoder(bytes.NewReader(b)).Decode(&h)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("want io.ErrUnexpectedEOF, got %v", err)
+	}
+}
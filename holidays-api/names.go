@@ -0,0 +1,64 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// NamesResponse is the response of the names endpoint.
+type NamesResponse struct {
+	Lang string `json:"lang"`
+
+	// Names maps the Japanese names of the statutory holidays to the localized names.
+	Names map[string]LocalizedName `json:"names"`
+}
+
+// LocalizedName is the name of a holiday in a language.
+type LocalizedName struct {
+	Name   string `json:"name"`
+	Romaji string `json:"romaji"`
+}
+
+func (h *Handler) names(w http.ResponseWriter, u *url.URL) *apiError {
+	lang := "en"
+	if q := u.Query(); q.Has("lang") {
+		lang = q.Get("lang")
+	}
+	if lang != "en" && lang != "ja" {
+		return invalidParameter("lang %q must be en or ja", lang)
+	}
+
+	res := NamesResponse{
+		Lang:  lang,
+		Names: map[string]LocalizedName{},
+	}
+	for _, name := range holiday.StatutoryNames() {
+		romaji, _ := holiday.Romanize(name)
+		localized := name
+		if lang == "en" {
+			localized, _ = holiday.EnglishName(name)
+		}
+		res.Names[name] = LocalizedName{
+			Name:   localized,
+			Romaji: romaji,
+		}
+	}
+	data, err := json.Marshal(res)
+	if err != nil {
+		h.responseInternalServerError(w, err)
+		return nil
+	}
+
+	// the names change only when the law is amended.
+	setCommonHeaders(w, 24*time.Hour)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+	return nil
+}
@@ -0,0 +1,59 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestServeHTTP_Names(t *testing.T) {
+	tests := []struct {
+		url  string
+		lang string
+		want LocalizedName
+	}{
+		{"http://example.com/names", "en", LocalizedName{Name: "National Foundation Day", Romaji: "Kenkoku Kinen no Hi"}},
+		{"http://example.com/names?lang=en", "en", LocalizedName{Name: "National Foundation Day", Romaji: "Kenkoku Kinen no Hi"}},
+		{"http://example.com/names?lang=ja", "ja", LocalizedName{Name: "建国記念の日", Romaji: "Kenkoku Kinen no Hi"}},
+	}
+	for _, tt := range tests {
+		h := NewHandler()
+		req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		resp := w.Result()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("%s: unexpected status code: want %d, got %d", tt.url, http.StatusOK, resp.StatusCode)
+		}
+		var got NamesResponse
+		if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+			t.Fatal(err)
+		}
+		if got.Lang != tt.lang {
+			t.Errorf("%s: want lang %q, got %q", tt.url, tt.lang, got.Lang)
+		}
+		if diff := cmp.Diff(tt.want, got.Names["建国記念の日"]); diff != "" {
+			t.Errorf("%s: name mismatch (-want/+got):\n%s", tt.url, diff)
+		}
+		for name, n := range got.Names {
+			if n.Name == "" || n.Romaji == "" {
+				t.Errorf("%s: %s is not localized: %v", tt.url, name, n)
+			}
+		}
+	}
+}
+
+func TestServeHTTP_NamesInvalidLang(t *testing.T) {
+	h := NewHandler()
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/names?lang=fr", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("unexpected status code: want %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
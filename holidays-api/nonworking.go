@@ -0,0 +1,129 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/closure"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// The reasons of NonWorkingDay.
+const (
+	// ReasonHoliday means that the day is a holiday, including substitute holidays.
+	ReasonHoliday = "holiday"
+
+	// ReasonClosure means that the day is a customary closure, such as お盆 and 年末年始.
+	ReasonClosure = "closure"
+
+	// ReasonWeekend means that the day is Saturday or Sunday.
+	ReasonWeekend = "weekend"
+)
+
+// NonWorkingDaysResponse is the response of the non-working-days endpoint.
+type NonWorkingDaysResponse struct {
+	Days []NonWorkingDay `json:"days"`
+}
+
+// NonWorkingDay is a rest day.
+type NonWorkingDay struct {
+	Date    string `json:"date"`
+	Weekday string `json:"weekday"`
+
+	// Reason is one of ReasonHoliday, ReasonClosure and ReasonWeekend.
+	// Holidays on weekends have ReasonHoliday.
+	Reason string `json:"reason"`
+
+	// Name and Kind are the name and the kind of the holiday or the closure.
+	Name string `json:"name,omitempty"`
+	Kind string `json:"kind,omitempty"`
+}
+
+// nonWorkingDays serves every rest day in the month.
+// The customary closures are included if the closures parameter is true.
+func (h *Handler) nonWorkingDays(w http.ResponseWriter, path string, u *url.URL) *apiError {
+	y, m, ok := strings.Cut(path, "/")
+	if !ok {
+		h.responseNotFound(w)
+		return nil
+	}
+	year, err := parseInt(y, 4)
+	if err != nil || year == 0 {
+		h.responseNotFound(w)
+		return nil
+	}
+	month, err := parseInt(m, 2)
+	if err != nil {
+		h.responseNotFound(w)
+		return nil
+	}
+	if month < 1 || month > 12 {
+		return invalidDate("month", path)
+	}
+
+	q := u.Query()
+	cal := holiday.NewCalendar(holiday.Japan)
+	if q.Has("closures") {
+		closures, err := strconv.ParseBool(q.Get("closures"))
+		if err != nil {
+			return invalidParameter("closures %q is not a boolean", q.Get("closures"))
+		}
+		if closures {
+			cal = holiday.NewCalendar(holiday.Japan, holiday.ProviderFunc(closure.FindClosuresInRange))
+		}
+	}
+
+	first := holiday.Date{Year: year, Month: time.Month(month), Day: 1}
+	days := time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	last := holiday.Date{Year: year, Month: time.Month(month), Day: days}
+	holidays := map[string]holiday.Holiday{}
+	for _, hd := range cal.HolidaysInRange(first, last) {
+		holidays[hd.Date] = hd
+	}
+
+	res := NonWorkingDaysResponse{
+		Days: []NonWorkingDay{},
+	}
+	for day := 1; day <= days; day++ {
+		t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, holiday.JST)
+		d := NonWorkingDay{
+			Date:    holiday.Date{Year: year, Month: time.Month(month), Day: day}.String(),
+			Weekday: strings.ToLower(t.Weekday().String()),
+		}
+		if hd, ok := holidays[d.Date]; ok {
+			d.Reason = ReasonHoliday
+			if hd.Kind == holiday.KindCustomary {
+				d.Reason = ReasonClosure
+			}
+			d.Name = hd.Name
+			d.Kind = hd.Kind.String()
+		} else if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+			d.Reason = ReasonWeekend
+		} else {
+			continue
+		}
+		res.Days = append(res.Days, d)
+	}
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		h.responseInternalServerError(w, err)
+		return nil
+	}
+
+	now := h.now()
+	maxAge := 24 * time.Hour
+	if year < now.Year() || (year == now.Year() && time.Month(month) < now.Month()) {
+		maxAge = 365 * 24 * time.Hour
+	}
+	setCommonHeaders(w, maxAge)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+	return nil
+}
@@ -0,0 +1,81 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestServeHTTP_NonWorkingDays(t *testing.T) {
+	h := NewHandler()
+	weekends := []NonWorkingDay{
+		{Date: "2025-01-04", Weekday: "saturday", Reason: ReasonWeekend},
+		{Date: "2025-01-05", Weekday: "sunday", Reason: ReasonWeekend},
+		{Date: "2025-01-11", Weekday: "saturday", Reason: ReasonWeekend},
+		{Date: "2025-01-12", Weekday: "sunday", Reason: ReasonWeekend},
+		{Date: "2025-01-13", Weekday: "monday", Reason: ReasonHoliday, Name: "成人の日", Kind: "national"},
+		{Date: "2025-01-18", Weekday: "saturday", Reason: ReasonWeekend},
+		{Date: "2025-01-19", Weekday: "sunday", Reason: ReasonWeekend},
+		{Date: "2025-01-25", Weekday: "saturday", Reason: ReasonWeekend},
+		{Date: "2025-01-26", Weekday: "sunday", Reason: ReasonWeekend},
+	}
+	tests := []struct {
+		path   string
+		status int
+		want   []NonWorkingDay
+	}{
+		{
+			path:   "/non-working-days/2025/01",
+			status: http.StatusOK,
+			want: append([]NonWorkingDay{
+				{Date: "2025-01-01", Weekday: "wednesday", Reason: ReasonHoliday, Name: "元日", Kind: "national"},
+			}, weekends...),
+		},
+		{
+			path:   "/non-working-days/2025/01?closures=true",
+			status: http.StatusOK,
+			want: append([]NonWorkingDay{
+				{Date: "2025-01-01", Weekday: "wednesday", Reason: ReasonHoliday, Name: "元日", Kind: "national"},
+				{Date: "2025-01-02", Weekday: "thursday", Reason: ReasonClosure, Name: "年末年始休み", Kind: "customary"},
+				{Date: "2025-01-03", Weekday: "friday", Reason: ReasonClosure, Name: "年末年始休み", Kind: "customary"},
+			}, weekends...),
+		},
+		{
+			path:   "/non-working-days/2025/13",
+			status: http.StatusBadRequest,
+		},
+		{
+			path:   "/non-working-days/2025/01?closures=maybe",
+			status: http.StatusBadRequest,
+		},
+		{
+			path:   "/non-working-days/2025",
+			status: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "http://example.com"+tt.path, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.status {
+				t.Fatalf("unexpected status code: want %d, got %d", tt.status, resp.StatusCode)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			var got NonWorkingDaysResponse
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got.Days); diff != "" {
+				t.Errorf("days mismatch (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
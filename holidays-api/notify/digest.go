@@ -0,0 +1,170 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// Period is the period that a digest covers.
+type Period int
+
+const (
+	// Weekly digests are sent on Mondays, and cover the week from Monday to Sunday.
+	Weekly Period = iota
+
+	// Monthly digests are sent on the first day of months, and cover the month.
+	Monthly
+)
+
+// Digest sends a digest of the holidays and the long weekends in the coming week or month,
+// e.g. by email for small offices without chat tools.
+type Digest struct {
+	Sender Sender
+
+	Period Period
+
+	// Lang is the language of the message. "ja" and "en" are supported.
+	// The default is "ja".
+	Lang string
+
+	// At is the time of day in JST when the digest is sent.
+	At time.Duration
+
+	// SkipEmpty skips the digests that have no holidays.
+	SkipEmpty bool
+}
+
+// Run sends digests at d.At on the first day of every period until ctx is canceled.
+func (d *Digest) Run(ctx context.Context) error {
+	return runDaily(ctx, d.At, func(now time.Time) {
+		if err := d.Notify(ctx, now); err != nil {
+			log.Printf("notify: failed to send a digest: %v", err)
+		}
+	})
+}
+
+// Notify sends the digest of the period if the day of now is the first day of the period.
+func (d *Digest) Notify(ctx context.Context, now time.Time) error {
+	now = now.In(holiday.JST)
+	var from, to time.Time
+	switch d.Period {
+	case Weekly:
+		if now.Weekday() != time.Monday {
+			return nil
+		}
+		from = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, holiday.JST)
+		to = from.AddDate(0, 0, 6)
+	case Monthly:
+		if now.Day() != 1 {
+			return nil
+		}
+		from = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, holiday.JST)
+		to = from.AddDate(0, 1, -1)
+	default:
+		return fmt.Errorf("notify: unknown period: %d", d.Period)
+	}
+
+	start := holiday.Date{Year: from.Year(), Month: from.Month(), Day: from.Day()}
+	end := holiday.Date{Year: to.Year(), Month: to.Month(), Day: to.Day()}
+	if d.SkipEmpty && len(holiday.FindHolidaysInRange(start, end)) == 0 {
+		return nil
+	}
+	return d.Sender.Send(ctx, DigestMessage(start, end, d.Period, d.Lang))
+}
+
+// DigestMessage returns a digest of the holidays and the long weekends between from and to (inclusive).
+// The first line is the title such as "2024年8月の祝日", and it is followed by the list of the holidays.
+// The long weekends that overlap the period are listed after them.
+func DigestMessage(from, to holiday.Date, period Period, lang string) string {
+	holidays := holiday.FindHolidaysInRange(from, to)
+	blocks := longWeekends(from, to)
+	en := lang == "en"
+
+	var buf strings.Builder
+	switch {
+	case period == Monthly && en:
+		fmt.Fprintf(&buf, "Holidays in %s %d\n", from.Month, from.Year)
+	case period == Monthly:
+		fmt.Fprintf(&buf, "%d年%d月の祝日\n", from.Year, from.Month)
+	case en:
+		fmt.Fprintf(&buf, "Holidays from %s to %s\n", formatDate(dateTime(from), lang), formatDate(dateTime(to), lang))
+	default:
+		fmt.Fprintf(&buf, "%s〜%sの祝日\n", formatDate(dateTime(from), lang), formatDate(dateTime(to), lang))
+	}
+
+	if len(holidays) == 0 {
+		if en {
+			buf.WriteString("\nNo holidays.\n")
+		} else {
+			buf.WriteString("\n祝日はありません。\n")
+		}
+		return buf.String()
+	}
+
+	buf.WriteString("\n")
+	for _, h := range holidays {
+		date, err := holiday.ParseDate(h.Date)
+		if err != nil {
+			continue
+		}
+		name := h.Name
+		if en {
+			if v, ok := holiday.EnglishName(h.Name); ok {
+				name = fmt.Sprintf("%s (%s)", h.Name, v)
+			}
+		}
+		fmt.Fprintf(&buf, "- %s %s\n", formatDate(dateTime(date), lang), name)
+	}
+
+	if len(blocks) > 0 {
+		if en {
+			buf.WriteString("\nLong weekends\n")
+		} else {
+			buf.WriteString("\n連休\n")
+		}
+		for _, b := range blocks {
+			if en {
+				fmt.Fprintf(&buf, "- %s to %s: %d days\n", formatDate(b.Start, lang), formatDate(b.End, lang), b.Days)
+			} else {
+				fmt.Fprintf(&buf, "- %s〜%s %d連休\n", formatDate(b.Start, lang), formatDate(b.End, lang), b.Days)
+			}
+		}
+	}
+	return buf.String()
+}
+
+// longWeekends returns the rest blocks of 3 days or more that overlap the days between from and to.
+func longWeekends(from, to holiday.Date) []holiday.RestBlock {
+	var result []holiday.RestBlock
+	end := dateTime(to)
+	for d := dateTime(from); !d.After(end); d = d.AddDate(0, 0, 1) {
+		block, _, ok := holiday.RestBlockOf(d)
+		if !ok || block.Days < 3 {
+			continue
+		}
+		if len(result) > 0 && result[len(result)-1].Start.Equal(block.Start) {
+			continue
+		}
+		result = append(result, block)
+	}
+	return result
+}
+
+func dateTime(d holiday.Date) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, holiday.JST)
+}
+
+var weekdaysJa = [...]string{"日", "月", "火", "水", "木", "金", "土"}
+
+// formatDate formats t such as "8月11日(日)" or "Sun, Aug 11".
+func formatDate(t time.Time, lang string) string {
+	if lang == "en" {
+		return t.Format("Mon, Jan 2")
+	}
+	return fmt.Sprintf("%d月%d日(%s)", t.Month(), t.Day(), weekdaysJa[t.Weekday()])
+}
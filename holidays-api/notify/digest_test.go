@@ -0,0 +1,84 @@
+package notify
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestDigestMessage(t *testing.T) {
+	tests := []struct {
+		from, to holiday.Date
+		period   Period
+		lang     string
+		want     string
+	}{
+		{
+			from:   holiday.Date{Year: 2024, Month: time.August, Day: 1},
+			to:     holiday.Date{Year: 2024, Month: time.August, Day: 31},
+			period: Monthly,
+			lang:   "ja",
+			want: "2024年8月の祝日\n\n" +
+				"- 8月11日(日) 山の日\n" +
+				"- 8月12日(月) 休日\n\n" +
+				"連休\n" +
+				"- 8月10日(土)〜8月12日(月) 3連休\n",
+		},
+		{
+			from:   holiday.Date{Year: 2024, Month: time.August, Day: 5},
+			to:     holiday.Date{Year: 2024, Month: time.August, Day: 11},
+			period: Weekly,
+			lang:   "en",
+			want: "Holidays from Mon, Aug 5 to Sun, Aug 11\n\n" +
+				"- Sun, Aug 11 山の日 (Mountain Day)\n\n" +
+				"Long weekends\n" +
+				"- Sat, Aug 10 to Mon, Aug 12: 3 days\n",
+		},
+		{
+			from:   holiday.Date{Year: 2024, Month: time.June, Day: 1},
+			to:     holiday.Date{Year: 2024, Month: time.June, Day: 30},
+			period: Monthly,
+			lang:   "ja",
+			want:   "2024年6月の祝日\n\n祝日はありません。\n",
+		},
+	}
+	for _, tt := range tests {
+		got := DigestMessage(tt.from, tt.to, tt.period, tt.lang)
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("DigestMessage(%s, %s) mismatch (-want/+got):\n%s", tt.from, tt.to, diff)
+		}
+	}
+}
+
+func TestDigest_Notify(t *testing.T) {
+	r := &recorder{}
+	d := &Digest{
+		Sender:    r,
+		Period:    Monthly,
+		SkipEmpty: true,
+	}
+
+	// 2024-08-01 09:00 JST
+	now := time.Date(2024, time.August, 1, 0, 0, 0, 0, time.UTC)
+	if err := d.Notify(context.Background(), now); err != nil {
+		t.Fatal(err)
+	}
+	// 2024-08-02 is not the first day of the month
+	if err := d.Notify(context.Background(), now.AddDate(0, 0, 1)); err != nil {
+		t.Fatal(err)
+	}
+	// 2024-06 has no holidays
+	if err := d.Notify(context.Background(), now.AddDate(0, -2, 0)); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(r.texts) != 1 {
+		t.Fatalf("want 1 digest, got %d", len(r.texts))
+	}
+	if got := r.texts[0]; got != DigestMessage(holiday.Date{Year: 2024, Month: time.August, Day: 1}, holiday.Date{Year: 2024, Month: time.August, Day: 31}, Monthly, "") {
+		t.Errorf("unexpected digest: %q", got)
+	}
+}
@@ -0,0 +1,77 @@
+// Package discord sends messages to Discord webhooks,
+// and answers the /holiday slash command of Discord bots.
+package discord
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/notify"
+)
+
+// Webhook is a Discord webhook.
+// https://discord.com/developers/docs/resources/webhook#execute-webhook
+type Webhook struct {
+	// URL is the webhook URL.
+	URL string
+
+	// Username overrides the default name of the webhook.
+	Username string
+
+	// AvatarURL overrides the default avatar of the webhook.
+	AvatarURL string
+
+	// Client is used for sending messages.
+	// If nil, http.DefaultClient is used.
+	Client *http.Client
+}
+
+var _ notify.Sender = (*Webhook)(nil)
+
+type message struct {
+	Content   string `json:"content"`
+	Username  string `json:"username,omitempty"`
+	AvatarURL string `json:"avatar_url,omitempty"`
+}
+
+// Send implements notify.Sender.
+func (w *Webhook) Send(ctx context.Context, text string) error {
+	body, err := json.Marshal(message{
+		Content:   text,
+		Username:  w.Username,
+		AvatarURL: w.AvatarURL,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	// Discord responds 204 No Content, or 200 OK with the message if the URL has wait=true.
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("discord: unexpected status code: %d: %s", resp.StatusCode, data)
+	}
+	return nil
+}
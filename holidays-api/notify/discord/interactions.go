@@ -0,0 +1,205 @@
+package discord
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+	"github.com/shogo82148/holidays-jp/holidays-api/notify"
+)
+
+// CommandName is the name of the slash command that Interactions answers.
+// Register it to the application with the option "query" of the string type, e.g.
+//
+//	curl -X POST -H "Authorization: Bot $TOKEN" -H "Content-Type: application/json" \
+//	  -d '{"name":"holiday","description":"Holidays in Japan","options":[{"type":3,"name":"query","description":"next, 2026-05 or 2026-05-03"}]}' \
+//	  https://discord.com/api/v10/applications/$APPLICATION_ID/commands
+const CommandName = "holiday"
+
+// maxInteractionSize is the max size of the interaction requests.
+const maxInteractionSize = 64 * 1024
+
+// the types of the interactions and the responses.
+// https://discord.com/developers/docs/interactions/receiving-and-responding
+const (
+	interactionTypePing               = 1
+	interactionTypeApplicationCommand = 2
+
+	responseTypePong                     = 1
+	responseTypeChannelMessageWithSource = 4
+)
+
+// Interactions is the interactions endpoint of a Discord application.
+// It answers the /holiday slash command:
+//
+//   - /holiday next: the next holiday
+//   - /holiday 2026-05: the holidays and the long weekends in the month
+//   - /holiday 2026-05-03: whether the day is a holiday
+//
+// Set its URL to "Interactions Endpoint URL" of the application in the Discord Developer Portal.
+type Interactions struct {
+	// PublicKey is the public key of the application to verify the requests.
+	PublicKey ed25519.PublicKey
+
+	// Lang is the language of the answers. "ja" and "en" are supported.
+	// If empty, "ja" is used for the users in the Japanese locale, and "en" for the others.
+	Lang string
+
+	// Clock provides the current time for the queries relative to now, such as "next".
+	// If nil, holiday.SystemClock is used.
+	Clock holiday.Clock
+}
+
+type interaction struct {
+	Type   int    `json:"type"`
+	Locale string `json:"locale"`
+	Data   struct {
+		Name    string `json:"name"`
+		Options []struct {
+			Name  string `json:"name"`
+			Value any    `json:"value"`
+		} `json:"options"`
+	} `json:"data"`
+}
+
+type interactionResponse struct {
+	Type int                      `json:"type"`
+	Data *interactionResponseData `json:"data,omitempty"`
+}
+
+type interactionResponseData struct {
+	Content string `json:"content"`
+}
+
+// ServeHTTP implements http.Handler.
+func (i *Interactions) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "only POST is allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxInteractionSize))
+	if err != nil {
+		http.Error(w, "failed to read the body", http.StatusBadRequest)
+		return
+	}
+	if !i.verify(r.Header.Get("X-Signature-Timestamp"), r.Header.Get("X-Signature-Ed25519"), body) {
+		// Discord checks that the endpoint rejects invalid signatures when the URL is set.
+		http.Error(w, "invalid request signature", http.StatusUnauthorized)
+		return
+	}
+
+	var req interaction
+	if err := json.Unmarshal(body, &req); err != nil {
+		http.Error(w, "invalid interaction", http.StatusBadRequest)
+		return
+	}
+
+	var res interactionResponse
+	switch req.Type {
+	case interactionTypePing:
+		res.Type = responseTypePong
+	case interactionTypeApplicationCommand:
+		lang := i.Lang
+		if lang == "" {
+			lang = "en"
+			if req.Locale == "ja" {
+				lang = "ja"
+			}
+		}
+		var query string
+		for _, opt := range req.Data.Options {
+			if opt.Name == "query" {
+				query, _ = opt.Value.(string)
+			}
+		}
+		content := fmt.Sprintf("unknown command: %s", req.Data.Name)
+		if req.Data.Name == CommandName {
+			content = Answer(query, i.now(), lang)
+		}
+		res.Type = responseTypeChannelMessageWithSource
+		res.Data = &interactionResponseData{Content: content}
+	default:
+		http.Error(w, "unsupported interaction type", http.StatusBadRequest)
+		return
+	}
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+func (i *Interactions) verify(timestamp, signature string, body []byte) bool {
+	if len(i.PublicKey) != ed25519.PublicKeySize || timestamp == "" {
+		return false
+	}
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	msg := make([]byte, 0, len(timestamp)+len(body))
+	msg = append(msg, timestamp...)
+	msg = append(msg, body...)
+	return ed25519.Verify(i.PublicKey, msg, sig)
+}
+
+func (i *Interactions) now() time.Time {
+	if i.Clock == nil {
+		return holiday.SystemClock.Now()
+	}
+	return i.Clock.Now()
+}
+
+// Answer returns the answer to the query of the /holiday command at now.
+// The query is "next" (or empty), a month such as "2026-05", or a day such as "2026-05-03".
+func Answer(query string, now time.Time, lang string) string {
+	en := lang == "en"
+	query = strings.TrimSpace(query)
+	if query == "" || query == "next" {
+		days, h := holiday.DaysUntilNextHoliday(now)
+		return notify.Message(h, days, lang)
+	}
+
+	if t, err := time.Parse("2006-1", query); err == nil {
+		from := holiday.Date{Year: t.Year(), Month: t.Month(), Day: 1}
+		end := t.AddDate(0, 1, -1)
+		to := holiday.Date{Year: end.Year(), Month: end.Month(), Day: end.Day()}
+		return notify.DigestMessage(from, to, notify.Monthly, lang)
+	}
+
+	if d, err := holiday.ParseDate(query); err == nil {
+		h, ok := holiday.FindHoliday(d.Year, d.Month, d.Day)
+		switch {
+		case ok && en:
+			name := h.Name
+			if v, ok := holiday.EnglishName(h.Name); ok {
+				name = fmt.Sprintf("%s (%s)", h.Name, v)
+			}
+			return fmt.Sprintf("%s is %s.", d, name)
+		case ok:
+			return fmt.Sprintf("%sは%sです", d, h.Name)
+		case en:
+			return fmt.Sprintf("%s is not a holiday.", d)
+		default:
+			return fmt.Sprintf("%sは祝日ではありません", d)
+		}
+	}
+
+	if en {
+		return fmt.Sprintf("Unknown query %q. Use next, a month such as 2026-05, or a day such as 2026-05-03.", query)
+	}
+	return fmt.Sprintf("%q は分かりません。next、2026-05 のような月、2026-05-03 のような日を指定してください。", query)
+}
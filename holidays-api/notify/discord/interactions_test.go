@@ -0,0 +1,104 @@
+package discord
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestAnswer(t *testing.T) {
+	// 2025-04-29 18:30 JST, 昭和の日
+	now := time.Date(2025, time.April, 29, 9, 30, 0, 0, time.UTC)
+	tests := []struct {
+		query string
+		lang  string
+		want  string
+	}{
+		{"next", "ja", "4日後は憲法記念日です"},
+		{"", "en", "憲法記念日 (Constitution Memorial Day) is in 4 days."},
+		{"2025-05-05", "ja", "2025-05-05はこどもの日です"},
+		{"2025-05-07", "en", "2025-05-07 is not a holiday."},
+		{"2025-06", "ja", "2025年6月の祝日\n\n祝日はありません。\n"},
+		{"tomorrow", "en", `Unknown query "tomorrow". Use next, a month such as 2026-05, or a day such as 2026-05-03.`},
+	}
+	for _, tt := range tests {
+		got := Answer(tt.query, now, tt.lang)
+		if got != tt.want {
+			t.Errorf("Answer(%q, %q): want %q, got %q", tt.query, tt.lang, tt.want, got)
+		}
+	}
+}
+
+func TestInteractions(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Interactions{
+		PublicKey: pub,
+		Clock: holiday.ClockFunc(func() time.Time {
+			return time.Date(2025, time.April, 29, 9, 30, 0, 0, time.UTC)
+		}),
+	}
+	serve := func(body string, key ed25519.PrivateKey) *http.Response {
+		req := httptest.NewRequest(http.MethodPost, "http://example.com/", strings.NewReader(body))
+		timestamp := "1700000000"
+		req.Header.Set("X-Signature-Timestamp", timestamp)
+		req.Header.Set("X-Signature-Ed25519", hex.EncodeToString(ed25519.Sign(key, []byte(timestamp+body))))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w.Result()
+	}
+
+	t.Run("invalid signature", func(t *testing.T) {
+		_, another, err := ed25519.GenerateKey(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp := serve(`{"type":1}`, another)
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusUnauthorized, resp.StatusCode)
+		}
+	})
+
+	t.Run("ping", func(t *testing.T) {
+		resp := serve(`{"type":1}`, priv)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		var got interactionResponse
+		if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(interactionResponse{Type: responseTypePong}, got); diff != "" {
+			t.Errorf("response mismatch (-want/+got):\n%s", diff)
+		}
+	})
+
+	t.Run("command", func(t *testing.T) {
+		body := `{"type":2,"locale":"ja","data":{"name":"holiday","options":[{"type":3,"name":"query","value":"next"}]}}`
+		resp := serve(body, priv)
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		var got interactionResponse
+		if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+			t.Fatal(err)
+		}
+		want := interactionResponse{
+			Type: responseTypeChannelMessageWithSource,
+			Data: &interactionResponseData{Content: "4日後は憲法記念日です"},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("response mismatch (-want/+got):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,127 @@
+// Package email sends messages by email over SMTP.
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/notify"
+)
+
+// SMTP sends messages as plain text emails in UTF-8.
+// The connection is upgraded with STARTTLS if the server supports it.
+type SMTP struct {
+	// Addr is the address of the SMTP server, e.g. "smtp.example.com:587".
+	Addr string
+
+	// Auth authenticates the client, e.g. smtp.PlainAuth.
+	// If nil, no authentication is made.
+	Auth smtp.Auth
+
+	// From is the address of the sender.
+	From string
+
+	// To is the addresses of the recipients.
+	To []string
+
+	// Subject is the subject of the emails.
+	// If empty, the first line of the message is used.
+	Subject string
+}
+
+var _ notify.Sender = (*SMTP)(nil)
+
+// Send implements notify.Sender.
+func (s *SMTP) Send(ctx context.Context, text string) error {
+	if len(s.To) == 0 {
+		return errors.New("email: no recipients")
+	}
+	msg := s.message(text, time.Now())
+
+	host, _, err := net.SplitHostPort(s.Addr)
+	if err != nil {
+		return err
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", s.Addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if s.Auth != nil {
+		if err := c.Auth(s.Auth); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(s.From); err != nil {
+		return err
+	}
+	for _, to := range s.To {
+		if err := c.Rcpt(to); err != nil {
+			return fmt.Errorf("email: failed to add the recipient %s: %w", to, err)
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// message returns the email of the text with the headers.
+// The body is encoded in base64, so that the lines in Japanese never exceed the limit of SMTP.
+func (s *SMTP) message(text string, now time.Time) []byte {
+	subject := s.Subject
+	if subject == "" {
+		subject, _, _ = strings.Cut(text, "\n")
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", s.From)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(s.To, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: base64\r\n")
+	buf.WriteString("\r\n")
+
+	body := base64.StdEncoding.EncodeToString([]byte(strings.ReplaceAll(text, "\n", "\r\n")))
+	for len(body) > 76 {
+		buf.WriteString(body[:76])
+		buf.WriteString("\r\n")
+		body = body[76:]
+	}
+	buf.WriteString(body)
+	buf.WriteString("\r\n")
+	return buf.Bytes()
+}
@@ -0,0 +1,142 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// smtpServer is a minimal SMTP server that accepts a single email.
+type smtpServer struct {
+	l          net.Listener
+	from       string
+	recipients []string
+	data       string
+	done       chan struct{}
+}
+
+func newSMTPServer(t *testing.T) *smtpServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &smtpServer{l: l, done: make(chan struct{})}
+	go s.serve(t)
+	t.Cleanup(func() { l.Close() })
+	return s
+}
+
+func (s *smtpServer) serve(t *testing.T) {
+	defer close(s.done)
+	conn, err := s.l.Accept()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) {
+		conn.Write([]byte(line + "\r\n"))
+	}
+	reply("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		cmd, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(cmd) {
+		case "EHLO", "HELO":
+			reply("250 localhost")
+		case "MAIL":
+			s.from = strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")
+			reply("250 OK")
+		case "RCPT":
+			s.recipients = append(s.recipients, strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>"))
+			reply("250 OK")
+		case "DATA":
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+			s.data = data.String()
+			reply("250 OK")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func TestSend(t *testing.T) {
+	server := newSMTPServer(t)
+	s := &SMTP{
+		Addr: server.l.Addr().String(),
+		From: "holidays@example.com",
+		To:   []string{"alice@example.com", "bob@example.com"},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.Send(ctx, "2024年8月の祝日\n\n- 8月11日(日) 山の日\n"); err != nil {
+		t.Fatal(err)
+	}
+	<-server.done
+
+	if server.from != "holidays@example.com" {
+		t.Errorf("want from holidays@example.com, got %s", server.from)
+	}
+	if diff := cmp.Diff(s.To, server.recipients); diff != "" {
+		t.Errorf("recipients mismatch (-want/+got):\n%s", diff)
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(server.data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if subject != "2024年8月の祝日" {
+		t.Errorf("want subject 2024年8月の祝日, got %s", subject)
+	}
+	body, err := io.ReadAll(base64.NewDecoder(base64.StdEncoding, msg.Body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "2024年8月の祝日\r\n\r\n- 8月11日(日) 山の日\r\n"
+	if string(body) != want {
+		t.Errorf("want body %q, got %q", want, body)
+	}
+}
+
+func TestSend_NoRecipients(t *testing.T) {
+	s := &SMTP{
+		Addr: "127.0.0.1:25",
+		From: "holidays@example.com",
+	}
+	if err := s.Send(context.Background(), "hello"); err == nil {
+		t.Error("want error, but got nil")
+	}
+}
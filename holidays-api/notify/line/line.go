@@ -0,0 +1,97 @@
+// Package line sends messages to LINE with the Messaging API.
+//
+// LINE Notify, which was the easiest way to post to LINE, ended on March 31, 2025.
+// The Messaging API is its successor; create a channel of a LINE Official Account
+// and issue a channel access token in the LINE Developers Console.
+package line
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/notify"
+)
+
+// DefaultBaseURL is the base URL of the Messaging API.
+const DefaultBaseURL = "https://api.line.me"
+
+// Bot is a LINE Official Account that sends messages with the Messaging API.
+// https://developers.line.biz/en/reference/messaging-api/
+type Bot struct {
+	// ChannelAccessToken is the channel access token of the Messaging API channel.
+	ChannelAccessToken string
+
+	// To is the ID of the user, the group or the room to send messages.
+	// If empty, the messages are broadcast to all the friends of the account.
+	To string
+
+	// BaseURL is the base URL of the API. If empty, DefaultBaseURL is used.
+	BaseURL string
+
+	// Client is used for sending messages.
+	// If nil, http.DefaultClient is used.
+	Client *http.Client
+}
+
+var _ notify.Sender = (*Bot)(nil)
+
+type textMessage struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type request struct {
+	To       string        `json:"to,omitempty"`
+	Messages []textMessage `json:"messages"`
+}
+
+// Send implements notify.Sender.
+func (b *Bot) Send(ctx context.Context, text string) error {
+	body, err := json.Marshal(request{
+		To: b.To,
+		Messages: []textMessage{
+			{Type: "text", Text: text},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	baseURL := b.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	path := "/v2/bot/message/push"
+	if b.To == "" {
+		path = "/v2/bot/message/broadcast"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+b.ChannelAccessToken)
+
+	client := b.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("line: unexpected status code: %d: %s", resp.StatusCode, data)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package line
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSend(t *testing.T) {
+	tests := []struct {
+		to   string
+		path string
+	}{
+		{"U0123456789abcdef", "/v2/bot/message/push"},
+		{"", "/v2/bot/message/broadcast"},
+	}
+	for _, tt := range tests {
+		var got request
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != tt.path {
+				t.Errorf("want path %s, got %s", tt.path, r.URL.Path)
+			}
+			if auth := r.Header.Get("Authorization"); auth != "Bearer token" {
+				t.Errorf("unexpected Authorization: %q", auth)
+			}
+			if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+				t.Error(err)
+			}
+			w.Write([]byte("{}"))
+		}))
+
+		b := &Bot{
+			ChannelAccessToken: "token",
+			To:                 tt.to,
+			BaseURL:            ts.URL,
+			Client:             ts.Client(),
+		}
+		if err := b.Send(context.Background(), "明日は山の日です"); err != nil {
+			t.Fatal(err)
+		}
+		ts.Close()
+
+		want := request{
+			To: tt.to,
+			Messages: []textMessage{
+				{Type: "text", Text: "明日は山の日です"},
+			},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("request mismatch (-want/+got):\n%s", diff)
+		}
+	}
+}
+
+func TestSend_Error(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"message":"Authentication failed due to the following reason: invalid token."}`))
+	}))
+	defer ts.Close()
+
+	b := &Bot{
+		ChannelAccessToken: "invalid",
+		BaseURL:            ts.URL,
+		Client:             ts.Client(),
+	}
+	if err := b.Send(context.Background(), "hello"); err == nil {
+		t.Error("want error, but got nil")
+	}
+}
@@ -0,0 +1,162 @@
+// Package notify sends reminders and digests of upcoming holidays to chat services and email.
+package notify
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// Sender sends a text message.
+type Sender interface {
+	Send(ctx context.Context, text string) error
+}
+
+// Notifier sends a reminder when a holiday is coming.
+type Notifier struct {
+	Sender Sender
+
+	// LeadDays is how many days before the holiday the reminder is sent.
+	LeadDays int
+
+	// Lang is the language of the message. "ja" and "en" are supported.
+	// The default is "ja".
+	Lang string
+
+	// At is the time of day in JST when the reminder is sent.
+	At time.Duration
+
+	// LongWeekends also reminds of the long weekends of 3 days or more, such as Golden Week,
+	// LeadDays before their first days.
+	LongWeekends bool
+}
+
+// Run sends reminders every day at n.At until ctx is canceled.
+func (n *Notifier) Run(ctx context.Context) error {
+	return runDaily(ctx, n.At, func(now time.Time) {
+		if err := n.Notify(ctx, now); err != nil {
+			log.Printf("notify: failed to send a reminder: %v", err)
+		}
+	})
+}
+
+// runDaily calls fn every day at the time of day in JST until ctx is canceled.
+func runDaily(ctx context.Context, at time.Duration, fn func(now time.Time)) error {
+	for {
+		now := time.Now().In(holiday.JST)
+		next := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, holiday.JST).Add(at)
+		if !next.After(now) {
+			next = time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, holiday.JST).Add(at)
+		}
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		fn(next)
+	}
+}
+
+// Notify sends a reminder if the day n.LeadDays after now is a holiday,
+// or the first day of a long weekend if n.LongWeekends is set.
+// If both apply, they are sent in one message.
+func (n *Notifier) Notify(ctx context.Context, now time.Time) error {
+	now = now.In(holiday.JST)
+	target := time.Date(now.Year(), now.Month(), now.Day()+n.LeadDays, 0, 0, 0, 0, holiday.JST)
+
+	var messages []string
+	if h, ok := holiday.FindHoliday(target.Year(), target.Month(), target.Day()); ok {
+		messages = append(messages, Message(h, n.LeadDays, n.Lang))
+	}
+	if n.LongWeekends {
+		if block, pos, ok := holiday.RestBlockOf(target); ok && pos == 1 && block.Days >= 3 {
+			messages = append(messages, LongWeekendMessage(block, n.LeadDays, n.Lang))
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return n.Sender.Send(ctx, strings.Join(messages, "\n"))
+}
+
+// Message returns a reminder text such as "明日は山の日です".
+func Message(h holiday.Holiday, leadDays int, lang string) string {
+	if lang == "en" {
+		name := h.Name
+		if en, ok := holiday.EnglishName(h.Name); ok {
+			name = fmt.Sprintf("%s (%s)", h.Name, en)
+		}
+		switch leadDays {
+		case 0:
+			return fmt.Sprintf("Today is %s.", name)
+		case 1:
+			return fmt.Sprintf("Tomorrow is %s.", name)
+		default:
+			return fmt.Sprintf("%s is in %d days.", name, leadDays)
+		}
+	}
+
+	switch leadDays {
+	case 0:
+		return fmt.Sprintf("今日は%sです", h.Name)
+	case 1:
+		return fmt.Sprintf("明日は%sです", h.Name)
+	case 2:
+		return fmt.Sprintf("明後日は%sです", h.Name)
+	default:
+		return fmt.Sprintf("%d日後は%sです", leadDays, h.Name)
+	}
+}
+
+// LongWeekendMessage returns a reminder text of the long weekend such as "3日後からゴールデンウィークの4連休です".
+// The long weekends around April 29 to May 5 are called Golden Week.
+func LongWeekendMessage(block holiday.RestBlock, leadDays int, lang string) string {
+	gw := isGoldenWeek(block)
+	if lang == "en" {
+		name := fmt.Sprintf("A %d-day weekend", block.Days)
+		if gw {
+			name = "Golden Week"
+		}
+		var when string
+		switch leadDays {
+		case 0:
+			when = "today"
+		case 1:
+			when = "tomorrow"
+		default:
+			when = fmt.Sprintf("in %d days", leadDays)
+		}
+		return fmt.Sprintf("%s starts %s: %d days off from %s to %s.", name, when, block.Days, formatDate(block.Start, lang), formatDate(block.End, lang))
+	}
+
+	var when string
+	switch leadDays {
+	case 0:
+		when = "今日"
+	case 1:
+		when = "明日"
+	case 2:
+		when = "明後日"
+	default:
+		when = fmt.Sprintf("%d日後", leadDays)
+	}
+	name := fmt.Sprintf("%d連休", block.Days)
+	if gw {
+		name = "ゴールデンウィークの" + name
+	}
+	return fmt.Sprintf("%sから%sです（%s〜%s）", when, name, formatDate(block.Start, lang), formatDate(block.End, lang))
+}
+
+// isGoldenWeek reports whether the block overlaps the days from 昭和の日 (April 29) to こどもの日 (May 5).
+func isGoldenWeek(block holiday.RestBlock) bool {
+	start := time.Date(block.Start.Year(), time.April, 29, 0, 0, 0, 0, holiday.JST)
+	end := time.Date(block.Start.Year(), time.May, 5, 0, 0, 0, 0, holiday.JST)
+	return !block.Start.After(end) && !block.End.Before(start)
+}
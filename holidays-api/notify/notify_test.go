@@ -0,0 +1,120 @@
+package notify
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestMessage(t *testing.T) {
+	h := holiday.Holiday{
+		Date: "2024-08-11",
+		Name: "山の日",
+	}
+	tests := []struct {
+		leadDays int
+		lang     string
+		want     string
+	}{
+		{0, "ja", "今日は山の日です"},
+		{1, "ja", "明日は山の日です"},
+		{2, "ja", "明後日は山の日です"},
+		{3, "ja", "3日後は山の日です"},
+		{0, "en", "Today is 山の日 (Mountain Day)."},
+		{1, "en", "Tomorrow is 山の日 (Mountain Day)."},
+		{3, "en", "山の日 (Mountain Day) is in 3 days."},
+	}
+	for _, tt := range tests {
+		got := Message(h, tt.leadDays, tt.lang)
+		if got != tt.want {
+			t.Errorf("Message(%d, %q): want %q, got %q", tt.leadDays, tt.lang, tt.want, got)
+		}
+	}
+}
+
+type recorder struct {
+	texts []string
+}
+
+func (r *recorder) Send(ctx context.Context, text string) error {
+	r.texts = append(r.texts, text)
+	return nil
+}
+
+func TestNotify(t *testing.T) {
+	r := &recorder{}
+	n := &Notifier{
+		Sender:   r,
+		LeadDays: 1,
+	}
+
+	// 2024-08-10 09:00 JST, the day before 山の日
+	now := time.Date(2024, time.August, 10, 0, 0, 0, 0, time.UTC)
+	if err := n.Notify(context.Background(), now); err != nil {
+		t.Fatal(err)
+	}
+	// 2024-08-13 is not the day before a holiday
+	if err := n.Notify(context.Background(), now.Add(3*24*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"明日は山の日です"}
+	if diff := cmp.Diff(want, r.texts); diff != "" {
+		t.Errorf("messages mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestLongWeekendMessage(t *testing.T) {
+	// 2025-05-03 to 2025-05-06
+	gw, _, _ := holiday.RestBlockOf(time.Date(2025, time.May, 3, 0, 0, 0, 0, holiday.JST))
+	// 2024-08-10 to 2024-08-12
+	summer, _, _ := holiday.RestBlockOf(time.Date(2024, time.August, 10, 0, 0, 0, 0, holiday.JST))
+
+	tests := []struct {
+		block    holiday.RestBlock
+		leadDays int
+		lang     string
+		want     string
+	}{
+		{gw, 3, "ja", "3日後からゴールデンウィークの4連休です（5月3日(土)〜5月6日(火)）"},
+		{gw, 0, "en", "Golden Week starts today: 4 days off from Sat, May 3 to Tue, May 6."},
+		{summer, 1, "ja", "明日から3連休です（8月10日(土)〜8月12日(月)）"},
+		{summer, 5, "en", "A 3-day weekend starts in 5 days: 3 days off from Sat, Aug 10 to Mon, Aug 12."},
+	}
+	for _, tt := range tests {
+		got := LongWeekendMessage(tt.block, tt.leadDays, tt.lang)
+		if got != tt.want {
+			t.Errorf("LongWeekendMessage(%s, %d, %q): want %q, got %q", tt.block.Start.Format("2006-01-02"), tt.leadDays, tt.lang, tt.want, got)
+		}
+	}
+}
+
+func TestNotify_LongWeekends(t *testing.T) {
+	r := &recorder{}
+	n := &Notifier{
+		Sender:       r,
+		LeadDays:     3,
+		LongWeekends: true,
+	}
+
+	// 2025-04-30 09:00 JST, 3 days before Golden Week
+	now := time.Date(2025, time.April, 30, 0, 0, 0, 0, time.UTC)
+	if err := n.Notify(context.Background(), now); err != nil {
+		t.Fatal(err)
+	}
+	// 2025-05-01, 3 days before みどりの日 in the middle of Golden Week
+	if err := n.Notify(context.Background(), now.Add(24*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"3日後は憲法記念日です\n3日後からゴールデンウィークの4連休です（5月3日(土)〜5月6日(火)）",
+		"3日後はみどりの日です",
+	}
+	if diff := cmp.Diff(want, r.texts); diff != "" {
+		t.Errorf("messages mismatch (-want/+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,80 @@
+// Package slack sends messages to Slack incoming webhooks.
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/notify"
+)
+
+// Webhook is a Slack incoming webhook.
+// https://api.slack.com/messaging/webhooks
+type Webhook struct {
+	// URL is the incoming webhook URL.
+	URL string
+
+	// Channel overrides the default channel of the webhook, e.g. "#general".
+	Channel string
+
+	// Username overrides the default name of the webhook.
+	Username string
+
+	// IconEmoji overrides the default icon of the webhook, e.g. ":calendar:".
+	IconEmoji string
+
+	// Client is used for sending messages.
+	// If nil, http.DefaultClient is used.
+	Client *http.Client
+}
+
+var _ notify.Sender = (*Webhook)(nil)
+
+type message struct {
+	Text      string `json:"text"`
+	Channel   string `json:"channel,omitempty"`
+	Username  string `json:"username,omitempty"`
+	IconEmoji string `json:"icon_emoji,omitempty"`
+}
+
+// Send implements notify.Sender.
+func (w *Webhook) Send(ctx context.Context, text string) error {
+	body, err := json.Marshal(message{
+		Text:      text,
+		Channel:   w.Channel,
+		Username:  w.Username,
+		IconEmoji: w.IconEmoji,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := w.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("slack: unexpected status code: %d: %s", resp.StatusCode, data)
+	}
+	return nil
+}
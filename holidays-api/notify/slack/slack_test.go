@@ -0,0 +1,55 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSend(t *testing.T) {
+	var got message
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Error(err)
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer ts.Close()
+
+	w := &Webhook{
+		URL:     ts.URL,
+		Channel: "#general",
+		Client:  ts.Client(),
+	}
+	if err := w.Send(context.Background(), "明日は山の日です"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := message{
+		Text:    "明日は山の日です",
+		Channel: "#general",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("message mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestSend_Error(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("no_service"))
+	}))
+	defer ts.Close()
+
+	w := &Webhook{
+		URL:    ts.URL,
+		Client: ts.Client(),
+	}
+	if err := w.Send(context.Background(), "hello"); err == nil {
+		t.Error("want error, but got nil")
+	}
+}
@@ -0,0 +1,165 @@
+// Package pdf renders a printable yearly calendar as a PDF document.
+//
+// The document uses HeiseiKakuGo-W5, one of the standard Japanese fonts of PDF viewers,
+// without embedding it. So the output is small, but some viewers may substitute the font.
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"time"
+	"unicode/utf16"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/render"
+)
+
+// the size of A4 in points.
+const (
+	pageWidth  = 595.28
+	pageHeight = 841.89
+)
+
+// layout of the calendar.
+const (
+	margin       = 36.0
+	columns      = 3
+	rows         = 4
+	titleSize    = 18.0
+	captionSize  = 11.0
+	daySize      = 9.0
+	listSize     = 6.5
+	cellWidth    = 22.0
+	cellHeight   = 13.0
+	listLineSize = 8.0
+)
+
+var weekdayNames = [...]string{"日", "月", "火", "水", "木", "金", "土"}
+
+type color struct{ r, g, b float64 }
+
+var (
+	black = color{0, 0, 0}
+	red   = color{0.8, 0, 0}
+	blue  = color{0, 0, 0.8}
+)
+
+// Calendar writes an A4 calendar of the year.
+// Sundays and holidays are shown in red, and Saturdays are shown in blue.
+func Calendar(w io.Writer, year int) error {
+	var c content
+	c.text(margin, pageHeight-margin-titleSize, titleSize, black, fmt.Sprintf("%d年 祝日カレンダー", year))
+
+	blockWidth := (pageWidth - 2*margin) / columns
+	blockHeight := (pageHeight - 2*margin - titleSize - 12) / rows
+	for i, m := range render.Year(year) {
+		x := margin + float64(i%columns)*blockWidth + (blockWidth-7*cellWidth)/2
+		y := pageHeight - margin - titleSize - 12 - float64(i/columns)*blockHeight
+		month(&c, x, y, m)
+	}
+
+	return write(w, c.bytes())
+}
+
+func month(c *content, x, y float64, m render.Month) {
+	y -= captionSize + 4
+	c.text(x, y, captionSize, black, fmt.Sprintf("%d月", int(m.Month)))
+
+	y -= cellHeight
+	for i, name := range weekdayNames {
+		col := black
+		switch time.Weekday(i) {
+		case time.Sunday:
+			col = red
+		case time.Saturday:
+			col = blue
+		}
+		c.text(x+float64(i)*cellWidth+(cellWidth-daySize)/2, y, daySize, col, name)
+	}
+
+	for _, week := range m.Weeks {
+		y -= cellHeight
+		for i, d := range week {
+			if d.Day == 0 {
+				continue
+			}
+			col := black
+			switch {
+			case d.Holiday != "" || d.Weekday == time.Sunday:
+				col = red
+			case d.Weekday == time.Saturday:
+				col = blue
+			}
+			s := fmt.Sprint(d.Day)
+			// right-align the numbers. the width of digits is a half of the font size.
+			width := float64(len(s)) * daySize / 2
+			c.text(x+float64(i+1)*cellWidth-width-4, y, daySize, col, s)
+		}
+	}
+
+	y -= 4
+	for _, h := range m.Holidays {
+		y -= listLineSize
+		c.text(x, y, listSize, red, fmt.Sprintf("%s %s", h.Date[5:], h.Name))
+	}
+}
+
+// content builds a content stream.
+type content struct {
+	buf bytes.Buffer
+}
+
+func (c *content) text(x, y, size float64, col color, s string) {
+	fmt.Fprintf(&c.buf, "BT %.3f %.3f %.3f rg /F1 %.1f Tf %.2f %.2f Td <", col.r, col.g, col.b, size, x, y)
+	for _, u := range utf16.Encode([]rune(s)) {
+		fmt.Fprintf(&c.buf, "%04X", u)
+	}
+	c.buf.WriteString("> Tj ET\n")
+}
+
+func (c *content) bytes() []byte {
+	return c.buf.Bytes()
+}
+
+// write writes a single-page PDF document with the content stream.
+func write(w io.Writer, stream []byte) error {
+	var compressed bytes.Buffer
+	zw := zlib.NewWriter(&compressed)
+	if _, err := zw.Write(stream); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /F1 4 0 R >> >> /Contents 7 0 R >>", pageWidth, pageHeight),
+		"<< /Type /Font /Subtype /Type0 /BaseFont /HeiseiKakuGo-W5 /Encoding /UniJIS-UCS2-H /DescendantFonts [5 0 R] >>",
+		// CIDs 1-95 are the proportional Roman glyphs. they are treated as half-width.
+		"<< /Type /Font /Subtype /CIDFontType0 /BaseFont /HeiseiKakuGo-W5 /CIDSystemInfo << /Registry (Adobe) /Ordering (Japan1) /Supplement 2 >> /FontDescriptor 6 0 R /DW 1000 /W [1 95 500] >>",
+		"<< /Type /FontDescriptor /FontName /HeiseiKakuGo-W5 /Flags 4 /FontBBox [-92 -250 1010 922] /ItalicAngle 0 /Ascent 880 /Descent -120 /CapHeight 880 /StemV 93 >>",
+		fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", compressed.Len(), compressed.Bytes()),
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n", len(objects)+1)
+	buf.WriteString("0000000000 65535 f \n")
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
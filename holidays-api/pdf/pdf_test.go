@@ -0,0 +1,71 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestCalendar(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Calendar(&buf, 2000); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	if !bytes.HasPrefix(data, []byte("%PDF-1.4\n")) {
+		t.Fatal("missing PDF header")
+	}
+	if !bytes.HasSuffix(data, []byte("%%EOF\n")) {
+		t.Fatal("missing EOF marker")
+	}
+
+	// the xref table must point to the objects.
+	m := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(data)
+	if m == nil {
+		t.Fatal("startxref not found")
+	}
+	xref, _ := strconv.Atoi(string(m[1]))
+	if !bytes.HasPrefix(data[xref:], []byte("xref\n")) {
+		t.Fatalf("startxref points to wrong offset: %d", xref)
+	}
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(data[xref:], -1)
+	if len(entries) != 7 {
+		t.Fatalf("want 7 objects, got %d", len(entries))
+	}
+	for i, e := range entries {
+		off, _ := strconv.Atoi(string(e[1]))
+		want := strconv.Itoa(i+1) + " 0 obj\n"
+		if !bytes.HasPrefix(data[off:], []byte(want)) {
+			t.Errorf("object %d: unexpected offset %d", i+1, off)
+		}
+	}
+
+	// decode the content stream.
+	start := bytes.Index(data, []byte("stream\n")) + len("stream\n")
+	end := bytes.Index(data, []byte("\nendstream"))
+	r, err := zlib.NewReader(bytes.NewReader(data[start:end]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 2000-05-04 国民の休日 is listed in red.
+	if !strings.Contains(string(stream), "0.800 0.000 0.000 rg /F1 6.5 Tf") {
+		t.Error("holidays are not shown in red")
+	}
+	if !strings.Contains(string(stream), "<0030003500" /* "05" */) {
+		t.Error("holiday list is missing")
+	}
+	// 日 in the weekday header.
+	if !strings.Contains(string(stream), "<65E5>") {
+		t.Error("weekday header is missing")
+	}
+}
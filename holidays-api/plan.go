@@ -0,0 +1,79 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+const (
+	// maxPlanLeaveDays is the max of the days parameter of /plan.
+	maxPlanLeaveDays = 100
+
+	// maxPlanRangeDays is the max length of the range of /plan.
+	maxPlanRangeDays = 366
+)
+
+// PlanResponse is the response of the plan endpoint.
+type PlanResponse struct {
+	// Leave are the dates to take paid leave on.
+	Leave []string `json:"leave"`
+
+	// RestFrom and RestTo are the first and the last day of the longest rest block made by the leave.
+	RestFrom string `json:"rest_from"`
+	RestTo   string `json:"rest_to"`
+
+	// Days is the length of the rest block.
+	Days int `json:"days"`
+}
+
+// plan serves the leave dates between from and to that make the longest rest block with the days of paid leave.
+func (h *Handler) plan(w http.ResponseWriter, u *url.URL) *apiError {
+	q := u.Query()
+	from, err := parseDate(q.Get("from"))
+	if err != nil {
+		return invalidDate("from", q.Get("from"))
+	}
+	to, err := parseDate(q.Get("to"))
+	if err != nil {
+		return invalidDate("to", q.Get("to"))
+	}
+	days, err := strconv.Atoi(q.Get("days"))
+	if err != nil || days < 0 || days > maxPlanLeaveDays {
+		return invalidParameter("days %q must be an integer between 0 and %d", q.Get("days"), maxPlanLeaveDays)
+	}
+	tf := time.Date(from.Year, from.Month, from.Day, 0, 0, 0, 0, holiday.JST)
+	tt := time.Date(to.Year, to.Month, to.Day, 0, 0, 0, 0, holiday.JST)
+	if tt.Before(tf) || tt.Sub(tf) >= maxPlanRangeDays*24*time.Hour {
+		return invalidParameter("the range from %s to %s must be up to %d days", q.Get("from"), q.Get("to"), maxPlanRangeDays)
+	}
+
+	plan := holiday.PlanLeave(from, to, days)
+	res := PlanResponse{
+		Leave: make([]string, 0, len(plan.Leave)),
+		Days:  plan.Block.Days,
+	}
+	for _, d := range plan.Leave {
+		res.Leave = append(res.Leave, d.Format("2006-01-02"))
+	}
+	if plan.Block.Days > 0 {
+		res.RestFrom = plan.Block.Start.Format("2006-01-02")
+		res.RestTo = plan.Block.End.Format("2006-01-02")
+	}
+	data, err := json.Marshal(res)
+	if err != nil {
+		h.responseInternalServerError(w, err)
+		return nil
+	}
+
+	setCommonHeaders(w, 24*time.Hour)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+	return nil
+}
@@ -0,0 +1,82 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestServeHTTP_Plan(t *testing.T) {
+	h := NewHandler()
+	tests := []struct {
+		query  string
+		status int
+		want   PlanResponse
+	}{
+		{
+			query:  "?from=2025-04-26&to=2025-05-11&days=4",
+			status: http.StatusOK,
+			want: PlanResponse{
+				Leave:    []string{"2025-04-28", "2025-04-30", "2025-05-01", "2025-05-02"},
+				RestFrom: "2025-04-26",
+				RestTo:   "2025-05-06",
+				Days:     11,
+			},
+		},
+		{
+			query:  "?from=2025-04-26&to=2025-05-11&days=0",
+			status: http.StatusOK,
+			want: PlanResponse{
+				Leave:    []string{},
+				RestFrom: "2025-05-03",
+				RestTo:   "2025-05-06",
+				Days:     4,
+			},
+		},
+		{
+			query:  "?from=2025-04-26&to=2025-05-11",
+			status: http.StatusBadRequest,
+		},
+		{
+			query:  "?from=2025-04-26&to=2025-05-11&days=-1",
+			status: http.StatusBadRequest,
+		},
+		{
+			query:  "?from=2025-05-11&to=2025-04-26&days=3",
+			status: http.StatusBadRequest,
+		},
+		{
+			query:  "?from=2025-01-01&to=2026-01-02&days=3",
+			status: http.StatusBadRequest,
+		},
+		{
+			query:  "?from=2025-02-32&to=2025-05-11&days=3",
+			status: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "http://example.com/plan"+tt.query, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.status {
+				t.Fatalf("unexpected status code: want %d, got %d", tt.status, resp.StatusCode)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			var got PlanResponse
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("response mismatch (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package holidaysapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// QuotaStore stores the number of requests per API key per day.
+// Implementations must be safe for concurrent use by multiple goroutines.
+type QuotaStore interface {
+	// Increment increments the usage of the key on the day, and returns the usage after incrementing.
+	Increment(ctx context.Context, name string, day holiday.Date) (int, error)
+
+	// Usage returns the usage of all keys on the day.
+	Usage(ctx context.Context, day holiday.Date) (map[string]int, error)
+}
+
+// MemoryQuotaStore is a QuotaStore that keeps the usage in memory.
+// The usage is lost when the process exits.
+type MemoryQuotaStore struct {
+	mu    sync.Mutex
+	day   holiday.Date
+	usage map[string]int
+}
+
+var _ QuotaStore = (*MemoryQuotaStore)(nil)
+
+// NewMemoryQuotaStore returns a new MemoryQuotaStore.
+func NewMemoryQuotaStore() *MemoryQuotaStore {
+	return &MemoryQuotaStore{
+		usage: map[string]int{},
+	}
+}
+
+// Increment implements QuotaStore.
+// Only the usage of the latest day is kept.
+func (s *MemoryQuotaStore) Increment(ctx context.Context, name string, day holiday.Date) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.day != day {
+		s.day = day
+		s.usage = map[string]int{}
+	}
+	s.usage[name]++
+	return s.usage[name], nil
+}
+
+// Usage implements QuotaStore.
+func (s *MemoryQuotaStore) Usage(ctx context.Context, day holiday.Date) (map[string]int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ret := map[string]int{}
+	if s.day == day {
+		for k, v := range s.usage {
+			ret[k] = v
+		}
+	}
+	return ret, nil
+}
+
+// FileQuotaStore is a QuotaStore that persists the usage to a JSON file,
+// so the quotas survive restarts.
+// The file is replaced atomically on every increment.
+// It is intended for small deployments with a single process.
+type FileQuotaStore struct {
+	path string
+
+	mu  sync.Mutex
+	mem *MemoryQuotaStore
+}
+
+var _ QuotaStore = (*FileQuotaStore)(nil)
+
+type quotaFile struct {
+	Date  string         `json:"date"`
+	Usage map[string]int `json:"usage"`
+}
+
+// NewFileQuotaStore returns a new FileQuotaStore that persists the usage to the file at path.
+// The usage in the file is loaded if it exists.
+func NewFileQuotaStore(path string) (*FileQuotaStore, error) {
+	s := &FileQuotaStore{
+		path: path,
+		mem:  NewMemoryQuotaStore(),
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var f quotaFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+	day, err := holiday.ParseDate(f.Date)
+	if err != nil {
+		return nil, err
+	}
+	s.mem.day = day
+	for k, v := range f.Usage {
+		s.mem.usage[k] = v
+	}
+	return s, nil
+}
+
+// Increment implements QuotaStore.
+func (s *FileQuotaStore) Increment(ctx context.Context, name string, day holiday.Date) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n, err := s.mem.Increment(ctx, name, day)
+	if err != nil {
+		return 0, err
+	}
+	usage, err := s.mem.Usage(ctx, day)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.save(quotaFile{Date: day.String(), Usage: usage}); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// Usage implements QuotaStore.
+func (s *FileQuotaStore) Usage(ctx context.Context, day holiday.Date) (map[string]int, error) {
+	return s.mem.Usage(ctx, day)
+}
+
+func (s *FileQuotaStore) save(f quotaFile) error {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), ".quota-*.json")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// SQLQuotaStore is a QuotaStore that persists the usage to a SQL database,
+// so the quotas survive restarts and are shared by multiple processes.
+// The queries are written for SQLite.
+type SQLQuotaStore struct {
+	db *sql.DB
+}
+
+var _ QuotaStore = (*SQLQuotaStore)(nil)
+
+const sqlQuotaSchema = `
+CREATE TABLE IF NOT EXISTS quota_usage (
+	day   TEXT NOT NULL,
+	name  TEXT NOT NULL,
+	usage INTEGER NOT NULL,
+	PRIMARY KEY (day, name)
+);
+`
+
+// NewSQLQuotaStore returns a new SQLQuotaStore that persists the usage to db.
+// The table is created if it doesn't exist.
+func NewSQLQuotaStore(ctx context.Context, db *sql.DB) (*SQLQuotaStore, error) {
+	if _, err := db.ExecContext(ctx, sqlQuotaSchema); err != nil {
+		return nil, err
+	}
+	return &SQLQuotaStore{db: db}, nil
+}
+
+// Increment implements QuotaStore.
+// The usage of the days before day is deleted.
+func (s *SQLQuotaStore) Increment(ctx context.Context, name string, day holiday.Date) (int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM quota_usage WHERE day < ?", day.String()); err != nil {
+		return 0, err
+	}
+	_, err = tx.ExecContext(
+		ctx,
+		"INSERT INTO quota_usage (day, name, usage) VALUES (?, ?, 1) ON CONFLICT (day, name) DO UPDATE SET usage = usage + 1",
+		day.String(), name,
+	)
+	if err != nil {
+		return 0, err
+	}
+	var n int
+	if err := tx.QueryRowContext(ctx, "SELECT usage FROM quota_usage WHERE day = ? AND name = ?", day.String(), name).Scan(&n); err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// Usage implements QuotaStore.
+func (s *SQLQuotaStore) Usage(ctx context.Context, day holiday.Date) (map[string]int, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT name, usage FROM quota_usage WHERE day = ?", day.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ret := map[string]int{}
+	for rows.Next() {
+		var name string
+		var n int
+		if err := rows.Scan(&name, &n); err != nil {
+			return nil, err
+		}
+		ret[name] = n
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
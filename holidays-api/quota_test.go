@@ -0,0 +1,208 @@
+package holidaysapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+	_ "modernc.org/sqlite"
+)
+
+func TestFileQuotaStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "quota.json")
+	day := holiday.Date{Year: 2024, Month: time.January, Day: 1}
+
+	s, err := NewFileQuotaStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 3; i++ {
+		n, err := s.Increment(ctx, "example-app", day)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != i {
+			t.Errorf("want %d, got %d", i, n)
+		}
+	}
+
+	// the usage survives restarts.
+	s, err = NewFileQuotaStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := s.Increment(ctx, "example-app", day); err != nil || n != 4 {
+		t.Errorf("want 4, got %d, %v", n, err)
+	}
+
+	// the usage is reset on the next day.
+	next := holiday.Date{Year: 2024, Month: time.January, Day: 2}
+	if n, err := s.Increment(ctx, "example-app", next); err != nil || n != 1 {
+		t.Errorf("want 1, got %d, %v", n, err)
+	}
+	usage, err := s.Usage(ctx, day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(usage) != 0 {
+		t.Errorf("want no usage, got %v", usage)
+	}
+}
+
+func TestSQLQuotaStore(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "holidays.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	day := holiday.Date{Year: 2024, Month: time.January, Day: 1}
+
+	s, err := NewSQLQuotaStore(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 3; i++ {
+		n, err := s.Increment(ctx, "example-app", day)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != i {
+			t.Errorf("want %d, got %d", i, n)
+		}
+	}
+	if n, err := s.Increment(ctx, "other-app", day); err != nil || n != 1 {
+		t.Errorf("want 1, got %d, %v", n, err)
+	}
+
+	// the usage survives restarts.
+	s, err = NewSQLQuotaStore(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	usage, err := s.Usage(ctx, day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(map[string]int{"example-app": 3, "other-app": 1}, usage); diff != "" {
+		t.Errorf("usage mismatch (-want/+got):\n%s", diff)
+	}
+
+	// the usage is reset on the next day.
+	next := holiday.Date{Year: 2024, Month: time.January, Day: 2}
+	if n, err := s.Increment(ctx, "example-app", next); err != nil || n != 1 {
+		t.Errorf("want 1, got %d, %v", n, err)
+	}
+	usage, err = s.Usage(ctx, day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(usage) != 0 {
+		t.Errorf("want no usage, got %v", usage)
+	}
+}
+
+func TestAPIKeys_DailyQuota(t *testing.T) {
+	// 2024-01-01 23:00 JST
+	now := time.Date(2024, time.January, 1, 14, 0, 0, 0, time.UTC)
+	ks := NewAPIKeys(
+		APIKey{Name: "example-app", Key: "example-key", DailyQuota: 2},
+		APIKey{Name: "unlimited", Key: "unlimited-key"},
+	)
+	ks.Clock = holiday.ClockFunc(func() time.Time { return now })
+	h := ks.Handler(NewHandler())
+
+	get := func() *http.Response {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/2021/01/01", nil)
+		req.Header.Set("X-API-Key", "example-key")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		return w.Result()
+	}
+	for i, remaining := range []string{"1", "0"} {
+		resp := get()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("#%d: want %d, got %d", i, http.StatusOK, resp.StatusCode)
+		}
+		if got := resp.Header.Get("X-Quota-Remaining"); got != remaining {
+			t.Errorf("#%d: want remaining %s, got %s", i, remaining, got)
+		}
+	}
+	resp := get()
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("want %d, got %d", http.StatusTooManyRequests, resp.StatusCode)
+	}
+	if got := resp.Header.Get("X-Quota-Reset"); got != "2024-01-02T00:00:00+09:00" {
+		t.Errorf("unexpected X-Quota-Reset: %s", got)
+	}
+	if got := resp.Header.Get("Retry-After"); got != "3600" {
+		t.Errorf("unexpected Retry-After: %s", got)
+	}
+
+	// the usage is reported to the operators.
+	admin := AdminAuth("secret", ks.QuotaHandler())
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/admin/quotas", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	w := httptest.NewRecorder()
+	admin.ServeHTTP(w, req)
+	var got QuotaResponse
+	if err := json.NewDecoder(w.Result().Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	want := QuotaResponse{
+		Date: "2024-01-01",
+		Usage: []QuotaUsage{
+			{Name: "example-app", Used: 3, Quota: 2},
+			{Name: "unlimited", Used: 0, Quota: 0},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("usage mismatch (-want/+got):\n%s", diff)
+	}
+
+	// the quota is reset at 00:00 JST.
+	now = now.Add(time.Hour)
+	if resp := get(); resp.StatusCode != http.StatusOK {
+		t.Errorf("want %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+}
+
+func TestAdminAuth(t *testing.T) {
+	h := AdminAuth("secret", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	for _, tt := range []struct {
+		auth string
+		want int
+	}{
+		{"Bearer secret", http.StatusOK},
+		{"Bearer wrong", http.StatusUnauthorized},
+		{"secret", http.StatusUnauthorized},
+		{"", http.StatusUnauthorized},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/admin/quotas", nil)
+		if tt.auth != "" {
+			req.Header.Set("Authorization", tt.auth)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != tt.want {
+			t.Errorf("%q: want %d, got %d", tt.auth, tt.want, w.Code)
+		}
+	}
+
+	// empty token rejects everything.
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/admin/quotas", nil)
+	req.Header.Set("Authorization", "Bearer ")
+	w := httptest.NewRecorder()
+	AdminAuth("", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})).ServeHTTP(w, req)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("want %d, got %d", http.StatusUnauthorized, w.Code)
+	}
+}
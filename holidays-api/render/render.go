@@ -0,0 +1,148 @@
+// Package render renders year calendars with holidays for humans.
+package render
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+var weekdayNames = [...]string{"日", "月", "火", "水", "木", "金", "土"}
+
+// Day is a day in the calendar grid.
+type Day struct {
+	// Day is the day of month. It is zero for padding cells.
+	Day     int
+	Weekday time.Weekday
+	Holiday string
+}
+
+// Month is a month in the calendar.
+type Month struct {
+	Year     int
+	Month    time.Month
+	Weeks    [][7]Day
+	Holidays []holiday.Holiday
+}
+
+// Year returns the calendar grid of the year. Weeks start on Sunday.
+func Year(year int) []Month {
+	holidays := holiday.FindHolidaysInYear(year)
+	names := make(map[string]string, len(holidays))
+	for _, h := range holidays {
+		names[h.Date] = h.Name
+	}
+
+	months := make([]Month, 0, 12)
+	for m := time.January; m <= time.December; m++ {
+		first := time.Date(year, m, 1, 0, 0, 0, 0, time.UTC)
+		days := time.Date(year, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+		month := Month{Year: year, Month: m}
+		var week [7]Day
+		for d := 1; d <= days; d++ {
+			wd := time.Weekday((int(first.Weekday()) + d - 1) % 7)
+			week[wd] = Day{
+				Day:     d,
+				Weekday: wd,
+				Holiday: names[fmt.Sprintf("%04d-%02d-%02d", year, int(m), d)],
+			}
+			if wd == time.Saturday || d == days {
+				month.Weeks = append(month.Weeks, week)
+				week = [7]Day{}
+			}
+		}
+		for _, h := range holidays {
+			if strings.HasPrefix(h.Date, fmt.Sprintf("%04d-%02d-", year, int(m))) {
+				month.Holidays = append(month.Holidays, h)
+			}
+		}
+		months = append(months, month)
+	}
+	return months
+}
+
+// Markdown writes the calendar of the year in Markdown.
+// Holidays are shown in bold and listed below each month.
+func Markdown(w io.Writer, year int) error {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "# %d年\n", year)
+	for _, m := range Year(year) {
+		fmt.Fprintf(&buf, "\n## %d年%d月\n\n", m.Year, int(m.Month))
+		buf.WriteString("|")
+		for _, name := range weekdayNames {
+			buf.WriteString(" " + name + " |")
+		}
+		buf.WriteString("\n|" + strings.Repeat(" ---: |", 7) + "\n")
+		for _, week := range m.Weeks {
+			buf.WriteString("|")
+			for _, d := range week {
+				switch {
+				case d.Day == 0:
+					buf.WriteString("  |")
+				case d.Holiday != "":
+					fmt.Fprintf(&buf, " **%d** |", d.Day)
+				default:
+					fmt.Fprintf(&buf, " %d |", d.Day)
+				}
+			}
+			buf.WriteString("\n")
+		}
+		if len(m.Holidays) > 0 {
+			buf.WriteString("\n")
+			for _, h := range m.Holidays {
+				fmt.Fprintf(&buf, "- %s %s\n", h.Date, h.Name)
+			}
+		}
+	}
+	_, err := io.WriteString(w, buf.String())
+	return err
+}
+
+var htmlTemplate = template.Must(template.New("calendar").Parse(`<!DOCTYPE html>
+<html lang="ja">
+<head>
+<meta charset="utf-8">
+<title>{{ .Year }}年の祝日カレンダー</title>
+<style>
+body { font-family: sans-serif; }
+.months { display: flex; flex-wrap: wrap; gap: 1em; }
+table { border-collapse: collapse; }
+th, td { width: 2em; text-align: right; padding: 0.2em; }
+.sun, .holiday { color: #d00; }
+.sat { color: #00d; }
+.holiday { font-weight: bold; }
+</style>
+</head>
+<body>
+<h1>{{ .Year }}年</h1>
+<div class="months">
+{{- range .Months }}
+<table>
+<caption>{{ .Year }}年{{ printf "%d" .Month }}月</caption>
+<thead><tr>{{ range $i, $name := $.WeekdayNames }}<th{{ if eq $i 0 }} class="sun"{{ else if eq $i 6 }} class="sat"{{ end }}>{{ $name }}</th>{{ end }}</tr></thead>
+<tbody>
+{{- range .Weeks }}
+<tr>{{ range . }}{{ if eq .Day 0 }}<td></td>{{ else if .Holiday }}<td class="holiday" title="{{ .Holiday }}">{{ .Day }}</td>{{ else if eq .Weekday 0 }}<td class="sun">{{ .Day }}</td>{{ else if eq .Weekday 6 }}<td class="sat">{{ .Day }}</td>{{ else }}<td>{{ .Day }}</td>{{ end }}{{ end }}</tr>
+{{- end }}
+</tbody>
+</table>
+{{- end }}
+</div>
+</body>
+</html>
+`))
+
+// HTML writes the calendar of the year as an HTML document.
+// Holidays are highlighted and their names are shown as tooltips.
+func HTML(w io.Writer, year int) error {
+	return htmlTemplate.Execute(w, map[string]any{
+		"Year":         year,
+		"Months":       Year(year),
+		"WeekdayNames": weekdayNames,
+	})
+}
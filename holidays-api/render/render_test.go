@@ -0,0 +1,51 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMarkdown(t *testing.T) {
+	var buf strings.Builder
+	if err := Markdown(&buf, 2000); err != nil {
+		t.Fatal(err)
+	}
+	got := buf.String()
+
+	const january = `## 2000年1月
+
+| 日 | 月 | 火 | 水 | 木 | 金 | 土 |
+| ---: | ---: | ---: | ---: | ---: | ---: | ---: |
+|  |  |  |  |  |  | **1** |
+| 2 | 3 | 4 | 5 | 6 | 7 | 8 |
+| 9 | **10** | 11 | 12 | 13 | 14 | 15 |
+| 16 | 17 | 18 | 19 | 20 | 21 | 22 |
+| 23 | 24 | 25 | 26 | 27 | 28 | 29 |
+| 30 | 31 |  |  |  |  |  |
+
+- 2000-01-01 元日
+- 2000-01-10 成人の日
+`
+	if !strings.Contains(got, january) {
+		t.Errorf("January is not rendered as expected:\n%s", got)
+	}
+}
+
+func TestHTML(t *testing.T) {
+	var buf strings.Builder
+	if err := HTML(&buf, 2000); err != nil {
+		t.Fatal(err)
+	}
+	got := buf.String()
+
+	for _, want := range []string{
+		`<caption>2000年1月</caption>`,
+		`<td class="holiday" title="元日">1</td>`,
+		`<td class="holiday" title="成人の日">10</td>`,
+		`<td class="sun">2</td>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("%q is not found", want)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Package requestid propagates request IDs across services through the X-Request-ID header,
+// so the access logs, the error responses and the outbound requests of a request can be correlated.
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+)
+
+// Header is the name of the HTTP header that carries the request ID.
+const Header = "X-Request-ID"
+
+// maxLength is the max length of the request IDs accepted from clients.
+const maxLength = 200
+
+type contextKey struct{}
+
+// NewContext returns a new context that carries the request ID.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the request ID in ctx.
+func FromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(contextKey{}).(string)
+	return id, ok
+}
+
+// New generates a new random request ID.
+func New() string {
+	var buf [16]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(buf[:])
+}
+
+// Handler returns a handler that propagates the request ID in the X-Request-ID header of the request,
+// or generates a new one if the request has no valid ID.
+// The ID is set to the X-Request-ID header of the response and to the context of the request.
+func Handler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(Header)
+		if !valid(id) {
+			id = New()
+		}
+		w.Header().Set(Header, id)
+		h.ServeHTTP(w, r.WithContext(NewContext(r.Context(), id)))
+	})
+}
+
+// SetHeader sets the request ID in ctx to the X-Request-ID header of the outbound request req.
+func SetHeader(req *http.Request) {
+	if id, ok := FromContext(req.Context()); ok {
+		req.Header.Set(Header, id)
+	}
+}
+
+// valid reports whether id is safe to log and to send to other services.
+func valid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
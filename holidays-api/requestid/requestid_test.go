@@ -0,0 +1,71 @@
+package requestid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		keep   bool
+	}{
+		{name: "propagate", header: "abc-123", keep: true},
+		{name: "generate", header: "", keep: false},
+		{name: "too long", header: strings.Repeat("a", maxLength+1), keep: false},
+		{name: "control characters", header: "abc\x00", keep: false},
+		{name: "spaces", header: "abc 123", keep: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			h := Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var ok bool
+				got, ok = FromContext(r.Context())
+				if !ok {
+					t.Error("the request ID is not in the context")
+				}
+			}))
+			req := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+			if tt.header != "" {
+				req.Header.Set(Header, tt.header)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			if tt.keep && got != tt.header {
+				t.Errorf("want %q, got %q", tt.header, got)
+			}
+			if !tt.keep && (got == tt.header || len(got) != 32) {
+				t.Errorf("want a new ID, got %q", got)
+			}
+			if res := w.Header().Get(Header); res != got {
+				t.Errorf("unexpected response header: want %q, got %q", got, res)
+			}
+		})
+	}
+}
+
+func TestSetHeader(t *testing.T) {
+	req, err := http.NewRequestWithContext(NewContext(context.Background(), "abc-123"), http.MethodGet, "http://example.com/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetHeader(req)
+	if got := req.Header.Get(Header); got != "abc-123" {
+		t.Errorf("want %q, got %q", "abc-123", got)
+	}
+
+	req, err = http.NewRequest(http.MethodGet, "http://example.com/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetHeader(req)
+	if got := req.Header.Get(Header); got != "" {
+		t.Errorf("want no header, got %q", got)
+	}
+}
@@ -0,0 +1,96 @@
+// Package schedule provides holiday-aware schedules for periodic jobs.
+//
+// Schedule has the same method set as cron.Schedule of github.com/robfig/cron,
+// so the wrapped schedules can be passed to (*cron.Cron).Schedule directly.
+// They also work with a plain time.Timer:
+//
+//	s := schedule.Wrap(schedule.Daily(9, 0), schedule.NextBusinessDay)
+//	timer := time.NewTimer(time.Until(s.Next(time.Now())))
+package schedule
+
+import (
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// maxIterations limits the search of the next run,
+// so that a schedule that never hits a business day doesn't loop forever.
+const maxIterations = 1000
+
+// Schedule calculates the next run time.
+type Schedule interface {
+	// Next returns the next run time after the given time.
+	// It returns the zero time if no time can be found.
+	Next(time.Time) time.Time
+}
+
+// ScheduleFunc is an adapter to allow the use of ordinary functions as Schedule.
+type ScheduleFunc func(time.Time) time.Time
+
+// Next implements Schedule.
+func (f ScheduleFunc) Next(t time.Time) time.Time {
+	return f(t)
+}
+
+// Daily returns a schedule that runs at hour:min in JST every day.
+func Daily(hour, min int) Schedule {
+	return ScheduleFunc(func(t time.Time) time.Time {
+		t = t.In(holiday.JST)
+		next := time.Date(t.Year(), t.Month(), t.Day(), hour, min, 0, 0, holiday.JST)
+		if !next.After(t) {
+			next = time.Date(t.Year(), t.Month(), t.Day()+1, hour, min, 0, 0, holiday.JST)
+		}
+		return next
+	})
+}
+
+// Policy is how to handle runs that fall on weekends or holidays.
+type Policy int
+
+const (
+	// Skip skips the runs on weekends and holidays.
+	Skip Policy = iota
+
+	// NextBusinessDay postpones the runs on weekends and holidays to the next business day.
+	NextBusinessDay
+
+	// PreviousBusinessDay brings forward the runs on weekends and holidays to the previous business day.
+	PreviousBusinessDay
+)
+
+// Wrap returns a schedule that applies the policy to s.
+func Wrap(s Schedule, p Policy) Schedule {
+	return &wrapped{s: s, p: p}
+}
+
+type wrapped struct {
+	s Schedule
+	p Policy
+}
+
+func (w *wrapped) Next(t time.Time) time.Time {
+	cur := t
+	for i := 0; i < maxIterations; i++ {
+		next := w.s.Next(cur)
+		if next.IsZero() {
+			return next
+		}
+		if holiday.IsBusinessDay(next) {
+			return next
+		}
+
+		switch w.p {
+		case NextBusinessDay:
+			return holiday.NextBusinessDay(next)
+		case PreviousBusinessDay:
+			prev := holiday.PreviousBusinessDay(next)
+			if prev.After(t) {
+				return prev
+			}
+			// the run has already been brought forward before t.
+		}
+		cur = next
+	}
+	return time.Time{}
+}
@@ -0,0 +1,97 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// monthly runs at 09:00 JST on the 15th of every month.
+var monthly = ScheduleFunc(func(t time.Time) time.Time {
+	t = t.In(holiday.JST)
+	next := time.Date(t.Year(), t.Month(), 15, 9, 0, 0, 0, holiday.JST)
+	if !next.After(t) {
+		next = time.Date(t.Year(), t.Month()+1, 15, 9, 0, 0, 0, holiday.JST)
+	}
+	return next
+})
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule Schedule
+		policy   Policy
+		now      time.Time
+		want     time.Time
+	}{
+		{
+			name:     "business day",
+			schedule: Daily(9, 0),
+			policy:   Skip,
+			now:      time.Date(2024, time.August, 7, 9, 0, 0, 0, holiday.JST),
+			want:     time.Date(2024, time.August, 8, 9, 0, 0, 0, holiday.JST),
+		},
+		{
+			// 2024-08-10 Sat, 2024-08-11 Sun 山の日, 2024-08-12 Mon 休日
+			name:     "skip",
+			schedule: Daily(9, 0),
+			policy:   Skip,
+			now:      time.Date(2024, time.August, 9, 9, 0, 0, 0, holiday.JST),
+			want:     time.Date(2024, time.August, 13, 9, 0, 0, 0, holiday.JST),
+		},
+		{
+			name:     "next business day",
+			schedule: Daily(9, 0),
+			policy:   NextBusinessDay,
+			now:      time.Date(2024, time.August, 9, 9, 0, 0, 0, holiday.JST),
+			want:     time.Date(2024, time.August, 13, 9, 0, 0, 0, holiday.JST),
+		},
+		{
+			name:     "previous business day",
+			schedule: Daily(9, 0),
+			policy:   PreviousBusinessDay,
+			now:      time.Date(2024, time.August, 8, 9, 0, 0, 0, holiday.JST),
+			want:     time.Date(2024, time.August, 9, 9, 0, 0, 0, holiday.JST),
+		},
+		{
+			// the runs on the weekend have been brought forward to 2024-08-09.
+			name:     "previous business day already run",
+			schedule: Daily(9, 0),
+			policy:   PreviousBusinessDay,
+			now:      time.Date(2024, time.August, 9, 9, 0, 0, 0, holiday.JST),
+			want:     time.Date(2024, time.August, 13, 9, 0, 0, 0, holiday.JST),
+		},
+		{
+			// 2024-09-15 Sun, 2024-09-16 Mon 敬老の日
+			name:     "monthly next business day",
+			schedule: monthly,
+			policy:   NextBusinessDay,
+			now:      time.Date(2024, time.September, 1, 0, 0, 0, 0, holiday.JST),
+			want:     time.Date(2024, time.September, 17, 9, 0, 0, 0, holiday.JST),
+		},
+		{
+			name:     "monthly previous business day",
+			schedule: monthly,
+			policy:   PreviousBusinessDay,
+			now:      time.Date(2024, time.September, 1, 0, 0, 0, 0, holiday.JST),
+			want:     time.Date(2024, time.September, 13, 9, 0, 0, 0, holiday.JST),
+		},
+		{
+			name:     "monthly skip",
+			schedule: monthly,
+			policy:   Skip,
+			now:      time.Date(2024, time.September, 1, 0, 0, 0, 0, holiday.JST),
+			want:     time.Date(2024, time.October, 15, 9, 0, 0, 0, holiday.JST),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Wrap(tt.schedule, tt.policy).Next(tt.now)
+			if !got.Equal(tt.want) {
+				t.Errorf("want %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// checkInterval is the interval to re-check the wall clock.
+// Timers are based on the monotonic clock, which doesn't follow changes of the wall clock
+// and may stop while the machine is sleeping.
+// Ticker wakes up periodically and compares the wall clock with the target time.
+const checkInterval = time.Minute
+
+// Ticker delivers the time at 00:00 JST of each holiday or business day.
+type Ticker struct {
+	// C is the channel on which the ticks are delivered.
+	C <-chan time.Time
+
+	stop chan struct{}
+}
+
+// NewHolidayTicker returns a new Ticker that fires at 00:00 JST of each holiday.
+func NewHolidayTicker() *Ticker {
+	return newTicker(nextHoliday, checkInterval)
+}
+
+// NewBusinessDayTicker returns a new Ticker that fires at 00:00 JST of each business day.
+func NewBusinessDayTicker() *Ticker {
+	return newTicker(nextBusinessDay, checkInterval)
+}
+
+// Stop turns off the ticker. Stop does not close the channel.
+func (t *Ticker) Stop() {
+	close(t.stop)
+}
+
+func newTicker(next func(time.Time) time.Time, interval time.Duration) *Ticker {
+	c := make(chan time.Time, 1)
+	t := &Ticker{
+		C:    c,
+		stop: make(chan struct{}),
+	}
+	go t.run(c, next, interval)
+	return t
+}
+
+func (t *Ticker) run(c chan<- time.Time, next func(time.Time) time.Time, interval time.Duration) {
+	target := next(time.Now())
+	for {
+		// strip the monotonic clock reading to compare the wall clock.
+		now := time.Now().Round(0)
+		if !now.Before(target) {
+			select {
+			case c <- target:
+			default:
+				// drop the tick for a slow receiver, like time.Ticker does.
+			}
+			target = next(now)
+			continue
+		}
+
+		wait := target.Sub(now)
+		if wait > interval {
+			wait = interval
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-t.stop:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// nextHoliday returns 00:00 JST of the next holiday after t.
+func nextHoliday(t time.Time) time.Time {
+	d := holiday.UntilNextHoliday(t)
+	if d <= 0 {
+		// no holiday found. check again tomorrow.
+		d = 24 * time.Hour
+	}
+	return t.Add(d)
+}
+
+// nextBusinessDay returns 00:00 JST of the next business day after t.
+func nextBusinessDay(t time.Time) time.Time {
+	t = t.In(holiday.JST)
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, holiday.JST)
+	return holiday.NextBusinessDay(midnight)
+}
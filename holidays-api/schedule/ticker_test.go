@@ -0,0 +1,45 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestTicker(t *testing.T) {
+	next := func(t time.Time) time.Time {
+		return t.Add(10 * time.Millisecond)
+	}
+	ticker := newTicker(next, time.Millisecond)
+	defer ticker.Stop()
+
+	var prev time.Time
+	for i := 0; i < 3; i++ {
+		select {
+		case tick := <-ticker.C:
+			if !tick.After(prev) {
+				t.Errorf("ticks must increase: %s, %s", prev, tick)
+			}
+			prev = tick
+		case <-time.After(time.Second):
+			t.Fatal("timeout")
+		}
+	}
+}
+
+func TestNextHoliday(t *testing.T) {
+	got := nextHoliday(time.Date(2024, time.August, 9, 12, 0, 0, 0, holiday.JST))
+	want := time.Date(2024, time.August, 11, 0, 0, 0, 0, holiday.JST)
+	if !got.Equal(want) {
+		t.Errorf("want %s, got %s", want, got)
+	}
+}
+
+func TestNextBusinessDay(t *testing.T) {
+	got := nextBusinessDay(time.Date(2024, time.August, 9, 12, 0, 0, 0, holiday.JST))
+	want := time.Date(2024, time.August, 13, 0, 0, 0, 0, holiday.JST)
+	if !got.Equal(want) {
+		t.Errorf("want %s, got %s", want, got)
+	}
+}
@@ -0,0 +1,96 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+	"github.com/shogo82148/holidays-jp/holidays-api/sun"
+)
+
+// SunResponse is the response of the sun endpoint.
+type SunResponse struct {
+	Date      string  `json:"date"`
+	Latitude  float64 `json:"lat"`
+	Longitude float64 `json:"lng"`
+
+	// Sunrise, Culmination, and Sunset are in RFC 3339 in JST.
+	Sunrise     string `json:"sunrise"`
+	Culmination string `json:"culmination"`
+	Sunset      string `json:"sunset"`
+
+	// DayLength is the length of the daytime in seconds.
+	DayLength int64 `json:"day_length"`
+
+	// The start and the end of the civil and nautical twilights in RFC 3339 in JST.
+	CivilDawn    string `json:"civil_dawn"`
+	CivilDusk    string `json:"civil_dusk"`
+	NauticalDawn string `json:"nautical_dawn"`
+	NauticalDusk string `json:"nautical_dusk"`
+}
+
+func (h *Handler) sun(w http.ResponseWriter, d string, u *url.URL) *apiError {
+	date, err := holiday.ParseDate(d)
+	if err != nil {
+		return invalidDate("date", d)
+	}
+
+	q := u.Query()
+	place := sun.Tokyo
+	if q.Has("lat") || q.Has("lng") {
+		lat, err1 := strconv.ParseFloat(q.Get("lat"), 64)
+		lng, err2 := strconv.ParseFloat(q.Get("lng"), 64)
+		if err1 != nil || err2 != nil {
+			return invalidParameter("lat %q and lng %q must be numbers in degrees", q.Get("lat"), q.Get("lng"))
+		}
+		place = sun.Place{Latitude: lat, Longitude: lng}
+	}
+	times, err := sun.On(date, place)
+	if errors.Is(err, sun.ErrOutOfJapan) {
+		return invalidParameter("lat %g and lng %g must be in Japan", place.Latitude, place.Longitude)
+	} else if err != nil {
+		h.responseInternalServerError(w, err)
+		return nil
+	}
+	civil, err := sun.CivilTwilight(date, place)
+	if err != nil {
+		h.responseInternalServerError(w, err)
+		return nil
+	}
+	nautical, err := sun.NauticalTwilight(date, place)
+	if err != nil {
+		h.responseInternalServerError(w, err)
+		return nil
+	}
+
+	res := SunResponse{
+		Date:         date.String(),
+		Latitude:     place.Latitude,
+		Longitude:    place.Longitude,
+		Sunrise:      times.Sunrise.Format(time.RFC3339),
+		Culmination:  times.Culmination.Format(time.RFC3339),
+		Sunset:       times.Sunset.Format(time.RFC3339),
+		DayLength:    int64(times.DayLength() / time.Second),
+		CivilDawn:    civil.Dawn.Format(time.RFC3339),
+		CivilDusk:    civil.Dusk.Format(time.RFC3339),
+		NauticalDawn: nautical.Dawn.Format(time.RFC3339),
+		NauticalDusk: nautical.Dusk.Format(time.RFC3339),
+	}
+	data, err := json.Marshal(res)
+	if err != nil {
+		h.responseInternalServerError(w, err)
+		return nil
+	}
+
+	// the calculation never changes.
+	setCommonHeaders(w, 365*24*time.Hour)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+	return nil
+}
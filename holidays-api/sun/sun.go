@@ -0,0 +1,186 @@
+// Package sun calculates the times of sunrise, sunset, culmination (南中), and twilight in Japan.
+//
+// The calculation follows 長沢 工(1999) "日の出・日の入りの計算 天体の出没時刻の求め方",
+// which is the same as the equinox days of the holiday package.
+// The times are accurate to about a minute, ignoring the elevation and the terrain of the place.
+package sun
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+	"github.com/shogo82148/holidays-jp/holidays-api/internal/astro"
+)
+
+// ErrOutOfJapan is returned for the places outside Japan.
+var ErrOutOfJapan = errors.New("sun: the place is out of Japan")
+
+// the bounds of Japan, from 沖ノ鳥島 to 択捉島 and from 与那国島 to 南鳥島.
+const (
+	minLatitude  = 20
+	maxLatitude  = 46
+	minLongitude = 122
+	maxLongitude = 154
+)
+
+// refraction is the atmospheric refraction at the horizon in degrees (35'08").
+const refraction = 0.585556
+
+// Tokyo is the place of the National Astronomical Observatory of Japan's reference for 東京.
+var Tokyo = Place{Latitude: 35.6581, Longitude: 139.7414}
+
+// Place is a place on the earth.
+type Place struct {
+	// Latitude is the latitude in degrees, positive for north.
+	Latitude float64
+
+	// Longitude is the longitude in degrees, positive for east.
+	Longitude float64
+}
+
+func (p Place) validate() error {
+	if p.Latitude < minLatitude || p.Latitude > maxLatitude || p.Longitude < minLongitude || p.Longitude > maxLongitude {
+		return fmt.Errorf("%w: (%g, %g)", ErrOutOfJapan, p.Latitude, p.Longitude)
+	}
+	return nil
+}
+
+// Times are the times of the sun in a day in JST.
+type Times struct {
+	Sunrise time.Time
+
+	// Culmination is the time when the sun is at the highest, i.e. 南中.
+	Culmination time.Time
+
+	Sunset time.Time
+}
+
+// DayLength returns the length of the daytime from sunrise to sunset.
+func (t Times) DayLength() time.Duration {
+	return t.Sunset.Sub(t.Sunrise)
+}
+
+// On returns the times of the sun on the date at the place.
+// It returns ErrOutOfJapan if the place is out of Japan.
+func On(date holiday.Date, place Place) (Times, error) {
+	if err := place.validate(); err != nil {
+		return Times{}, err
+	}
+	day := time.Date(date.Year, date.Month, date.Day, 0, 0, 0, 0, holiday.JST)
+	return Times{
+		Sunrise:     find(day.Add(6*time.Hour), place, rising, horizon),
+		Culmination: find(day.Add(12*time.Hour), place, culmination, nil),
+		Sunset:      find(day.Add(18*time.Hour), place, setting, horizon),
+	}, nil
+}
+
+// DayLength returns the length of the daytime from sunrise to sunset on the date at the place.
+// It returns ErrOutOfJapan if the place is out of Japan.
+func DayLength(date holiday.Date, place Place) (time.Duration, error) {
+	times, err := On(date, place)
+	if err != nil {
+		return 0, err
+	}
+	return times.DayLength(), nil
+}
+
+// Twilight is the twilight in the morning and the evening.
+// The sky is bright enough from Dawn to Dusk for the kind of the twilight.
+type Twilight struct {
+	Dawn time.Time
+	Dusk time.Time
+}
+
+// the altitudes of the center of the sun at the start of the twilights.
+const (
+	civilTwilightAltitude    = -6
+	nauticalTwilightAltitude = -12
+)
+
+// CivilTwilight returns the civil twilight (市民薄明) on the date at the place,
+// when the center of the sun is less than 6 degrees below the horizon.
+// It is bright enough for outdoor activities without lighting.
+// It returns ErrOutOfJapan if the place is out of Japan.
+func CivilTwilight(date holiday.Date, place Place) (Twilight, error) {
+	return twilight(date, place, civilTwilightAltitude)
+}
+
+// NauticalTwilight returns the nautical twilight (航海薄明) on the date at the place,
+// when the center of the sun is less than 12 degrees below the horizon.
+// The horizon at sea is visible in it.
+// It returns ErrOutOfJapan if the place is out of Japan.
+func NauticalTwilight(date holiday.Date, place Place) (Twilight, error) {
+	return twilight(date, place, nauticalTwilightAltitude)
+}
+
+func twilight(date holiday.Date, place Place, k float64) (Twilight, error) {
+	if err := place.validate(); err != nil {
+		return Twilight{}, err
+	}
+	altitude := func(astro.JulianYear) float64 { return k }
+	day := time.Date(date.Year, date.Month, date.Day, 0, 0, 0, 0, holiday.JST)
+	return Twilight{
+		Dawn: find(day.Add(5*time.Hour), place, rising, altitude),
+		Dusk: find(day.Add(19*time.Hour), place, setting, altitude),
+	}, nil
+}
+
+// horizon returns the altitude of the center of the sun at sunrise and sunset,
+// when the upper edge of the sun is on the horizon.
+// It is corrected with the apparent radius, the refraction, and the parallax.
+func horizon(jy astro.JulianYear) float64 {
+	r := astro.SunDistance(jy)
+	return -0.266994/r - refraction + 0.0024428/r
+}
+
+type event int
+
+const (
+	rising event = iota
+	culmination
+	setting
+)
+
+// find finds the time of the event around t, when the altitude of the sun is altitude(jy).
+// altitude is not used for culmination.
+func find(t time.Time, place Place, e event, altitude func(jy astro.JulianYear) float64) time.Time {
+	for i := 0; i < 10; i++ {
+		jy := astro.JulianYearOf(t)
+		ra, dec := astro.SunEquatorial(jy)
+
+		// the hour angle of the sun now and at the event.
+		h := astro.SiderealTime(t, place.Longitude) - ra
+		var target float64
+		if e != culmination {
+			k := altitude(jy)
+			target = acos((sin(k) - sin(dec)*sin(place.Latitude)) / (cos(dec) * cos(place.Latitude)))
+			if e == rising {
+				target = -target
+			}
+		}
+
+		// the hour angle of the sun increases about 360 degrees a day.
+		diff := math.Mod(target-h+540, 360) - 180
+		step := time.Duration(diff / 360 * float64(24*time.Hour))
+		t = t.Add(step)
+		if step.Abs() < time.Second {
+			break
+		}
+	}
+	return t.Round(time.Second)
+}
+
+func sin(x float64) float64 {
+	return math.Sin(x / 180 * math.Pi)
+}
+
+func cos(x float64) float64 {
+	return math.Cos(x / 180 * math.Pi)
+}
+
+func acos(x float64) float64 {
+	return math.Acos(x) / math.Pi * 180
+}
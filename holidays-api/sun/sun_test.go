@@ -0,0 +1,132 @@
+package sun
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestOn(t *testing.T) {
+	// from the ephemeris of the National Astronomical Observatory of Japan.
+	tests := []struct {
+		date        holiday.Date
+		place       Place
+		sunrise     string
+		culmination string
+		sunset      string
+	}{
+		{holiday.Date{Year: 2025, Month: time.January, Day: 1}, Tokyo, "06:50", "11:44", "16:38"},
+		{holiday.Date{Year: 2025, Month: time.June, Day: 21}, Tokyo, "04:25", "11:43", "19:00"},
+		{holiday.Date{Year: 2025, Month: time.January, Day: 1}, Place{Latitude: 43.0621, Longitude: 141.3544}, "07:06", "11:38", "16:10"},
+		{holiday.Date{Year: 2025, Month: time.January, Day: 1}, Place{Latitude: 26.2124, Longitude: 127.6809}, "07:17", "12:33", "17:49"},
+	}
+	for _, tt := range tests {
+		got, err := On(tt.date, tt.place)
+		if err != nil {
+			t.Fatal(err)
+		}
+		check := func(name string, got time.Time, want string) {
+			t.Helper()
+			if got.Location() != holiday.JST {
+				t.Errorf("%s %s: want JST, got %s", tt.date, name, got.Location())
+			}
+			if got.Format("2006-01-02") != tt.date.String() {
+				t.Errorf("%s %s: unexpected date %s", tt.date, name, got)
+			}
+			// the ephemeris is rounded to minutes, and the calculation is accurate to about a minute.
+			w, err := time.ParseInLocation("2006-01-02 15:04", tt.date.String()+" "+want, holiday.JST)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d := got.Sub(w).Abs(); d > time.Minute {
+				t.Errorf("%s %s: want %s, got %s", tt.date, name, want, got.Format("15:04:05"))
+			}
+		}
+		check("sunrise", got.Sunrise, tt.sunrise)
+		check("culmination", got.Culmination, tt.culmination)
+		check("sunset", got.Sunset, tt.sunset)
+	}
+}
+
+func TestTimes_DayLength(t *testing.T) {
+	winter, err := On(holiday.Date{Year: 2025, Month: time.December, Day: 22}, Tokyo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	summer, err := On(holiday.Date{Year: 2025, Month: time.June, Day: 21}, Tokyo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if winter.DayLength() >= summer.DayLength() {
+		t.Errorf("want %s < %s", winter.DayLength(), summer.DayLength())
+	}
+}
+
+func TestOn_OutOfJapan(t *testing.T) {
+	_, err := On(holiday.Date{Year: 2025, Month: time.January, Day: 1}, Place{Latitude: 51.4779, Longitude: 0})
+	if !errors.Is(err, ErrOutOfJapan) {
+		t.Errorf("want ErrOutOfJapan, got %v", err)
+	}
+}
+
+func TestTwilight(t *testing.T) {
+	// from the ephemeris of the National Astronomical Observatory of Japan.
+	tests := []struct {
+		date     holiday.Date
+		twilight func(holiday.Date, Place) (Twilight, error)
+		dawn     string
+		dusk     string
+	}{
+		{holiday.Date{Year: 2025, Month: time.January, Day: 1}, CivilTwilight, "06:23", "17:06"},
+		{holiday.Date{Year: 2025, Month: time.June, Day: 21}, CivilTwilight, "03:55", "19:30"},
+		{holiday.Date{Year: 2025, Month: time.January, Day: 1}, NauticalTwilight, "05:51", "17:38"},
+		{holiday.Date{Year: 2025, Month: time.June, Day: 21}, NauticalTwilight, "03:18", "20:07"},
+	}
+	for _, tt := range tests {
+		got, err := tt.twilight(tt.date, Tokyo)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, c := range []struct {
+			got  time.Time
+			want string
+		}{{got.Dawn, tt.dawn}, {got.Dusk, tt.dusk}} {
+			w, err := time.ParseInLocation("2006-01-02 15:04", tt.date.String()+" "+c.want, holiday.JST)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d := c.got.Sub(w).Abs(); d > time.Minute {
+				t.Errorf("%s: want %s, got %s", tt.date, c.want, c.got.Format("15:04:05"))
+			}
+		}
+	}
+
+	// the nautical twilight is longer than the civil twilight, which is longer than the daytime.
+	date := holiday.Date{Year: 2025, Month: time.March, Day: 20}
+	times, _ := On(date, Tokyo)
+	civil, _ := CivilTwilight(date, Tokyo)
+	nautical, _ := NauticalTwilight(date, Tokyo)
+	if !nautical.Dawn.Before(civil.Dawn) || !civil.Dawn.Before(times.Sunrise) {
+		t.Errorf("unexpected order of dawns: %s, %s, %s", nautical.Dawn, civil.Dawn, times.Sunrise)
+	}
+	if !times.Sunset.Before(civil.Dusk) || !civil.Dusk.Before(nautical.Dusk) {
+		t.Errorf("unexpected order of dusks: %s, %s, %s", times.Sunset, civil.Dusk, nautical.Dusk)
+	}
+}
+
+func TestDayLength(t *testing.T) {
+	got, err := DayLength(holiday.Date{Year: 2025, Month: time.June, Day: 21}, Tokyo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 04:25 to 19:00 in the ephemeris.
+	if want := 14*time.Hour + 35*time.Minute; (got - want).Abs() > 2*time.Minute {
+		t.Errorf("want %s, got %s", want, got)
+	}
+
+	if _, err := DayLength(holiday.Date{Year: 2025, Month: time.June, Day: 21}, Place{}); !errors.Is(err, ErrOutOfJapan) {
+		t.Errorf("want ErrOutOfJapan, got %v", err)
+	}
+}
@@ -0,0 +1,71 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestServeHTTP_Sun(t *testing.T) {
+	h := NewHandler()
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/sun/2025-01-01?lat=43.0621&lng=141.3544", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	resp := w.Result()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	var got SunResponse
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	want := SunResponse{
+		Date:         "2025-01-01",
+		Latitude:     43.0621,
+		Longitude:    141.3544,
+		Sunrise:      "2025-01-01T07:05:59+09:00",
+		Culmination:  "2025-01-01T11:38:05+09:00",
+		Sunset:       "2025-01-01T16:10:20+09:00",
+		DayLength:    32661,
+		CivilDawn:    "2025-01-01T06:33:50+09:00",
+		CivilDusk:    "2025-01-01T16:42:28+09:00",
+		NauticalDawn: "2025-01-01T05:58:05+09:00",
+		NauticalDusk: "2025-01-01T17:18:14+09:00",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("sun mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestServeHTTP_SunErrors(t *testing.T) {
+	tests := []struct {
+		url  string
+		code string
+	}{
+		{"http://example.com/sun/2025-13-01", ErrorCodeInvalidDate},
+		{"http://example.com/sun/2025-01-01?lat=north&lng=141", ErrorCodeInvalidParameter},
+		{"http://example.com/sun/2025-01-01?lat=35", ErrorCodeInvalidParameter},
+		{"http://example.com/sun/2025-01-01?lat=51.4779&lng=0", ErrorCodeInvalidParameter},
+	}
+	for _, tt := range tests {
+		h := NewHandler()
+		req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: unexpected status code: want %d, got %d", tt.url, http.StatusBadRequest, w.Code)
+		}
+		var got ErrorResponse
+		if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+			t.Fatal(err)
+		}
+		if got.Error.Code != tt.code {
+			t.Errorf("%s: unexpected error code: want %q, got %q", tt.url, tt.code, got.Error.Code)
+		}
+	}
+}
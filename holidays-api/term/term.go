@@ -0,0 +1,206 @@
+// Package term maps dates to Japanese fiscal and school terms,
+// such as 第1四半期 of the fiscal year and 1学期/2学期/3学期 of the school year.
+//
+// The fiscal and school years in Japan start in April, so 2026-03-31 is in the fiscal year 2025.
+// The boundaries of the terms are configurable by Scheme, because they vary between companies and schools.
+package term
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// Boundary is the first day of a term in every year.
+type Boundary struct {
+	// Name is the name of the term, e.g. 1学期.
+	Name string
+
+	Month time.Month
+	Day   int
+}
+
+// Scheme divides a year into terms.
+// The year starts at the first boundary, e.g. April 1st for the fiscal year,
+// and each term continues until the day before the next boundary.
+type Scheme struct {
+	boundaries []Boundary
+}
+
+// NewScheme returns a new Scheme with the boundaries.
+// The boundaries must be in chronological order from the start of the year, within a year,
+// e.g. April 1st, September 1st and January 1st for the school terms.
+// February 29th is not allowed, because it doesn't exist in every year.
+func NewScheme(boundaries ...Boundary) (*Scheme, error) {
+	if len(boundaries) == 0 {
+		return nil, fmt.Errorf("term: no boundaries")
+	}
+	prev := -1
+	for _, b := range boundaries {
+		if b.Month < time.January || b.Month > time.December || b.Day < 1 || b.Day > daysIn(b.Month) {
+			return nil, fmt.Errorf("term: invalid boundary of %s: %d-%d", b.Name, int(b.Month), b.Day)
+		}
+		offset := offsetOf(boundaries[0].Month, b.Month, b.Day)
+		if offset <= prev {
+			return nil, fmt.Errorf("term: the boundary of %s is not in chronological order", b.Name)
+		}
+		prev = offset
+	}
+	return &Scheme{boundaries: append([]Boundary(nil), boundaries...)}, nil
+}
+
+// MustNewScheme is like NewScheme but panics if the boundaries are invalid.
+func MustNewScheme(boundaries ...Boundary) *Scheme {
+	s, err := NewScheme(boundaries...)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+var (
+	// FiscalYears is the Japanese fiscal year (年度) starting on April 1st.
+	FiscalYears = MustNewScheme(
+		Boundary{Name: "年度", Month: time.April, Day: 1},
+	)
+
+	// FiscalQuarters are the quarters of the Japanese fiscal year.
+	FiscalQuarters = MustNewScheme(
+		Boundary{Name: "第1四半期", Month: time.April, Day: 1},
+		Boundary{Name: "第2四半期", Month: time.July, Day: 1},
+		Boundary{Name: "第3四半期", Month: time.October, Day: 1},
+		Boundary{Name: "第4四半期", Month: time.January, Day: 1},
+	)
+
+	// SchoolTerms are the three terms (3学期制) common in Japanese elementary and junior high schools.
+	// The vacations belong to the previous terms, e.g. the summer vacation is in 1学期.
+	SchoolTerms = MustNewScheme(
+		Boundary{Name: "1学期", Month: time.April, Day: 1},
+		Boundary{Name: "2学期", Month: time.September, Day: 1},
+		Boundary{Name: "3学期", Month: time.January, Day: 1},
+	)
+
+	// Semesters are the two terms (2学期制) common in Japanese universities.
+	Semesters = MustNewScheme(
+		Boundary{Name: "前期", Month: time.April, Day: 1},
+		Boundary{Name: "後期", Month: time.October, Day: 1},
+	)
+)
+
+// Term is a term in a year.
+type Term struct {
+	// Name is the name of the term, e.g. 1学期.
+	Name string
+
+	// Year is the year when the year of the scheme starts, e.g. 2025 for 2026-03-31 in the fiscal year.
+	Year int
+
+	// Index is the position of the term in the year, starting at 1, e.g. 1 for 第1四半期.
+	Index int
+
+	// Start and End are the first and the last day of the term (inclusive).
+	Start holiday.Date
+	End   holiday.Date
+}
+
+// String returns the year and the name of the term, e.g. "2025 1学期".
+func (t Term) String() string {
+	return fmt.Sprintf("%d %s", t.Year, t.Name)
+}
+
+// BusinessDays returns the number of the business days in the term on the calendar,
+// e.g. to compare the sales of quarters.
+func (t Term) BusinessDays(cal *holiday.Calendar) int {
+	start := time.Date(t.Start.Year, t.Start.Month, t.Start.Day, 0, 0, 0, 0, holiday.JST)
+	end := time.Date(t.End.Year, t.End.Month, t.End.Day, 0, 0, 0, 0, holiday.JST)
+	var n int
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		if cal.IsBusinessDay(d) {
+			n++
+		}
+	}
+	return n
+}
+
+// Of returns the term of the day of t in JST.
+func (s *Scheme) Of(t time.Time) Term {
+	t = t.In(holiday.JST)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	// the year starts in the year of t or the previous year.
+	year := t.Year()
+	if day.Before(s.start(year, 0)) {
+		year--
+	}
+	i := len(s.boundaries) - 1
+	for ; i > 0; i-- {
+		if !day.Before(s.start(year, i)) {
+			break
+		}
+	}
+	return s.term(year, i)
+}
+
+// Terms returns the terms in the year that starts in year.
+func (s *Scheme) Terms(year int) []Term {
+	terms := make([]Term, 0, len(s.boundaries))
+	for i := range s.boundaries {
+		terms = append(terms, s.term(year, i))
+	}
+	return terms
+}
+
+func (s *Scheme) term(year, i int) Term {
+	start := s.start(year, i)
+	var end time.Time
+	if i+1 < len(s.boundaries) {
+		end = s.start(year, i+1).AddDate(0, 0, -1)
+	} else {
+		end = s.start(year+1, 0).AddDate(0, 0, -1)
+	}
+	return Term{
+		Name:  s.boundaries[i].Name,
+		Year:  year,
+		Index: i + 1,
+		Start: dateOf(start),
+		End:   dateOf(end),
+	}
+}
+
+// start returns the first day of the i-th term in the year that starts in year.
+func (s *Scheme) start(year, i int) time.Time {
+	b := s.boundaries[i]
+	if b.Month < s.boundaries[0].Month {
+		// the term starts in the next calendar year, e.g. 3学期 in January.
+		year++
+	}
+	return time.Date(year, b.Month, b.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// FiscalYear returns the Japanese fiscal year (年度) of the day of t in JST, e.g. 2025 for 2026-03-31.
+func FiscalYear(t time.Time) int {
+	return FiscalYears.Of(t).Year
+}
+
+// FiscalQuarter returns the fiscal year and the quarter (1 to 4) of the day of t in JST,
+// e.g. 2025 and 4 for 2026-03-31.
+func FiscalQuarter(t time.Time) (year, quarter int) {
+	term := FiscalQuarters.Of(t)
+	return term.Year, term.Index
+}
+
+// offsetOf returns the position of the day in the year starting in the month, ignoring leap years.
+func offsetOf(start, month time.Month, day int) int {
+	m := (int(month) - int(start) + 12) % 12
+	return m*31 + day
+}
+
+// daysIn returns the number of days in the month of a common year.
+func daysIn(month time.Month) int {
+	return time.Date(2001, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func dateOf(t time.Time) holiday.Date {
+	return holiday.Date{Year: t.Year(), Month: t.Month(), Day: t.Day()}
+}
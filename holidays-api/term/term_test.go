@@ -0,0 +1,131 @@
+package term
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestScheme_Of(t *testing.T) {
+	tests := []struct {
+		scheme *Scheme
+		t      time.Time
+		want   Term
+	}{
+		{
+			scheme: SchoolTerms,
+			t:      time.Date(2025, time.April, 1, 0, 0, 0, 0, holiday.JST),
+			want: Term{
+				Name: "1学期", Year: 2025, Index: 1,
+				Start: holiday.Date{Year: 2025, Month: time.April, Day: 1},
+				End:   holiday.Date{Year: 2025, Month: time.August, Day: 31},
+			},
+		},
+		{
+			scheme: SchoolTerms,
+			t:      time.Date(2025, time.December, 31, 23, 59, 0, 0, holiday.JST),
+			want: Term{
+				Name: "2学期", Year: 2025, Index: 2,
+				Start: holiday.Date{Year: 2025, Month: time.September, Day: 1},
+				End:   holiday.Date{Year: 2025, Month: time.December, Day: 31},
+			},
+		},
+		{
+			// 2025-12-31 15:00 UTC is 2026-01-01 00:00 JST.
+			scheme: SchoolTerms,
+			t:      time.Date(2025, time.December, 31, 15, 0, 0, 0, time.UTC),
+			want: Term{
+				Name: "3学期", Year: 2025, Index: 3,
+				Start: holiday.Date{Year: 2026, Month: time.January, Day: 1},
+				End:   holiday.Date{Year: 2026, Month: time.March, Day: 31},
+			},
+		},
+		{
+			scheme: FiscalQuarters,
+			t:      time.Date(2024, time.March, 15, 0, 0, 0, 0, holiday.JST),
+			want: Term{
+				Name: "第4四半期", Year: 2023, Index: 4,
+				Start: holiday.Date{Year: 2024, Month: time.January, Day: 1},
+				End:   holiday.Date{Year: 2024, Month: time.March, Day: 31},
+			},
+		},
+		{
+			scheme: MustNewScheme(
+				Boundary{Name: "上期", Month: time.January, Day: 1},
+				Boundary{Name: "下期", Month: time.July, Day: 1},
+			),
+			t: time.Date(2025, time.June, 30, 0, 0, 0, 0, holiday.JST),
+			want: Term{
+				Name: "上期", Year: 2025, Index: 1,
+				Start: holiday.Date{Year: 2025, Month: time.January, Day: 1},
+				End:   holiday.Date{Year: 2025, Month: time.June, Day: 30},
+			},
+		},
+	}
+	for _, tt := range tests {
+		got := tt.scheme.Of(tt.t)
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("Of(%s) mismatch (-want/+got):\n%s", tt.t, diff)
+		}
+	}
+}
+
+func TestNewScheme(t *testing.T) {
+	tests := []struct {
+		boundaries []Boundary
+		ok         bool
+	}{
+		{[]Boundary{{Name: "a", Month: time.April, Day: 1}, {Name: "b", Month: time.March, Day: 31}}, true},
+		{[]Boundary{}, false},
+		{[]Boundary{{Name: "a", Month: time.April, Day: 1}, {Name: "b", Month: time.April, Day: 1}}, false},
+		{[]Boundary{{Name: "a", Month: time.April, Day: 1}, {Name: "b", Month: time.September, Day: 1}, {Name: "c", Month: time.May, Day: 1}}, false},
+		{[]Boundary{{Name: "a", Month: time.February, Day: 29}}, false},
+		{[]Boundary{{Name: "a", Month: 13, Day: 1}}, false},
+	}
+	for _, tt := range tests {
+		_, err := NewScheme(tt.boundaries...)
+		if (err == nil) != tt.ok {
+			t.Errorf("NewScheme(%v): want ok %t, got %v", tt.boundaries, tt.ok, err)
+		}
+	}
+}
+
+func TestFiscalQuarter(t *testing.T) {
+	tests := []struct {
+		t       time.Time
+		year    int
+		quarter int
+	}{
+		{time.Date(2025, time.April, 1, 0, 0, 0, 0, holiday.JST), 2025, 1},
+		{time.Date(2025, time.September, 30, 0, 0, 0, 0, holiday.JST), 2025, 2},
+		{time.Date(2025, time.October, 1, 0, 0, 0, 0, holiday.JST), 2025, 3},
+		{time.Date(2026, time.March, 31, 0, 0, 0, 0, holiday.JST), 2025, 4},
+	}
+	for _, tt := range tests {
+		year, quarter := FiscalQuarter(tt.t)
+		if year != tt.year || quarter != tt.quarter {
+			t.Errorf("FiscalQuarter(%s): want (%d, %d), got (%d, %d)", tt.t, tt.year, tt.quarter, year, quarter)
+		}
+		if got := FiscalYear(tt.t); got != tt.year {
+			t.Errorf("FiscalYear(%s): want %d, got %d", tt.t, tt.year, got)
+		}
+	}
+}
+
+func TestTerm_BusinessDays(t *testing.T) {
+	// 2025-04: 30 days, 8 weekend days, 昭和の日 on Tuesday
+	term := Term{
+		Start: holiday.Date{Year: 2025, Month: time.April, Day: 1},
+		End:   holiday.Date{Year: 2025, Month: time.April, Day: 30},
+	}
+	if got := term.BusinessDays(holiday.NewCalendar(holiday.Japan)); got != 21 {
+		t.Errorf("want 21, got %d", got)
+	}
+
+	terms := FiscalQuarters.Terms(2025)
+	if len(terms) != 4 || terms[3].End != (holiday.Date{Year: 2026, Month: time.March, Day: 31}) {
+		t.Errorf("unexpected terms: %v", terms)
+	}
+}
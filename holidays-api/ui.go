@@ -0,0 +1,22 @@
+package holidaysapi
+
+import (
+	_ "embed"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// indexHTML is a calendar page for humans.
+// It renders the holidays fetched from the API, and links to the CSV and the iCalendar feed.
+//
+//go:embed ui/index.html
+var indexHTML []byte
+
+func (h *Handler) index(w http.ResponseWriter) {
+	setCommonHeaders(w, 24*time.Hour)
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Length", strconv.Itoa(len(indexHTML)))
+	w.WriteHeader(http.StatusOK)
+	w.Write(indexHTML)
+}
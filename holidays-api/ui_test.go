@@ -0,0 +1,67 @@
+package holidaysapi
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServeHTTP_Index(t *testing.T) {
+	h := NewHandler()
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	resp := w.Result()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if got := resp.Header.Get("Content-Type"); got != "text/html; charset=utf-8" {
+		t.Errorf("unexpected Content-Type: %q", got)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"<title>日本の祝日カレンダー</title>", `href="holidays.ics"`, "holidays.csv?from="} {
+		if !strings.Contains(string(body), s) {
+			t.Errorf("the page doesn't contain %q", s)
+		}
+	}
+}
+
+func TestServeHTTP_CSV(t *testing.T) {
+	h := NewHandler()
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/holidays.csv?from=2021-01-01&to=2021-02-28", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	resp := w.Result()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if got := resp.Header.Get("Content-Type"); got != "text/csv; charset=utf-8; header=present" {
+		t.Errorf("unexpected Content-Type: %q", got)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "date,name,kind\n" +
+		"2021-01-01,元日,national\n" +
+		"2021-01-11,成人の日,national\n" +
+		"2021-02-11,建国記念の日,national\n" +
+		"2021-02-23,天皇誕生日,national\n"
+	if string(body) != want {
+		t.Errorf("want %q, got %q", want, body)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "http://example.com/holidays.csv?from=2021-13-01&to=2021-12-31", nil)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("unexpected status code: want %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
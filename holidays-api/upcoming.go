@@ -0,0 +1,62 @@
+package holidaysapi
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+const (
+	// defaultUpcomingCount is the number of the holidays returned by /upcoming by default.
+	defaultUpcomingCount = 5
+
+	// maxUpcomingCount is the max of the count parameter of /upcoming.
+	maxUpcomingCount = 100
+
+	// maxUpcomingDays is the max of the within parameter of /upcoming, about 10 years.
+	maxUpcomingDays = 3660
+)
+
+// upcoming serves the next holidays from today in JST, including today.
+// The count parameter limits the number of the holidays (5 by default),
+// and the within parameter limits the period, e.g. 30d for 30 days including today.
+// If only within is specified, all holidays in the period are returned.
+func (h *Handler) upcoming(w http.ResponseWriter, u *url.URL) *apiError {
+	q := u.Query()
+	count := defaultUpcomingCount
+	days := maxUpcomingDays
+	if q.Has("within") {
+		d, ok := strings.CutSuffix(q.Get("within"), "d")
+		n, err := strconv.Atoi(d)
+		if !ok || err != nil || n < 1 || n > maxUpcomingDays {
+			return invalidParameter("within %q must be a number of days between 1d and %dd", q.Get("within"), maxUpcomingDays)
+		}
+		days = n
+		count = maxUpcomingCount
+	}
+	if q.Has("count") {
+		n, err := strconv.Atoi(q.Get("count"))
+		if err != nil || n < 1 || n > maxUpcomingCount {
+			return invalidParameter("count %q must be an integer between 1 and %d", q.Get("count"), maxUpcomingCount)
+		}
+		count = n
+	}
+
+	now := h.now()
+	from := holiday.Date{Year: now.Year(), Month: now.Month(), Day: now.Day()}
+	end := now.AddDate(0, 0, days-1)
+	to := holiday.Date{Year: end.Year(), Month: end.Month(), Day: end.Day()}
+	holidays := filterByName(holiday.FindHolidaysInRange(from, to), q.Get("name"))
+	if len(holidays) > count {
+		holidays = holidays[:count]
+	}
+
+	// the holidays change at midnight in Japan.
+	midnight := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, holiday.JST)
+	h.responseHolidays(w, holidays, q, midnight.Sub(now))
+	return nil
+}
@@ -0,0 +1,114 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestServeHTTP_Upcoming(t *testing.T) {
+	h := NewHandler()
+	// 2025-04-29 12:00 JST, 昭和の日
+	h.Clock = holiday.ClockFunc(func() time.Time {
+		return time.Date(2025, time.April, 29, 3, 0, 0, 0, time.UTC)
+	})
+	tests := []struct {
+		query  string
+		status int
+		want   []Holiday
+	}{
+		{
+			query:  "",
+			status: http.StatusOK,
+			want: []Holiday{
+				{Date: "2025-04-29", Name: "昭和の日"},
+				{Date: "2025-05-03", Name: "憲法記念日"},
+				{Date: "2025-05-04", Name: "みどりの日"},
+				{Date: "2025-05-05", Name: "こどもの日"},
+				{Date: "2025-05-06", Name: "休日"},
+			},
+		},
+		{
+			query:  "?count=2",
+			status: http.StatusOK,
+			want: []Holiday{
+				{Date: "2025-04-29", Name: "昭和の日"},
+				{Date: "2025-05-03", Name: "憲法記念日"},
+			},
+		},
+		{
+			// 2025-04-29 to 2025-07-27
+			query:  "?within=90d",
+			status: http.StatusOK,
+			want: []Holiday{
+				{Date: "2025-04-29", Name: "昭和の日"},
+				{Date: "2025-05-03", Name: "憲法記念日"},
+				{Date: "2025-05-04", Name: "みどりの日"},
+				{Date: "2025-05-05", Name: "こどもの日"},
+				{Date: "2025-05-06", Name: "休日"},
+				{Date: "2025-07-21", Name: "海の日"},
+			},
+		},
+		{
+			query:  "?within=5d&count=1",
+			status: http.StatusOK,
+			want: []Holiday{
+				{Date: "2025-04-29", Name: "昭和の日"},
+			},
+		},
+		{
+			query:  "?within=3d",
+			status: http.StatusOK,
+			want: []Holiday{
+				{Date: "2025-04-29", Name: "昭和の日"},
+			},
+		},
+		{
+			query:  "?count=0",
+			status: http.StatusBadRequest,
+		},
+		{
+			query:  "?count=101",
+			status: http.StatusBadRequest,
+		},
+		{
+			query:  "?within=30",
+			status: http.StatusBadRequest,
+		},
+		{
+			query:  "?within=1w",
+			status: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "http://example.com/upcoming"+tt.query, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			resp := w.Result()
+			if resp.StatusCode != tt.status {
+				t.Fatalf("unexpected status code: want %d, got %d", tt.status, resp.StatusCode)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			// it expires at the midnight.
+			if got := resp.Header.Get("Cache-Control"); got != "max-age=43200" {
+				t.Errorf("unexpected Cache-Control: %q", got)
+			}
+			var got Response
+			if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got.Holidays); diff != "" {
+				t.Errorf("holidays mismatch (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+// Package wareki converts dates into the Japanese calendar (和暦), e.g. 令和7年5月6日.
+//
+// Only the dates since Japan adopted the Gregorian calendar (明治6年1月1日, 1873-01-01) are supported.
+package wareki
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+	"golang.org/x/text/unicode/norm"
+)
+
+// ErrOutOfRange is returned for the dates before 1873-01-01.
+var ErrOutOfRange = errors.New("wareki: the date is before the adoption of the Gregorian calendar")
+
+// ErrInvalidFormat is returned by Parse for the strings that are not dates in the Japanese calendar.
+var ErrInvalidFormat = errors.New("wareki: invalid format")
+
+// ErrInvalidDate is returned by Parse for the dates that do not exist, e.g. 令和7年2月30日 and 平成32年1月1日.
+var ErrInvalidDate = errors.New("wareki: invalid date")
+
+// Era is an era name (元号).
+type Era struct {
+	// Name is the era name in Japanese, e.g. 令和.
+	Name string
+
+	// Romaji is the era name in Latin letters, e.g. Reiwa.
+	Romaji string
+
+	// Start is the first day of the era.
+	Start holiday.Date
+}
+
+var eras = []Era{
+	{Name: "明治", Romaji: "Meiji", Start: holiday.Date{Year: 1868, Month: time.October, Day: 23}},
+	{Name: "大正", Romaji: "Taisho", Start: holiday.Date{Year: 1912, Month: time.July, Day: 30}},
+	{Name: "昭和", Romaji: "Showa", Start: holiday.Date{Year: 1926, Month: time.December, Day: 25}},
+	{Name: "平成", Romaji: "Heisei", Start: holiday.Date{Year: 1989, Month: time.January, Day: 8}},
+	{Name: "令和", Romaji: "Reiwa", Start: holiday.Date{Year: 2019, Month: time.May, Day: 1}},
+}
+
+// gregorianStart is the day when Japan adopted the Gregorian calendar.
+var gregorianStart = holiday.Date{Year: 1873, Month: time.January, Day: 1}
+
+// Eras returns the supported eras in chronological order.
+func Eras() []Era {
+	return append([]Era(nil), eras...)
+}
+
+// Date is a date in the Japanese calendar.
+type Date struct {
+	Era Era
+
+	// Year is the year of the era. The first year (元年) is 1.
+	Year int
+
+	Month time.Month
+	Day   int
+}
+
+// FromDate converts d into the Japanese calendar.
+func FromDate(d holiday.Date) (Date, error) {
+	if compare(d, gregorianStart) < 0 {
+		return Date{}, ErrOutOfRange
+	}
+	era := eras[0]
+	for _, e := range eras {
+		if compare(d, e.Start) >= 0 {
+			era = e
+		}
+	}
+	return Date{
+		Era:   era,
+		Year:  d.Year - era.Start.Year + 1,
+		Month: d.Month,
+		Day:   d.Day,
+	}, nil
+}
+
+// FromTime converts the day of t in JST into the Japanese calendar.
+func FromTime(t time.Time) (Date, error) {
+	t = t.In(holiday.JST)
+	return FromDate(holiday.Date{Year: t.Year(), Month: t.Month(), Day: t.Day()})
+}
+
+// String returns the date in the Japanese format, e.g. 令和7年5月6日.
+// The first year of an era is written as 元年, e.g. 令和元年5月1日.
+func (d Date) String() string {
+	return fmt.Sprintf("%s%s年%d月%d日", d.Era.Name, d.YearString(), d.Month, d.Day)
+}
+
+// YearString returns the year of the era, or 元 for the first year.
+func (d Date) YearString() string {
+	if d.Year == 1 {
+		return "元"
+	}
+	return fmt.Sprint(d.Year)
+}
+
+var (
+	// e.g. 7年1月1日 and 元年5月1日
+	reKanjiDate = regexp.MustCompile(`^(元|[0-9]{1,2})年([0-9]{1,2})月([0-9]{1,2})日$`)
+
+	// e.g. 7.1.1, 07/01/01 and 7-1-1
+	reNumericDate = regexp.MustCompile(`^([0-9]{1,2})([./-])([0-9]{1,2})([./-])([0-9]{1,2})$`)
+)
+
+// Parse parses a date in the Japanese calendar, e.g. 令和7年1月1日, 令和元年5月1日 and R7.1.1.
+// The era is the name in Japanese, or the first letter of the romaji (M, T, S, H and R).
+// The year, the month and the day are separated by 年月日, ".", "/" or "-".
+// Full-width letters and digits are also accepted, e.g. Ｒ７．１．１.
+//
+// It returns ErrInvalidFormat if s is not in the formats,
+// ErrInvalidDate if the date does not exist in the era, and ErrOutOfRange for the dates before 1873.
+func Parse(s string) (holiday.Date, error) {
+	s = strings.TrimSpace(norm.NFKC.String(s))
+
+	var era Era
+	var rest string
+	var found bool
+	for _, e := range eras {
+		if r, ok := strings.CutPrefix(s, e.Name); ok {
+			era, rest, found = e, r, true
+			break
+		}
+		if len(s) > 0 && strings.EqualFold(s[:1], e.Romaji[:1]) {
+			era, rest, found = e, s[1:], true
+			break
+		}
+	}
+	if !found {
+		return holiday.Date{}, ErrInvalidFormat
+	}
+
+	var y, m, d string
+	if match := reKanjiDate.FindStringSubmatch(rest); match != nil {
+		y, m, d = match[1], match[2], match[3]
+	} else if match := reNumericDate.FindStringSubmatch(rest); match != nil && match[2] == match[4] {
+		y, m, d = match[1], match[3], match[5]
+	} else {
+		return holiday.Date{}, ErrInvalidFormat
+	}
+
+	year := 1
+	if y != "元" {
+		year, _ = strconv.Atoi(y)
+	}
+	month, _ := strconv.Atoi(m)
+	day, _ := strconv.Atoi(d)
+	if year < 1 || month < 1 || month > 12 || day < 1 {
+		return holiday.Date{}, ErrInvalidDate
+	}
+	date := holiday.Date{Year: era.Start.Year + year - 1, Month: time.Month(month), Day: day}
+	if t := time.Date(date.Year, date.Month, date.Day, 0, 0, 0, 0, time.UTC); t.Day() != day {
+		return holiday.Date{}, ErrInvalidDate
+	}
+	if compare(date, gregorianStart) < 0 {
+		return holiday.Date{}, ErrOutOfRange
+	}
+
+	// the date must be in the era, e.g. 平成31年5月1日 is 令和元年5月1日.
+	if w, _ := FromDate(date); w.Era.Name != era.Name {
+		return holiday.Date{}, ErrInvalidDate
+	}
+	return date, nil
+}
+
+// ParseDate parses a date in the Japanese calendar by Parse,
+// or in the Gregorian calendar by holiday.ParseDate, e.g. 令和7年1月1日, R7.1.1 and 2025-01-01.
+func ParseDate(s string) (holiday.Date, error) {
+	if d, err := Parse(s); err == nil || !errors.Is(err, ErrInvalidFormat) {
+		return d, err
+	}
+	return holiday.ParseDate(s)
+}
+
+func compare(a, b holiday.Date) int {
+	switch {
+	case a.Year != b.Year:
+		return a.Year - b.Year
+	case a.Month != b.Month:
+		return int(a.Month - b.Month)
+	default:
+		return a.Day - b.Day
+	}
+}
@@ -0,0 +1,127 @@
+package wareki
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestFromDate(t *testing.T) {
+	tests := []struct {
+		date holiday.Date
+		want string
+	}{
+		{holiday.Date{Year: 1873, Month: time.January, Day: 1}, "明治6年1月1日"},
+		{holiday.Date{Year: 1912, Month: time.July, Day: 29}, "明治45年7月29日"},
+		{holiday.Date{Year: 1912, Month: time.July, Day: 30}, "大正元年7月30日"},
+		{holiday.Date{Year: 1926, Month: time.December, Day: 25}, "昭和元年12月25日"},
+		{holiday.Date{Year: 1989, Month: time.January, Day: 7}, "昭和64年1月7日"},
+		{holiday.Date{Year: 1989, Month: time.January, Day: 8}, "平成元年1月8日"},
+		{holiday.Date{Year: 2019, Month: time.April, Day: 30}, "平成31年4月30日"},
+		{holiday.Date{Year: 2019, Month: time.May, Day: 1}, "令和元年5月1日"},
+		{holiday.Date{Year: 2025, Month: time.May, Day: 6}, "令和7年5月6日"},
+	}
+	for _, tt := range tests {
+		got, err := FromDate(tt.date)
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", tt.date, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("%v: want %s, got %s", tt.date, tt.want, got)
+		}
+	}
+
+	if _, err := FromDate(holiday.Date{Year: 1872, Month: time.December, Day: 31}); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("want ErrOutOfRange, got %v", err)
+	}
+}
+
+func TestFromTime(t *testing.T) {
+	// 2019-04-30 15:00 UTC is 2019-05-01 00:00 JST.
+	got, err := FromTime(time.Date(2019, time.April, 30, 15, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Era.Name != "令和" || got.Year != 1 {
+		t.Errorf("want 令和元年, got %s", got)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  holiday.Date
+	}{
+		{"令和7年1月1日", holiday.Date{Year: 2025, Month: time.January, Day: 1}},
+		{"令和元年5月1日", holiday.Date{Year: 2019, Month: time.May, Day: 1}},
+		{"平成31年4月30日", holiday.Date{Year: 2019, Month: time.April, Day: 30}},
+		{"明治6年1月1日", holiday.Date{Year: 1873, Month: time.January, Day: 1}},
+		{"R7.1.1", holiday.Date{Year: 2025, Month: time.January, Day: 1}},
+		{"r07/01/01", holiday.Date{Year: 2025, Month: time.January, Day: 1}},
+		{"H31-4-30", holiday.Date{Year: 2019, Month: time.April, Day: 30}},
+		{"S64.1.7", holiday.Date{Year: 1989, Month: time.January, Day: 7}},
+		{"令和7.1.1", holiday.Date{Year: 2025, Month: time.January, Day: 1}},
+		// full-width letters and digits
+		{"Ｒ７．１．１", holiday.Date{Year: 2025, Month: time.January, Day: 1}},
+		{"令和７年１月１日", holiday.Date{Year: 2025, Month: time.January, Day: 1}},
+		// U+337B SQUARE ERA NAME HEISEI
+		{"㍻元年1月8日", holiday.Date{Year: 1989, Month: time.January, Day: 8}},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.input)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: want %v, got %v", tt.input, tt.want, got)
+		}
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		input string
+		want  error
+	}{
+		{"2025-01-01", ErrInvalidFormat},
+		{"令和7年1月", ErrInvalidFormat},
+		{"R7.1/1", ErrInvalidFormat},
+		{"X7.1.1", ErrInvalidFormat},
+		{"令和7年2月30日", ErrInvalidDate},
+		{"令和0年1月1日", ErrInvalidDate},
+		// 平成 ended on 2019-04-30.
+		{"平成31年5月1日", ErrInvalidDate},
+		// 令和 started on 2019-05-01.
+		{"令和元年4月30日", ErrInvalidDate},
+		{"明治5年12月31日", ErrOutOfRange},
+	}
+	for _, tt := range tests {
+		if _, err := Parse(tt.input); !errors.Is(err, tt.want) {
+			t.Errorf("%s: want %v, got %v", tt.input, tt.want, err)
+		}
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	for _, input := range []string{"令和7年1月1日", "R7.1.1", "2025-01-01", "2025/1/1"} {
+		got, err := ParseDate(input)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", input, err)
+			continue
+		}
+		if want := (holiday.Date{Year: 2025, Month: time.January, Day: 1}); got != want {
+			t.Errorf("%s: want %v, got %v", input, want, got)
+		}
+	}
+
+	if _, err := ParseDate("平成31年5月1日"); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("want ErrInvalidDate, got %v", err)
+	}
+	if _, err := ParseDate("2025-02-30"); !errors.Is(err, holiday.ErrInvalidDate) {
+		t.Errorf("want holiday.ErrInvalidDate, got %v", err)
+	}
+}
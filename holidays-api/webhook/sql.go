@@ -0,0 +1,202 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// SQLStore is a Store that persists the subscriptions and the delivery logs to a SQL database,
+// so they survive restarts and can be shared by multiple processes.
+// The queries are written for SQLite.
+// The database contains the secrets, so restrict the access to it.
+type SQLStore struct {
+	db *sql.DB
+}
+
+var _ Store = (*SQLStore)(nil)
+
+const sqlStoreSchema = `
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+	id           TEXT PRIMARY KEY,
+	url          TEXT NOT NULL,
+	secret       TEXT NOT NULL,
+	lead_days    INTEGER NOT NULL,
+	template     TEXT NOT NULL,
+	content_type TEXT NOT NULL,
+	created_at   TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+	seq             INTEGER PRIMARY KEY AUTOINCREMENT,
+	id              TEXT NOT NULL,
+	subscription_id TEXT NOT NULL,
+	holiday_date    TEXT NOT NULL,
+	attempt         INTEGER NOT NULL,
+	status_code     INTEGER NOT NULL,
+	error           TEXT NOT NULL,
+	success         INTEGER NOT NULL,
+	permanent       INTEGER NOT NULL,
+	time            TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_subscription_id ON webhook_deliveries (subscription_id, seq);
+`
+
+// NewSQLStore returns a new SQLStore that persists the data to db.
+// The tables are created if they don't exist.
+func NewSQLStore(ctx context.Context, db *sql.DB) (*SQLStore, error) {
+	if _, err := db.ExecContext(ctx, sqlStoreSchema); err != nil {
+		return nil, err
+	}
+	return &SQLStore{db: db}, nil
+}
+
+// Subscriptions implements Store.
+func (s *SQLStore) Subscriptions(ctx context.Context) ([]Subscription, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		"SELECT id, url, secret, lead_days, template, content_type, created_at FROM webhook_subscriptions ORDER BY created_at, id",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subs []Subscription
+	for rows.Next() {
+		var sub Subscription
+		var createdAt string
+		if err := rows.Scan(&sub.ID, &sub.URL, &sub.Secret, &sub.LeadDays, &sub.Template, &sub.ContentType, &createdAt); err != nil {
+			return nil, err
+		}
+		sub.CreatedAt, err = parseSQLTime(createdAt)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, sub)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return subs, nil
+}
+
+// AddSubscription implements Store.
+// If sub.ID is empty, a random ID is assigned.
+func (s *SQLStore) AddSubscription(ctx context.Context, sub Subscription) error {
+	if sub.ID == "" {
+		sub.ID = NewID()
+	}
+	_, err := s.db.ExecContext(
+		ctx,
+		"INSERT INTO webhook_subscriptions (id, url, secret, lead_days, template, content_type, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		sub.ID, sub.URL, sub.Secret, sub.LeadDays, sub.Template, sub.ContentType, formatSQLTime(sub.CreatedAt),
+	)
+	return err
+}
+
+// UpdateSubscription implements Store.
+func (s *SQLStore) UpdateSubscription(ctx context.Context, sub Subscription) error {
+	result, err := s.db.ExecContext(
+		ctx,
+		"UPDATE webhook_subscriptions SET url = ?, secret = ?, lead_days = ?, template = ?, content_type = ?, created_at = ? WHERE id = ?",
+		sub.URL, sub.Secret, sub.LeadDays, sub.Template, sub.ContentType, formatSQLTime(sub.CreatedAt), sub.ID,
+	)
+	if err != nil {
+		return err
+	}
+	return checkAffected(result)
+}
+
+// DeleteSubscription implements Store.
+func (s *SQLStore) DeleteSubscription(ctx context.Context, id string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, "DELETE FROM webhook_subscriptions WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+	if err := checkAffected(result); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM webhook_deliveries WHERE subscription_id = ?", id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// RecordDelivery implements Store.
+// It keeps the latest MaxDeliveries logs of the subscription.
+func (s *SQLStore) RecordDelivery(ctx context.Context, delivery Delivery) error {
+	_, err := s.db.ExecContext(
+		ctx,
+		"INSERT INTO webhook_deliveries (id, subscription_id, holiday_date, attempt, status_code, error, success, permanent, time) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		delivery.ID, delivery.SubscriptionID, delivery.HolidayDate, delivery.Attempt,
+		delivery.StatusCode, delivery.Error, delivery.Success, delivery.Permanent, formatSQLTime(delivery.Time),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(
+		ctx,
+		"DELETE FROM webhook_deliveries WHERE subscription_id = ? AND seq NOT IN (SELECT seq FROM webhook_deliveries WHERE subscription_id = ? ORDER BY seq DESC LIMIT ?)",
+		delivery.SubscriptionID, delivery.SubscriptionID, MaxDeliveries,
+	)
+	return err
+}
+
+// Deliveries implements Store.
+func (s *SQLStore) Deliveries(ctx context.Context, subscriptionID string) ([]Delivery, error) {
+	rows, err := s.db.QueryContext(
+		ctx,
+		"SELECT id, subscription_id, holiday_date, attempt, status_code, error, success, permanent, time FROM webhook_deliveries WHERE subscription_id = ? ORDER BY seq",
+		subscriptionID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []Delivery
+	for rows.Next() {
+		var d Delivery
+		var t string
+		if err := rows.Scan(&d.ID, &d.SubscriptionID, &d.HolidayDate, &d.Attempt, &d.StatusCode, &d.Error, &d.Success, &d.Permanent, &t); err != nil {
+			return nil, err
+		}
+		d.Time, err = parseSQLTime(t)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+func checkAffected(result sql.Result) error {
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// sqlTimeLayout has the fixed width, so that the formatted times in UTC sort in chronological order.
+const sqlTimeLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+func formatSQLTime(t time.Time) string {
+	return t.UTC().Format(sqlTimeLayout)
+}
+
+func parseSQLTime(s string) (time.Time, error) {
+	return time.Parse(sqlTimeLayout, s)
+}
@@ -0,0 +1,150 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	_ "modernc.org/sqlite"
+)
+
+func TestSQLStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "holidays.db")
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	s, err := NewSQLStore(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sub := Subscription{
+		ID:        "sub",
+		URL:       "https://example.com/hook",
+		Secret:    "secret",
+		LeadDays:  1,
+		Template:  `{"text":{{json .Text}}}`,
+		CreatedAt: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	if err := s.AddSubscription(ctx, sub); err != nil {
+		t.Fatal(err)
+	}
+	deliveries := []Delivery{
+		{
+			ID:             "delivery1",
+			SubscriptionID: "sub",
+			HolidayDate:    "2024-01-08",
+			Attempt:        1,
+			StatusCode:     500,
+			Error:          "unexpected status code: 500",
+			Time:           time.Date(2024, time.January, 7, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:             "delivery2",
+			SubscriptionID: "sub",
+			HolidayDate:    "2024-01-08",
+			Attempt:        2,
+			StatusCode:     200,
+			Success:        true,
+			Time:           time.Date(2024, time.January, 7, 0, 0, 1, 500, time.UTC),
+		},
+		{
+			ID:             "delivery3",
+			SubscriptionID: "sub",
+			HolidayDate:    "2024-02-11",
+			Attempt:        1,
+			Error:          "webhook: invalid template",
+			Permanent:      true,
+			Time:           time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, d := range deliveries {
+		if err := s.RecordDelivery(ctx, d); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sub.Secret = "rotated"
+	if err := s.UpdateSubscription(ctx, sub); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.UpdateSubscription(ctx, Subscription{ID: "unknown"}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("want ErrNotFound, got %v", err)
+	}
+
+	// the data survives restarts.
+	s, err = NewSQLStore(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	subs, err := s.Subscriptions(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]Subscription{sub}, subs); diff != "" {
+		t.Errorf("subscriptions mismatch (-want/+got):\n%s", diff)
+	}
+	got, err := s.Deliveries(ctx, "sub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(deliveries, got); diff != "" {
+		t.Errorf("deliveries mismatch (-want/+got):\n%s", diff)
+	}
+
+	if err := s.DeleteSubscription(ctx, "sub"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.DeleteSubscription(ctx, "sub"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("want ErrNotFound, got %v", err)
+	}
+	subs, err = s.Subscriptions(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subs) != 0 {
+		t.Errorf("want no subscriptions, got %v", subs)
+	}
+	got, err = s.Deliveries(ctx, "sub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Errorf("want no deliveries, got %v", got)
+	}
+}
+
+func TestSQLStore_MaxDeliveries(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "holidays.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	s, err := NewSQLStore(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < MaxDeliveries+10; i++ {
+		if err := s.RecordDelivery(ctx, Delivery{SubscriptionID: "sub", Attempt: i}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	deliveries, err := s.Deliveries(ctx, "sub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != MaxDeliveries {
+		t.Fatalf("want %d deliveries, got %d", MaxDeliveries, len(deliveries))
+	}
+	if deliveries[0].Attempt != 10 {
+		t.Errorf("want the latest deliveries, got the first attempt %d", deliveries[0].Attempt)
+	}
+}
@@ -0,0 +1,254 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+)
+
+// ErrNotFound is returned when the subscription is not found.
+var ErrNotFound = errors.New("webhook: subscription not found")
+
+// Store stores subscriptions and delivery logs.
+type Store interface {
+	// Subscriptions returns all subscriptions.
+	Subscriptions(ctx context.Context) ([]Subscription, error)
+
+	// AddSubscription adds a new subscription.
+	AddSubscription(ctx context.Context, sub Subscription) error
+
+	// UpdateSubscription replaces the subscription that has the same ID as sub.
+	// It returns ErrNotFound if no such subscription exists.
+	UpdateSubscription(ctx context.Context, sub Subscription) error
+
+	// DeleteSubscription deletes the subscription.
+	DeleteSubscription(ctx context.Context, id string) error
+
+	// RecordDelivery appends the delivery log.
+	// Implementations may drop the old logs; the stores in this package keep
+	// the latest MaxDeliveries logs of each subscription.
+	RecordDelivery(ctx context.Context, delivery Delivery) error
+
+	// Deliveries returns the delivery logs of the subscription in order of recording.
+	Deliveries(ctx context.Context, subscriptionID string) ([]Delivery, error)
+}
+
+// MaxDeliveries is the number of the delivery logs that the stores in this package keep for each subscription.
+// It is much larger than Dispatcher.MaxAttemptsPerHoliday by default,
+// so the logs of the holiday being dispatched are never dropped.
+const MaxDeliveries = 100
+
+// MemoryStore is a Store that keeps everything in memory.
+type MemoryStore struct {
+	mu            sync.Mutex
+	subscriptions []Subscription
+	deliveries    map[string][]Delivery
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore returns a new MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		deliveries: map[string][]Delivery{},
+	}
+}
+
+// Subscriptions implements Store.
+func (s *MemoryStore) Subscriptions(ctx context.Context) ([]Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.subscriptions), nil
+}
+
+// AddSubscription implements Store.
+// If sub.ID is empty, a random ID is assigned.
+func (s *MemoryStore) AddSubscription(ctx context.Context, sub Subscription) error {
+	if sub.ID == "" {
+		sub.ID = NewID()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscriptions = append(s.subscriptions, sub)
+	return nil
+}
+
+// UpdateSubscription implements Store.
+func (s *MemoryStore) UpdateSubscription(ctx context.Context, sub Subscription) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	idx := slices.IndexFunc(s.subscriptions, func(v Subscription) bool {
+		return v.ID == sub.ID
+	})
+	if idx < 0 {
+		return ErrNotFound
+	}
+	s.subscriptions[idx] = sub
+	return nil
+}
+
+// DeleteSubscription implements Store.
+func (s *MemoryStore) DeleteSubscription(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	idx := slices.IndexFunc(s.subscriptions, func(sub Subscription) bool {
+		return sub.ID == id
+	})
+	if idx < 0 {
+		return ErrNotFound
+	}
+	s.subscriptions = slices.Delete(s.subscriptions, idx, idx+1)
+	delete(s.deliveries, id)
+	return nil
+}
+
+// RecordDelivery implements Store.
+// It keeps the latest MaxDeliveries logs of the subscription.
+func (s *MemoryStore) RecordDelivery(ctx context.Context, delivery Delivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	deliveries := append(s.deliveries[delivery.SubscriptionID], delivery)
+	if len(deliveries) > MaxDeliveries {
+		deliveries = slices.Delete(deliveries, 0, len(deliveries)-MaxDeliveries)
+	}
+	s.deliveries[delivery.SubscriptionID] = deliveries
+	return nil
+}
+
+// Deliveries implements Store.
+func (s *MemoryStore) Deliveries(ctx context.Context, subscriptionID string) ([]Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.deliveries[subscriptionID]), nil
+}
+
+// FileStore is a Store that persists the subscriptions and the delivery logs to a JSON file,
+// so they survive restarts.
+// The whole file is rewritten atomically on every change, including every delivery attempt,
+// so it is intended for small deployments with a single process and a few subscriptions.
+// Use SQLStore for more.
+// The file contains the secrets, so it is readable only by the owner.
+type FileStore struct {
+	path string
+
+	mu  sync.Mutex
+	mem *MemoryStore
+}
+
+var _ Store = (*FileStore)(nil)
+
+type storeFile struct {
+	Subscriptions []Subscription        `json:"subscriptions"`
+	Deliveries    map[string][]Delivery `json:"deliveries"`
+}
+
+// NewFileStore returns a new FileStore that persists the data to the file at path.
+// The data in the file is loaded if it exists.
+func NewFileStore(path string) (*FileStore, error) {
+	s := &FileStore{
+		path: path,
+		mem:  NewMemoryStore(),
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var f storeFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+	s.mem.subscriptions = f.Subscriptions
+	for k, v := range f.Deliveries {
+		s.mem.deliveries[k] = v
+	}
+	return s, nil
+}
+
+// Subscriptions implements Store.
+func (s *FileStore) Subscriptions(ctx context.Context) ([]Subscription, error) {
+	return s.mem.Subscriptions(ctx)
+}
+
+// AddSubscription implements Store.
+// If sub.ID is empty, a random ID is assigned.
+func (s *FileStore) AddSubscription(ctx context.Context, sub Subscription) error {
+	return s.update(func() error {
+		return s.mem.AddSubscription(ctx, sub)
+	})
+}
+
+// UpdateSubscription implements Store.
+func (s *FileStore) UpdateSubscription(ctx context.Context, sub Subscription) error {
+	return s.update(func() error {
+		return s.mem.UpdateSubscription(ctx, sub)
+	})
+}
+
+// DeleteSubscription implements Store.
+func (s *FileStore) DeleteSubscription(ctx context.Context, id string) error {
+	return s.update(func() error {
+		return s.mem.DeleteSubscription(ctx, id)
+	})
+}
+
+// RecordDelivery implements Store.
+func (s *FileStore) RecordDelivery(ctx context.Context, delivery Delivery) error {
+	return s.update(func() error {
+		return s.mem.RecordDelivery(ctx, delivery)
+	})
+}
+
+// Deliveries implements Store.
+func (s *FileStore) Deliveries(ctx context.Context, subscriptionID string) ([]Delivery, error) {
+	return s.mem.Deliveries(ctx, subscriptionID)
+}
+
+// update applies fn to the data in memory, and saves the result to the file.
+func (s *FileStore) update(fn func() error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := fn(); err != nil {
+		return err
+	}
+
+	s.mem.mu.Lock()
+	f := storeFile{
+		Subscriptions: slices.Clone(s.mem.subscriptions),
+		Deliveries:    make(map[string][]Delivery, len(s.mem.deliveries)),
+	}
+	for k, v := range s.mem.deliveries {
+		f.Deliveries[k] = slices.Clone(v)
+	}
+	s.mem.mu.Unlock()
+	return s.save(f)
+}
+
+func (s *FileStore) save(f storeFile) error {
+	data, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), ".webhooks-*.json")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
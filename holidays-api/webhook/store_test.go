@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "webhooks.json")
+
+	s, err := NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sub := Subscription{
+		ID:        "sub",
+		URL:       "https://example.com/hook",
+		Secret:    "secret",
+		LeadDays:  1,
+		CreatedAt: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+	if err := s.AddSubscription(ctx, sub); err != nil {
+		t.Fatal(err)
+	}
+	delivery := Delivery{
+		ID:             "delivery",
+		SubscriptionID: "sub",
+		HolidayDate:    "2024-01-08",
+		Attempt:        1,
+		StatusCode:     200,
+		Success:        true,
+		Time:           time.Date(2024, time.January, 7, 0, 0, 0, 0, time.UTC),
+	}
+	if err := s.RecordDelivery(ctx, delivery); err != nil {
+		t.Fatal(err)
+	}
+	sub.Secret = "rotated"
+	if err := s.UpdateSubscription(ctx, sub); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.UpdateSubscription(ctx, Subscription{ID: "unknown"}); !errors.Is(err, ErrNotFound) {
+		t.Errorf("want ErrNotFound, got %v", err)
+	}
+
+	// the data survives restarts.
+	s, err = NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	subs, err := s.Subscriptions(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]Subscription{sub}, subs); diff != "" {
+		t.Errorf("subscriptions mismatch (-want/+got):\n%s", diff)
+	}
+	deliveries, err := s.Deliveries(ctx, "sub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]Delivery{delivery}, deliveries); diff != "" {
+		t.Errorf("deliveries mismatch (-want/+got):\n%s", diff)
+	}
+
+	if err := s.DeleteSubscription(ctx, "sub"); err != nil {
+		t.Fatal(err)
+	}
+	s, err = NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	subs, err = s.Subscriptions(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subs) != 0 {
+		t.Errorf("want no subscriptions, got %v", subs)
+	}
+}
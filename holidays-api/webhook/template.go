@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+	"github.com/shogo82148/holidays-jp/holidays-api/notify"
+)
+
+// TemplateData is the data model of the payload templates of the subscriptions.
+// The fields of Payload are available as they are, e.g. {{.Holiday.Name}} and {{.LeadDays}}.
+type TemplateData struct {
+	Payload
+
+	// Text is the reminder in Japanese, e.g. "明日は山の日です".
+	Text string
+
+	// TextEn is the reminder in English, e.g. "Tomorrow is 山の日 (Mountain Day)."
+	TextEn string
+}
+
+// templateFuncs are the functions available in the payload templates.
+var templateFuncs = template.FuncMap{
+	// json encodes the value as JSON, e.g. {"text": {{json .Text}}}.
+	"json": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	},
+}
+
+// ParseTemplate parses the payload template of a subscription.
+// It is a text/template over TemplateData with the function json, which encodes a value as JSON.
+// For example, the template for Microsoft Teams, Mattermost and Slack is:
+//
+//	{"text": {{json .Text}}}
+func ParseTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("payload").Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("webhook: invalid template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// ValidateTemplate reports an error if the template can't be parsed,
+// or fails to render a sample payload, e.g. because it refers to an unknown field.
+func ValidateTemplate(text string) error {
+	tmpl, err := ParseTemplate(text)
+	if err != nil {
+		return err
+	}
+	sample := Payload{
+		ID:             "sample",
+		SubscriptionID: "sample",
+		LeadDays:       1,
+		Holiday: Holiday{
+			Date: "2024-08-11",
+			Name: "山の日",
+		},
+		SentAt: time.Date(2024, time.August, 10, 9, 0, 0, 0, holiday.JST).Format(time.RFC3339),
+	}
+	if err := tmpl.Execute(&bytes.Buffer{}, newTemplateData(sample)); err != nil {
+		return fmt.Errorf("webhook: invalid template: %w", err)
+	}
+	return nil
+}
+
+func newTemplateData(payload Payload) TemplateData {
+	h := holiday.Holiday{
+		Date: payload.Holiday.Date,
+		Name: payload.Holiday.Name,
+	}
+	return TemplateData{
+		Payload: payload,
+		Text:    notify.Message(h, payload.LeadDays, "ja"),
+		TextEn:  notify.Message(h, payload.LeadDays, "en"),
+	}
+}
+
+// render returns the request body of the payload for the subscription.
+// The payload is encoded as JSON if the subscription has no template.
+func render(sub Subscription, payload Payload) ([]byte, error) {
+	if sub.Template == "" {
+		return json.Marshal(payload)
+	}
+	tmpl, err := ParseTemplate(sub.Template)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, newTemplateData(payload)); err != nil {
+		return nil, fmt.Errorf("webhook: failed to render the template: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,159 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestRender(t *testing.T) {
+	payload := Payload{
+		ID:             "payload",
+		SubscriptionID: "sub",
+		LeadDays:       1,
+		Holiday: Holiday{
+			Date: "2024-08-11",
+			Name: "山の日",
+		},
+		SentAt: "2024-08-10T09:00:00+09:00",
+	}
+	tests := []struct {
+		template string
+		want     string
+	}{
+		{
+			template: "",
+			want:     `{"id":"payload","subscription_id":"sub","lead_days":1,"holiday":{"date":"2024-08-11","name":"山の日"},"sent_at":"2024-08-10T09:00:00+09:00"}`,
+		},
+		{
+			template: `{"text": {{json .Text}}}`,
+			want:     `{"text": "明日は山の日です"}`,
+		},
+		{
+			template: `{"text": {{json .TextEn}}, "date": {{json .Holiday.Date}}}`,
+			want:     `{"text": "Tomorrow is 山の日 (Mountain Day).", "date": "2024-08-11"}`,
+		},
+	}
+	for _, tt := range tests {
+		got, err := render(Subscription{Template: tt.template}, payload)
+		if err != nil {
+			t.Errorf("%q: %v", tt.template, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("%q: want %s, got %s", tt.template, tt.want, got)
+		}
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	tests := []struct {
+		template string
+		valid    bool
+	}{
+		{`{"text": {{json .Text}}}`, true},
+		{`text={{.Text}}`, true}, // the templates are not required to be JSON
+		{`{"text": {{json .Text}`, false},
+		{`{"text": {{.Unknown}}}`, false},
+		{`{"text": {{unknown .Text}}}`, false},
+	}
+	for _, tt := range tests {
+		err := ValidateTemplate(tt.template)
+		if (err == nil) != tt.valid {
+			t.Errorf("ValidateTemplate(%q): want valid %t, got %v", tt.template, tt.valid, err)
+		}
+	}
+}
+
+func TestDispatch_Template(t *testing.T) {
+	var body, contentType string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		body = string(data)
+		contentType = r.Header.Get("Content-Type")
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	store := NewMemoryStore()
+	if err := store.AddSubscription(ctx, Subscription{
+		ID:          "sub",
+		URL:         ts.URL,
+		LeadDays:    1,
+		Template:    `text={{.Text}}`,
+		ContentType: "text/plain; charset=utf-8",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	d := &Dispatcher{
+		Store:  store,
+		Client: ts.Client(),
+	}
+
+	// the day before 2024-08-11 山の日
+	now := time.Date(2024, time.August, 10, 9, 0, 0, 0, holiday.JST)
+	if err := d.Dispatch(ctx, now); err != nil {
+		t.Fatal(err)
+	}
+	if body != "text=明日は山の日です" {
+		t.Errorf("unexpected body: %q", body)
+	}
+	if contentType != "text/plain; charset=utf-8" {
+		t.Errorf("unexpected Content-Type: %q", contentType)
+	}
+}
+
+func TestDispatch_TemplateError(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	store := NewMemoryStore()
+	if err := store.AddSubscription(ctx, Subscription{
+		ID:       "sub",
+		URL:      ts.URL,
+		LeadDays: 1,
+		// the template is broken after it was validated, e.g. by editing the store directly.
+		Template: `{{.Unknown}}`,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	d := &Dispatcher{
+		Store:  store,
+		Client: ts.Client(),
+	}
+
+	// the day before 2024-08-11 山の日
+	now := time.Date(2024, time.August, 10, 9, 0, 0, 0, holiday.JST)
+	for i := 0; i < 3; i++ {
+		if err := d.Dispatch(ctx, now.Add(time.Duration(i)*10*time.Minute)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if requests != 0 {
+		t.Errorf("want no requests, got %d", requests)
+	}
+
+	// the failure is recorded once, and not retried on the next ticks.
+	deliveries, err := store.Deliveries(ctx, "sub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 1 {
+		t.Fatalf("want 1 delivery, got %d", len(deliveries))
+	}
+	if got := deliveries[0]; got.Success || !got.Permanent || got.Error == "" {
+		t.Errorf("want a permanent failure, got %+v", got)
+	}
+}
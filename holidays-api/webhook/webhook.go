@@ -0,0 +1,384 @@
+// Package webhook notifies registered endpoints of upcoming holidays.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// SignatureHeader is the header that contains the signature of the payload.
+// The value is "sha256=" followed by the hex encoded HMAC-SHA256 of
+// the timestamp, a dot, and the request body.
+const SignatureHeader = "X-Holidays-Signature"
+
+// TimestampHeader is the header that contains the unix time when the payload is signed.
+const TimestampHeader = "X-Holidays-Timestamp"
+
+// Subscription is a webhook registration.
+type Subscription struct {
+	ID string `json:"id"`
+
+	// URL is the endpoint that receives notifications.
+	URL string `json:"url"`
+
+	// Secret is the key of HMAC-SHA256 signature.
+	Secret string `json:"secret,omitempty"`
+
+	// LeadDays is how many days before a holiday the notification is sent.
+	// 0 means the notification is sent at the beginning of the holiday.
+	LeadDays int `json:"lead_days"`
+
+	// Template is the template of the request body; see ParseTemplate.
+	// If empty, Payload is sent as JSON.
+	Template string `json:"template,omitempty"`
+
+	// ContentType is the Content-Type of the request body.
+	// If empty, "application/json" is used.
+	ContentType string `json:"content_type,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Delivery is a log of a delivery attempt.
+// Permanent is true if the delivery failed for a reason that retries never fix,
+// e.g. the template of the subscription fails to render; the holiday is not retried after it.
+type Delivery struct {
+	ID             string    `json:"id"`
+	SubscriptionID string    `json:"subscription_id"`
+	HolidayDate    string    `json:"holiday_date"`
+	Attempt        int       `json:"attempt"`
+	StatusCode     int       `json:"status_code,omitempty"`
+	Error          string    `json:"error,omitempty"`
+	Success        bool      `json:"success"`
+	Permanent      bool      `json:"permanent,omitempty"`
+	Time           time.Time `json:"time"`
+}
+
+// Payload is the JSON body sent to the subscribers.
+type Payload struct {
+	ID             string  `json:"id"`
+	SubscriptionID string  `json:"subscription_id"`
+	LeadDays       int     `json:"lead_days"`
+	Holiday        Holiday `json:"holiday"`
+	SentAt         string  `json:"sent_at"`
+
+	// Test is true for the payloads sent by Dispatcher.Test.
+	// Subscribers should not act on them.
+	Test bool `json:"test,omitempty"`
+}
+
+// Holiday is a holiday in the payload.
+type Holiday struct {
+	Date string `json:"date"`
+	Name string `json:"name"`
+}
+
+// Sign returns the signature of the body.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is a valid signature of the body.
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// Dispatcher sends notifications to the subscribers.
+type Dispatcher struct {
+	Store Store
+
+	// Client is used for delivering notifications.
+	// If nil, http.DefaultClient is used.
+	Client *http.Client
+
+	// MaxAttempts is the maximum number of attempts for each notification.
+	// If zero, 3 is used.
+	MaxAttempts int
+
+	// Backoff is the wait time before the first retry.
+	// It doubles on each retry. If zero, 1 second is used.
+	Backoff time.Duration
+
+	// MaxAttemptsPerHoliday is the maximum number of attempts for each holiday in total,
+	// including the attempts by the previous calls of Dispatch.
+	// The holiday is given up after that. If zero, 10 is used.
+	MaxAttemptsPerHoliday int
+
+	// Concurrency is the maximum number of subscribers that are notified at the same time.
+	// If zero, 8 is used.
+	Concurrency int
+}
+
+// Run dispatches notifications every interval until ctx is canceled.
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := d.Dispatch(ctx, time.Now()); err != nil {
+			log.Printf("webhook: failed to dispatch: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch sends the notifications that are due at now.
+// Holidays that have already been delivered successfully, failed permanently,
+// or reached MaxAttemptsPerHoliday are skipped, so it is safe to call Dispatch repeatedly.
+// The subscribers are notified concurrently, and an error of a subscriber doesn't stop the others;
+// the errors are joined and returned.
+func (d *Dispatcher) Dispatch(ctx context.Context, now time.Time) error {
+	subs, err := d.Store.Subscriptions(ctx)
+	if err != nil {
+		return err
+	}
+
+	concurrency := d.Concurrency
+	if concurrency <= 0 {
+		concurrency = 8
+	}
+	sem := make(chan struct{}, concurrency)
+	errs := make([]error, len(subs))
+	var wg sync.WaitGroup
+	for i, sub := range subs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, sub Subscription) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := d.dispatch(ctx, sub, now); err != nil {
+				errs[i] = fmt.Errorf("webhook: subscription %s: %w", sub.ID, err)
+			}
+		}(i, sub)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// dispatch sends the notification that is due at now to the subscriber.
+func (d *Dispatcher) dispatch(ctx context.Context, sub Subscription, now time.Time) error {
+	today := now.In(holiday.JST)
+	target := time.Date(today.Year(), today.Month(), today.Day()+sub.LeadDays, 0, 0, 0, 0, holiday.JST)
+	h, ok := holiday.FindHoliday(target.Year(), target.Month(), target.Day())
+	if !ok {
+		return nil
+	}
+
+	attempts, done, err := d.attempts(ctx, sub.ID, h.Date)
+	if err != nil {
+		return err
+	}
+	if done {
+		return nil
+	}
+	maxAttempts := d.MaxAttemptsPerHoliday
+	if maxAttempts <= 0 {
+		maxAttempts = 10
+	}
+	if attempts >= maxAttempts {
+		return nil
+	}
+	return d.deliver(ctx, sub, h, now, maxAttempts-attempts)
+}
+
+// attempts returns the number of the failed attempts for the holiday,
+// and whether the holiday is done, i.e. delivered successfully or failed permanently.
+func (d *Dispatcher) attempts(ctx context.Context, subscriptionID, date string) (int, bool, error) {
+	deliveries, err := d.Store.Deliveries(ctx, subscriptionID)
+	if err != nil {
+		return 0, false, err
+	}
+	var n int
+	for _, v := range deliveries {
+		if v.HolidayDate != date {
+			continue
+		}
+		if v.Success || v.Permanent {
+			return n, true, nil
+		}
+		n++
+	}
+	return n, false, nil
+}
+
+// deliver sends the notification with retries, up to limit attempts.
+// Failures of the subscriber are recorded in the store, not returned.
+// It keeps retrying even if recording fails, and returns the errors of the store.
+func (d *Dispatcher) deliver(ctx context.Context, sub Subscription, h holiday.Holiday, now time.Time, limit int) error {
+	payload := Payload{
+		ID:             NewID(),
+		SubscriptionID: sub.ID,
+		LeadDays:       sub.LeadDays,
+		Holiday: Holiday{
+			Date: h.Date,
+			Name: h.Name,
+		},
+		SentAt: now.In(holiday.JST).Format(time.RFC3339),
+	}
+	body, err := render(sub, payload)
+	if err != nil {
+		// retrying never fixes the template, so give up now.
+		return d.Store.RecordDelivery(ctx, Delivery{
+			ID:             payload.ID,
+			SubscriptionID: sub.ID,
+			HolidayDate:    h.Date,
+			Attempt:        1,
+			Error:          err.Error(),
+			Permanent:      true,
+			Time:           time.Now(),
+		})
+	}
+
+	maxAttempts := d.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 3
+	}
+	maxAttempts = min(maxAttempts, limit)
+	backoff := d.Backoff
+	if backoff <= 0 {
+		backoff = time.Second
+	}
+
+	var errs []error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		status, err := d.post(ctx, sub, body)
+		delivery := Delivery{
+			ID:             payload.ID,
+			SubscriptionID: sub.ID,
+			HolidayDate:    h.Date,
+			Attempt:        attempt,
+			StatusCode:     status,
+			Success:        err == nil,
+			Time:           time.Now(),
+		}
+		if err != nil {
+			delivery.Error = err.Error()
+		}
+		if err := d.Store.RecordDelivery(ctx, delivery); err != nil {
+			errs = append(errs, err)
+		}
+		if delivery.Success || attempt == maxAttempts {
+			break
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Join(append(errs, ctx.Err())...)
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+	return errors.Join(errs...)
+}
+
+// Test sends a test notification of the next holiday after now to the subscriber once, without retries.
+// The payload has Test set, and the delivery is returned but not recorded,
+// so it doesn't prevent the real notification of the holiday.
+func (d *Dispatcher) Test(ctx context.Context, sub Subscription, now time.Time) (Delivery, error) {
+	h, ok := holiday.NextHoliday(now)
+	if !ok {
+		return Delivery{}, errors.New("webhook: no holiday to notify")
+	}
+	payload := Payload{
+		ID:             NewID(),
+		SubscriptionID: sub.ID,
+		LeadDays:       sub.LeadDays,
+		Holiday: Holiday{
+			Date: h.Date,
+			Name: h.Name,
+		},
+		SentAt: now.In(holiday.JST).Format(time.RFC3339),
+		Test:   true,
+	}
+	delivery := Delivery{
+		ID:             payload.ID,
+		SubscriptionID: sub.ID,
+		HolidayDate:    h.Date,
+		Attempt:        1,
+	}
+	body, err := render(sub, payload)
+	if err == nil {
+		delivery.StatusCode, err = d.post(ctx, sub, body)
+	}
+	delivery.Success = err == nil
+	delivery.Time = time.Now()
+	if err != nil {
+		delivery.Error = err.Error()
+	}
+	return delivery, nil
+}
+
+func (d *Dispatcher) post(ctx context.Context, sub Subscription, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := time.Now().Unix()
+	contentType := sub.ContentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("User-Agent", "https://github.com/shogo82148/holidays-jp")
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	if sub.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(sub.Secret, timestamp, body))
+	}
+
+	client := d.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook: unexpected status code: %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// NewSecret returns a new random secret for signing the payloads.
+func NewSecret() string {
+	var buf [32]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(buf[:])
+}
+
+// NewID returns a new random ID of subscriptions and payloads.
+func NewID() string {
+	var buf [16]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(buf[:])
+}
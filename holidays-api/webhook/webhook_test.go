@@ -0,0 +1,299 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"foo":"bar"}`)
+	sig := Sign("secret", 1700000000, body)
+	if !Verify("secret", 1700000000, body, sig) {
+		t.Error("want valid, but got invalid")
+	}
+	if Verify("secret", 1700000001, body, sig) {
+		t.Error("want invalid timestamp, but got valid")
+	}
+	if Verify("another-secret", 1700000000, body, sig) {
+		t.Error("want invalid secret, but got valid")
+	}
+}
+
+func TestDispatch(t *testing.T) {
+	var mu sync.Mutex
+	var payloads []Payload
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		timestamp, err := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if !Verify("secret", timestamp, body, r.Header.Get(SignatureHeader)) {
+			t.Error("invalid signature")
+		}
+
+		// the first attempt fails.
+		if calls == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		var p Payload
+		if err := json.Unmarshal(body, &p); err != nil {
+			t.Error(err)
+			return
+		}
+		payloads = append(payloads, p)
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	store := NewMemoryStore()
+	if err := store.AddSubscription(ctx, Subscription{
+		ID:       "sub",
+		URL:      ts.URL,
+		Secret:   "secret",
+		LeadDays: 3,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	d := &Dispatcher{
+		Store:   store,
+		Client:  ts.Client(),
+		Backoff: time.Millisecond,
+	}
+
+	// 2000-01-07 + 3 days = 2000-01-10 成人の日
+	now := time.Date(2000, time.January, 7, 9, 0, 0, 0, holiday.JST)
+	if err := d.Dispatch(ctx, now); err != nil {
+		t.Fatal(err)
+	}
+	// already delivered
+	if err := d.Dispatch(ctx, now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	// 2000-01-11 is not a holiday
+	if err := d.Dispatch(ctx, now.Add(24*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	if calls != 2 {
+		t.Errorf("want 2 calls, got %d", calls)
+	}
+	if len(payloads) != 1 {
+		t.Fatalf("want 1 payload, got %d", len(payloads))
+	}
+	want := Holiday{
+		Date: "2000-01-10",
+		Name: "成人の日",
+	}
+	if diff := cmp.Diff(want, payloads[0].Holiday); diff != "" {
+		t.Errorf("holiday mismatch (-want/+got):\n%s", diff)
+	}
+
+	deliveries, err := store.Deliveries(ctx, "sub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 2 {
+		t.Fatalf("want 2 deliveries, got %d", len(deliveries))
+	}
+	if deliveries[0].Success || deliveries[0].StatusCode != http.StatusInternalServerError {
+		t.Errorf("unexpected first delivery: %#v", deliveries[0])
+	}
+	if !deliveries[1].Success || deliveries[1].Attempt != 2 {
+		t.Errorf("unexpected second delivery: %#v", deliveries[1])
+	}
+}
+
+func TestDispatch_MaxAttemptsPerHoliday(t *testing.T) {
+	var mu sync.Mutex
+	var calls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	store := NewMemoryStore()
+	if err := store.AddSubscription(ctx, Subscription{ID: "sub", URL: ts.URL, LeadDays: 3}); err != nil {
+		t.Fatal(err)
+	}
+	d := &Dispatcher{
+		Store:                 store,
+		Client:                ts.Client(),
+		MaxAttempts:           3,
+		Backoff:               time.Millisecond,
+		MaxAttemptsPerHoliday: 5,
+	}
+
+	// 2000-01-07 + 3 days = 2000-01-10 成人の日
+	now := time.Date(2000, time.January, 7, 9, 0, 0, 0, holiday.JST)
+	for i := 0; i < 4; i++ {
+		if err := d.Dispatch(ctx, now.Add(time.Duration(i)*10*time.Minute)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 3 attempts on the first dispatch, 2 on the second, and none after that.
+	if calls != 5 {
+		t.Errorf("want 5 calls, got %d", calls)
+	}
+	deliveries, err := store.Deliveries(ctx, "sub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 5 {
+		t.Errorf("want 5 deliveries, got %d", len(deliveries))
+	}
+}
+
+// failingStore fails to record the deliveries of a subscription.
+type failingStore struct {
+	*MemoryStore
+	failID string
+}
+
+func (s *failingStore) RecordDelivery(ctx context.Context, delivery Delivery) error {
+	if delivery.SubscriptionID == s.failID {
+		return errors.New("disk full")
+	}
+	return s.MemoryStore.RecordDelivery(ctx, delivery)
+}
+
+func TestDispatch_Concurrent(t *testing.T) {
+	fastDone := make(chan struct{})
+	var once sync.Once
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/slow":
+			// the slow subscriber must not block the others.
+			select {
+			case <-fastDone:
+			case <-time.After(5 * time.Second):
+				t.Error("the subscribers are not notified concurrently")
+			}
+		case "/fast":
+			once.Do(func() { close(fastDone) })
+		}
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	store := &failingStore{MemoryStore: NewMemoryStore(), failID: "slow"}
+	for _, id := range []string{"slow", "fast"} {
+		if err := store.AddSubscription(ctx, Subscription{ID: id, URL: ts.URL + "/" + id, LeadDays: 3}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	d := &Dispatcher{
+		Store:  store,
+		Client: ts.Client(),
+	}
+
+	// 2000-01-07 + 3 days = 2000-01-10 成人の日
+	now := time.Date(2000, time.January, 7, 9, 0, 0, 0, holiday.JST)
+	err := d.Dispatch(ctx, now)
+	if err == nil || !strings.Contains(err.Error(), "disk full") {
+		t.Errorf("want the error of the store, got %v", err)
+	}
+
+	// the error of a subscriber doesn't stop the others.
+	deliveries, err := store.Deliveries(ctx, "fast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 1 || !deliveries[0].Success {
+		t.Errorf("want a successful delivery, got %#v", deliveries)
+	}
+}
+
+func TestMemoryStore_MaxDeliveries(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	for i := 0; i < MaxDeliveries+10; i++ {
+		if err := store.RecordDelivery(ctx, Delivery{SubscriptionID: "sub", Attempt: i}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	deliveries, err := store.Deliveries(ctx, "sub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != MaxDeliveries {
+		t.Fatalf("want %d deliveries, got %d", MaxDeliveries, len(deliveries))
+	}
+	if deliveries[0].Attempt != 10 {
+		t.Errorf("want the latest deliveries, got the first attempt %d", deliveries[0].Attempt)
+	}
+}
+
+func TestDispatcher_Test(t *testing.T) {
+	var got Payload
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer ts.Close()
+
+	ctx := context.Background()
+	store := NewMemoryStore()
+	sub := Subscription{
+		ID:  "sub",
+		URL: ts.URL,
+	}
+	if err := store.AddSubscription(ctx, sub); err != nil {
+		t.Fatal(err)
+	}
+	d := &Dispatcher{
+		Store:  store,
+		Client: ts.Client(),
+	}
+
+	now := time.Date(2000, time.January, 7, 9, 0, 0, 0, holiday.JST)
+	delivery, err := d.Test(ctx, sub, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !delivery.Success || delivery.HolidayDate != "2000-01-10" {
+		t.Errorf("unexpected delivery: %#v", delivery)
+	}
+	if !got.Test {
+		t.Error("want a test payload")
+	}
+
+	// the test deliveries are not recorded.
+	deliveries, err := store.Deliveries(ctx, "sub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 0 {
+		t.Errorf("want no deliveries, got %d", len(deliveries))
+	}
+}
@@ -0,0 +1,213 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/webhook"
+)
+
+// maxWebhookLeadDays is the max number of days before a holiday that a webhook is notified.
+const maxWebhookLeadDays = 30
+
+// maxWebhookRequestSize is the max size of the body of the requests to create webhooks, including the templates.
+const maxWebhookRequestSize = 16 * 1024
+
+// WebhookRequest is the body of the request to create a webhook in Dashboard.
+type WebhookRequest struct {
+	URL      string `json:"url"`
+	LeadDays int    `json:"lead_days"`
+
+	// Template and ContentType customize the request body; see webhook.Subscription.
+	Template    string `json:"template,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+}
+
+// WebhookTestResponse is the result of the test delivery of a webhook in Dashboard.
+type WebhookTestResponse struct {
+	Delivery webhook.Delivery `json:"delivery"`
+}
+
+// webhooks lists the webhooks on GET, and creates a webhook on POST.
+func (d *Dashboard) webhooks(w http.ResponseWriter, r *http.Request) {
+	if d.Webhooks == nil {
+		responseError(w, http.StatusNotFound, ErrorCodeNotFound, "webhooks are not configured")
+		return
+	}
+	if r.Method == http.MethodPost {
+		d.createWebhook(w, r)
+		return
+	}
+
+	subs, err := d.Webhooks.Subscriptions(r.Context())
+	if err != nil {
+		log.Printf("failed to get the webhooks: %v", err)
+		responseError(w, http.StatusInternalServerError, ErrorCodeInternal, "internal server error")
+		return
+	}
+	for i := range subs {
+		// the secrets are shown only when they are created or rotated.
+		subs[i].Secret = ""
+	}
+	if subs == nil {
+		subs = []webhook.Subscription{}
+	}
+	responseAdminJSON(w, subs)
+}
+
+func (d *Dashboard) createWebhook(w http.ResponseWriter, r *http.Request) {
+	var req WebhookRequest
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxWebhookRequestSize))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		responseError(w, http.StatusBadRequest, ErrorCodeInvalidParameter, "invalid request body: "+err.Error())
+		return
+	}
+	if err := validateWebhookRequest(req); err != nil {
+		responseError(w, http.StatusBadRequest, ErrorCodeInvalidParameter, err.Error())
+		return
+	}
+
+	sub := webhook.Subscription{
+		ID:          webhook.NewID(),
+		URL:         req.URL,
+		Secret:      webhook.NewSecret(),
+		LeadDays:    req.LeadDays,
+		Template:    req.Template,
+		ContentType: req.ContentType,
+		CreatedAt:   time.Now().UTC().Truncate(time.Second),
+	}
+	if err := d.Webhooks.AddSubscription(r.Context(), sub); err != nil {
+		log.Printf("failed to add the webhook: %v", err)
+		responseError(w, http.StatusInternalServerError, ErrorCodeInternal, "internal server error")
+		return
+	}
+	responseAdminJSONWithStatus(w, http.StatusCreated, sub)
+}
+
+func validateWebhookRequest(req WebhookRequest) error {
+	u, err := url.Parse(req.URL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return fmt.Errorf("url %q is not a valid http or https URL", req.URL)
+	}
+	if req.LeadDays < 0 || req.LeadDays > maxWebhookLeadDays {
+		return fmt.Errorf("lead_days %d is out of range; it must be between 0 and %d", req.LeadDays, maxWebhookLeadDays)
+	}
+	if req.Template != "" {
+		if err := webhook.ValidateTemplate(req.Template); err != nil {
+			return err
+		}
+	}
+	if req.ContentType != "" {
+		if _, _, err := mime.ParseMediaType(req.ContentType); err != nil {
+			return fmt.Errorf("content_type %q is invalid", req.ContentType)
+		}
+	}
+	return nil
+}
+
+// serveWebhook serves the webhook at "/webhooks/{id}":
+// DELETE deletes it, and POST to "/webhooks/{id}/test" and "/webhooks/{id}/rotate-secret"
+// sends a test notification and rotates the secret respectively.
+func (d *Dashboard) serveWebhook(w http.ResponseWriter, r *http.Request) {
+	if d.Webhooks == nil {
+		responseError(w, http.StatusNotFound, ErrorCodeNotFound, "webhooks are not configured")
+		return
+	}
+
+	id, action, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/webhooks/"), "/")
+	allow := http.MethodPost
+	if action == "" {
+		allow = http.MethodDelete
+	}
+	if action != "" && action != "test" && action != "rotate-secret" {
+		responseError(w, http.StatusNotFound, ErrorCodeNotFound, "not found")
+		return
+	}
+	if r.Method != allow {
+		w.Header().Set("Allow", allow)
+		responseError(w, http.StatusMethodNotAllowed, ErrorCodeMethodNotAllowed, "only "+allow+" is allowed")
+		return
+	}
+
+	sub, ok, err := d.findWebhook(r, id)
+	if err != nil {
+		log.Printf("failed to get the webhooks: %v", err)
+		responseError(w, http.StatusInternalServerError, ErrorCodeInternal, "internal server error")
+		return
+	}
+	if !ok {
+		responseError(w, http.StatusNotFound, ErrorCodeNotFound, fmt.Sprintf("webhook %q is not found", id))
+		return
+	}
+
+	switch action {
+	case "":
+		d.deleteWebhook(w, r, sub)
+	case "test":
+		d.testWebhook(w, r, sub)
+	case "rotate-secret":
+		d.rotateWebhookSecret(w, r, sub)
+	}
+}
+
+func (d *Dashboard) findWebhook(r *http.Request, id string) (webhook.Subscription, bool, error) {
+	subs, err := d.Webhooks.Subscriptions(r.Context())
+	if err != nil {
+		return webhook.Subscription{}, false, err
+	}
+	for _, sub := range subs {
+		if sub.ID == id {
+			return sub, true, nil
+		}
+	}
+	return webhook.Subscription{}, false, nil
+}
+
+func (d *Dashboard) deleteWebhook(w http.ResponseWriter, r *http.Request, sub webhook.Subscription) {
+	err := d.Webhooks.DeleteSubscription(r.Context(), sub.ID)
+	if errors.Is(err, webhook.ErrNotFound) {
+		responseError(w, http.StatusNotFound, ErrorCodeNotFound, fmt.Sprintf("webhook %q is not found", sub.ID))
+		return
+	}
+	if err != nil {
+		log.Printf("failed to delete the webhook %s: %v", sub.ID, err)
+		responseError(w, http.StatusInternalServerError, ErrorCodeInternal, "internal server error")
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (d *Dashboard) testWebhook(w http.ResponseWriter, r *http.Request, sub webhook.Subscription) {
+	dispatcher := &webhook.Dispatcher{Store: d.Webhooks}
+	delivery, err := dispatcher.Test(r.Context(), sub, time.Now())
+	if err != nil {
+		log.Printf("failed to test the webhook %s: %v", sub.ID, err)
+		responseError(w, http.StatusInternalServerError, ErrorCodeInternal, "internal server error")
+		return
+	}
+	responseAdminJSON(w, WebhookTestResponse{Delivery: delivery})
+}
+
+func (d *Dashboard) rotateWebhookSecret(w http.ResponseWriter, r *http.Request, sub webhook.Subscription) {
+	sub.Secret = webhook.NewSecret()
+	err := d.Webhooks.UpdateSubscription(r.Context(), sub)
+	if errors.Is(err, webhook.ErrNotFound) {
+		responseError(w, http.StatusNotFound, ErrorCodeNotFound, fmt.Sprintf("webhook %q is not found", sub.ID))
+		return
+	}
+	if err != nil {
+		log.Printf("failed to rotate the secret of the webhook %s: %v", sub.ID, err)
+		responseError(w, http.StatusInternalServerError, ErrorCodeInternal, "internal server error")
+		return
+	}
+	responseAdminJSON(w, sub)
+}
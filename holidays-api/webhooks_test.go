@@ -0,0 +1,144 @@
+package holidaysapi
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/webhook"
+)
+
+func TestDashboard_Webhooks(t *testing.T) {
+	var received int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received++
+	}))
+	defer ts.Close()
+
+	d := &Dashboard{
+		Token:    "secret",
+		Webhooks: webhook.NewMemoryStore(),
+	}
+	serve := func(method, path, body string) *http.Response {
+		req := httptest.NewRequest(method, "http://example.com"+path, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer secret")
+		w := httptest.NewRecorder()
+		d.ServeHTTP(w, req)
+		return w.Result()
+	}
+	decode := func(t *testing.T, resp *http.Response, v any) {
+		t.Helper()
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	t.Run("unauthorized", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/webhooks", nil)
+		w := httptest.NewRecorder()
+		d.ServeHTTP(w, req)
+		if w.Code != http.StatusUnauthorized {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusUnauthorized, w.Code)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		tests := []string{
+			`{"url":"ftp://example.com/hook"}`,
+			`{"url":"https://example.com/hook","lead_days":-1}`,
+			`{"url":"https://example.com/hook","lead_days":31}`,
+			`{"url":"https://example.com/hook","secret":"my-secret"}`,
+			`{"url":"https://example.com/hook","template":"{{.Unknown}}"}`,
+			`{"url":"https://example.com/hook","content_type":"no/such type"}`,
+			`not json`,
+		}
+		for _, body := range tests {
+			resp := serve(http.MethodPost, "/webhooks", body)
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Errorf("%s: unexpected status code: want %d, got %d", body, http.StatusBadRequest, resp.StatusCode)
+			}
+		}
+	})
+
+	var created webhook.Subscription
+	t.Run("create", func(t *testing.T) {
+		resp := serve(http.MethodPost, "/webhooks", `{"url":"`+ts.URL+`","lead_days":3,"template":"{\"text\": {{json .Text}}}"}`)
+		if resp.StatusCode != http.StatusCreated {
+			t.Fatalf("unexpected status code: want %d, got %d", http.StatusCreated, resp.StatusCode)
+		}
+		decode(t, resp, &created)
+		if created.ID == "" || created.Secret == "" {
+			t.Errorf("want the ID and the secret, got %#v", created)
+		}
+		if created.URL != ts.URL || created.LeadDays != 3 || created.Template != `{"text": {{json .Text}}}` {
+			t.Errorf("unexpected subscription: %#v", created)
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		resp := serve(http.MethodGet, "/webhooks", "")
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		var got []webhook.Subscription
+		decode(t, resp, &got)
+		if len(got) != 1 || got[0].ID != created.ID {
+			t.Fatalf("unexpected subscriptions: %#v", got)
+		}
+		if got[0].Secret != "" {
+			t.Error("the secret must not be shown")
+		}
+	})
+
+	t.Run("test", func(t *testing.T) {
+		resp := serve(http.MethodPost, "/webhooks/"+created.ID+"/test", "")
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		var got WebhookTestResponse
+		decode(t, resp, &got)
+		if !got.Delivery.Success {
+			t.Errorf("unexpected delivery: %#v", got.Delivery)
+		}
+		if received != 1 {
+			t.Errorf("want 1 request, got %d", received)
+		}
+	})
+
+	t.Run("rotate-secret", func(t *testing.T) {
+		if resp := serve(http.MethodGet, "/webhooks/"+created.ID+"/rotate-secret", ""); resp.StatusCode != http.StatusMethodNotAllowed {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusMethodNotAllowed, resp.StatusCode)
+		}
+		resp := serve(http.MethodPost, "/webhooks/"+created.ID+"/rotate-secret", "")
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected status code: want %d, got %d", http.StatusOK, resp.StatusCode)
+		}
+		var got webhook.Subscription
+		decode(t, resp, &got)
+		if got.Secret == "" || got.Secret == created.Secret {
+			t.Errorf("want a new secret, got %q", got.Secret)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		resp := serve(http.MethodDelete, "/webhooks/"+created.ID, "")
+		if resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("unexpected status code: want %d, got %d", http.StatusNoContent, resp.StatusCode)
+		}
+		resp = serve(http.MethodDelete, "/webhooks/"+created.ID, "")
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("unexpected status code: want %d, got %d", http.StatusNotFound, resp.StatusCode)
+		}
+		resp = serve(http.MethodGet, "/webhooks", "")
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.TrimSpace(string(body)); got != "[]" {
+			t.Errorf("want no webhooks, got %s", got)
+		}
+	})
+}
@@ -0,0 +1,178 @@
+// Package xlsx exports holidays as an Excel workbook (Office Open XML).
+package xlsx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+var weekdayNames = [...]string{"日", "月", "火", "水", "木", "金", "土"}
+
+var kindNames = map[holiday.Kind]string{
+	holiday.KindNational:   "国民の祝日",
+	holiday.KindSubstitute: "振替休日",
+	holiday.KindCitizens:   "国民の休日",
+	holiday.KindSpecial:    "特別法による休日",
+	holiday.KindCustom:     "独自の休日",
+	holiday.KindLocal:      "地域の記念日",
+	holiday.KindCustomary:  "慣習上の休業日",
+	holiday.KindMourning:   "服喪の日",
+}
+
+// excelEpoch is the origin of the serial date numbers in Excel.
+var excelEpoch = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+
+// Write writes a workbook that has a sheet for each year.
+// Each sheet lists the holidays with the columns 日付, 曜日, 名称, and 種別.
+func Write(w io.Writer, years ...int) error {
+	if len(years) == 0 {
+		return fmt.Errorf("xlsx: no years")
+	}
+
+	z := zip.NewWriter(w)
+	files := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", contentTypes(len(years))},
+		{"_rels/.rels", rootRels},
+		{"xl/workbook.xml", workbook(years)},
+		{"xl/_rels/workbook.xml.rels", workbookRels(len(years))},
+		{"xl/styles.xml", styles},
+	}
+	for _, f := range files {
+		if err := writeFile(z, f.name, f.content); err != nil {
+			return err
+		}
+	}
+	for i, year := range years {
+		name := fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1)
+		if err := writeFile(z, name, sheet(holiday.FindHolidaysInYear(year))); err != nil {
+			return err
+		}
+	}
+	return z.Close()
+}
+
+func writeFile(z *zip.Writer, name, content string) error {
+	f, err := z.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(f, content)
+	return err
+}
+
+const xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+func contentTypes(sheets int) string {
+	var buf strings.Builder
+	buf.WriteString(xmlHeader)
+	buf.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	buf.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	buf.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	buf.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	buf.WriteString(`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	for i := 1; i <= sheets; i++ {
+		fmt.Fprintf(&buf, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i)
+	}
+	buf.WriteString(`</Types>`)
+	return buf.String()
+}
+
+const rootRels = xmlHeader +
+	`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+func workbook(years []int) string {
+	var buf strings.Builder
+	buf.WriteString(xmlHeader)
+	buf.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+	for i, year := range years {
+		fmt.Fprintf(&buf, `<sheet name="%d" sheetId="%d" r:id="rId%d"/>`, year, i+1, i+1)
+	}
+	buf.WriteString(`</sheets></workbook>`)
+	return buf.String()
+}
+
+func workbookRels(sheets int) string {
+	var buf strings.Builder
+	buf.WriteString(xmlHeader)
+	buf.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	for i := 1; i <= sheets; i++ {
+		fmt.Fprintf(&buf, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i, i)
+	}
+	fmt.Fprintf(&buf, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, sheets+1)
+	buf.WriteString(`</Relationships>`)
+	return buf.String()
+}
+
+// styles defines the cell formats:
+// 0 is the default, 1 is yyyy-mm-dd, and 2 is bold for the header.
+const styles = xmlHeader +
+	`<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy-mm-dd"/></numFmts>` +
+	`<fonts count="2"><font><sz val="11"/><name val="Yu Gothic"/></font><font><b/><sz val="11"/><name val="Yu Gothic"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="3">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`</cellXfs>` +
+	`</styleSheet>`
+
+func sheet(holidays []holiday.Holiday) string {
+	var buf strings.Builder
+	buf.WriteString(xmlHeader)
+	buf.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	buf.WriteString(`<cols><col min="1" max="1" width="12" customWidth="1"/><col min="2" max="2" width="6" customWidth="1"/><col min="3" max="4" width="24" customWidth="1"/></cols>`)
+	buf.WriteString(`<sheetData>`)
+	buf.WriteString(`<row r="1">`)
+	for i, title := range []string{"日付", "曜日", "名称", "種別"} {
+		inlineString(&buf, cellRef(i, 1), title, 2)
+	}
+	buf.WriteString(`</row>`)
+
+	for i, h := range holidays {
+		row := i + 2
+		d, err := time.Parse("2006-01-02", h.Date)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Fprintf(&buf, `<row r="%d">`, row)
+		serial := int(d.Sub(excelEpoch) / (24 * time.Hour))
+		fmt.Fprintf(&buf, `<c r="%s" s="1"><v>%d</v></c>`, cellRef(0, row), serial)
+		inlineString(&buf, cellRef(1, row), weekdayNames[d.Weekday()], 0)
+		inlineString(&buf, cellRef(2, row), h.Name, 0)
+		inlineString(&buf, cellRef(3, row), kindNames[h.Kind], 0)
+		buf.WriteString(`</row>`)
+	}
+	buf.WriteString(`</sheetData></worksheet>`)
+	return buf.String()
+}
+
+func inlineString(buf *strings.Builder, ref, s string, style int) {
+	fmt.Fprintf(buf, `<c r="%s" t="inlineStr"`, ref)
+	if style != 0 {
+		fmt.Fprintf(buf, ` s="%d"`, style)
+	}
+	buf.WriteString(`><is><t>`)
+	xml.EscapeText(buf, []byte(s))
+	buf.WriteString(`</t></is></c>`)
+}
+
+// cellRef returns the reference of the cell, e.g. cellRef(0, 1) is "A1".
+// col is zero-based and less than 26.
+func cellRef(col, row int) string {
+	return string(rune('A'+col)) + strconv.Itoa(row)
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+var utf8BOM = []byte("\xef\xbb\xbf")
+
+// the keywords to find the columns in the header of syukujitsu.csv.
+// The header has been "国民の祝日・休日月日,国民の祝日・休日名称" since 2017.
+var (
+	dateColumnKeywords = []string{"月日", "日付", "date"}
+	nameColumnKeywords = []string{"名称", "名前", "name"}
+)
+
+// the formats of the dates in syukujitsu.csv, e.g. 2021/1/1.
+var dateLayouts = []string{"2006/1/2", "2006-1-2"}
+
+// parseSyukujitsuCSV parses syukujitsu.csv published by the Cabinet Office.
+// The encoding is Shift_JIS, or UTF-8 with or without a BOM.
+// The columns are found by the header, so they may be reordered or renamed a little.
+// The returned holidays are sorted by date, and their kinds are not set.
+func parseSyukujitsuCSV(rawData []byte) ([]Holiday, error) {
+	data, err := decodeCSV(rawData)
+	if err != nil {
+		return nil, err
+	}
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	dateCol, nameCol := -1, -1
+	seen := map[string]int{}
+	var holidays []Holiday
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("syukujitsu.csv: %w", err)
+		}
+		line, _ := r.FieldPos(0)
+		if isBlankRecord(record) {
+			continue
+		}
+
+		if dateCol < 0 {
+			dateCol = findColumn(record, dateColumnKeywords)
+			nameCol = findColumn(record, nameColumnKeywords)
+			if dateCol < 0 {
+				return nil, fmt.Errorf("syukujitsu.csv:%d: no date column in the header %q", line, record)
+			}
+			if nameCol < 0 {
+				return nil, fmt.Errorf("syukujitsu.csv:%d: no name column in the header %q", line, record)
+			}
+			continue
+		}
+
+		if len(record) <= max(dateCol, nameCol) {
+			return nil, fmt.Errorf("syukujitsu.csv:%d: want at least %d columns, got %d", line, max(dateCol, nameCol)+1, len(record))
+		}
+		date, err := parseCSVDate(record[dateCol])
+		if err != nil {
+			return nil, fmt.Errorf("syukujitsu.csv:%d: invalid date %q", line, record[dateCol])
+		}
+		name := norm.NFC.String(strings.TrimSpace(record[nameCol]))
+		if name == "" {
+			return nil, fmt.Errorf("syukujitsu.csv:%d: the name of %s is empty", line, date)
+		}
+		if prev, ok := seen[date]; ok {
+			return nil, fmt.Errorf("syukujitsu.csv:%d: %s is duplicated with line %d", line, date, prev)
+		}
+		seen[date] = line
+
+		holidays = append(holidays, Holiday{
+			Date: date,
+			Name: name,
+		})
+	}
+	if dateCol < 0 {
+		return nil, errors.New("syukujitsu.csv: no header")
+	}
+	if len(holidays) == 0 {
+		return nil, errors.New("syukujitsu.csv: no holidays")
+	}
+	sort.Slice(holidays, func(i, j int) bool {
+		return holidays[i].Date < holidays[j].Date
+	})
+	return holidays, nil
+}
+
+// decodeCSV converts the raw data into UTF-8 without a BOM.
+// The data is treated as UTF-8 if it has a BOM or it is valid UTF-8, otherwise as Shift_JIS.
+// The data that has U+FFFD is rejected because it comes from a broken conversion.
+func decodeCSV(rawData []byte) ([]byte, error) {
+	var data []byte
+	var encoding string
+	if d, ok := bytes.CutPrefix(rawData, utf8BOM); ok {
+		if !utf8.Valid(d) {
+			return nil, errors.New("syukujitsu.csv: invalid UTF-8 after the BOM")
+		}
+		data, encoding = d, "UTF-8 with BOM"
+	} else if utf8.Valid(rawData) {
+		data, encoding = rawData, "UTF-8"
+	} else {
+		d, _, err := transform.Bytes(japanese.ShiftJIS.NewDecoder(), rawData)
+		if err != nil {
+			return nil, fmt.Errorf("syukujitsu.csv: neither UTF-8 nor Shift_JIS: %w", err)
+		}
+		data, encoding = d, "Shift_JIS"
+	}
+
+	// the Shift_JIS decoder replaces the invalid bytes with U+FFFD,
+	// and UTF-8 data may have it if the file was converted from a wrong encoding.
+	// Either way the names are mojibake.
+	if bytes.ContainsRune(data, utf8.RuneError) {
+		if encoding == "Shift_JIS" {
+			return nil, errors.New("syukujitsu.csv: neither UTF-8 nor Shift_JIS")
+		}
+		return nil, fmt.Errorf("syukujitsu.csv: U+FFFD in the data decoded as %s", encoding)
+	}
+	log.Printf("syukujitsu.csv is decoded as %s", encoding)
+	return data, nil
+}
+
+// isBlankRecord reports whether all fields of the record are blank, e.g. trailing ",".
+func isBlankRecord(record []string) bool {
+	for _, f := range record {
+		if strings.TrimSpace(f) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// findColumn returns the index of the first column that contains one of the keywords, or -1 if not found.
+func findColumn(header []string, keywords []string) int {
+	for i, h := range header {
+		h = strings.ToLower(strings.TrimSpace(h))
+		for _, k := range keywords {
+			if strings.Contains(h, k) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parseCSVDate converts the date in syukujitsu.csv into the format of 2006-01-02, e.g. 2021/1/1 -> 2021-01-01.
+func parseCSVDate(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	var err error
+	for _, layout := range dateLayouts {
+		var t time.Time
+		t, err = time.Parse(layout, s)
+		if err == nil {
+			return t.Format("2006-01-02"), nil
+		}
+	}
+	return "", err
+}
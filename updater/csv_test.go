@@ -0,0 +1,217 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseSyukujitsuCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []Holiday
+		wantErr string
+	}{
+		{
+			name: "the current format",
+			input: "国民の祝日・休日月日,国民の祝日・休日名称\r\n" +
+				"2024/1/1,元日\r\n" +
+				"2024/1/8,成人の日\r\n",
+			want: []Holiday{
+				{Date: "2024-01-01", Name: "元日"},
+				{Date: "2024-01-08", Name: "成人の日"},
+			},
+		},
+		{
+			name: "reordered columns",
+			input: "国民の祝日・休日名称,国民の祝日・休日月日\n" +
+				"元日,2024/1/1\n" +
+				"成人の日,2024/1/8\n",
+			want: []Holiday{
+				{Date: "2024-01-01", Name: "元日"},
+				{Date: "2024-01-08", Name: "成人の日"},
+			},
+		},
+		{
+			name: "renamed columns",
+			input: "日付,名前\n" +
+				"2024-01-08,成人の日\n" +
+				"2024-01-01,元日\n",
+			want: []Holiday{
+				{Date: "2024-01-01", Name: "元日"},
+				{Date: "2024-01-08", Name: "成人の日"},
+			},
+		},
+		{
+			name: "English header and an extra column",
+			input: "Date,Name,Note\n" +
+				"2024/1/1,元日,\n",
+			want: []Holiday{
+				{Date: "2024-01-01", Name: "元日"},
+			},
+		},
+		{
+			name: "blank lines and trailing commas",
+			input: "国民の祝日・休日月日,国民の祝日・休日名称\n" +
+				"\n" +
+				"2024/1/1, 元日 \n" +
+				",\n",
+			want: []Holiday{
+				{Date: "2024-01-01", Name: "元日"},
+			},
+		},
+		{
+			name: "missing date column",
+			input: "国民の祝日・休日名称\n" +
+				"元日\n",
+			wantErr: "no date column",
+		},
+		{
+			name: "missing name column",
+			input: "国民の祝日・休日月日,備考\n" +
+				"2024/1/1,\n",
+			wantErr: "no name column",
+		},
+		{
+			name: "missing field",
+			input: "国民の祝日・休日月日,国民の祝日・休日名称\n" +
+				"2024/1/1\n",
+			wantErr: "syukujitsu.csv:2: want at least 2 columns, got 1",
+		},
+		{
+			name: "empty name",
+			input: "国民の祝日・休日月日,国民の祝日・休日名称\n" +
+				"2024/1/1, \n",
+			wantErr: "the name of 2024-01-01 is empty",
+		},
+		{
+			name: "duplicate rows",
+			input: "国民の祝日・休日月日,国民の祝日・休日名称\n" +
+				"2024/1/1,元日\n" +
+				"2024/1/8,成人の日\n" +
+				"2024/1/1,元日\n",
+			wantErr: "syukujitsu.csv:4: 2024-01-01 is duplicated with line 2",
+		},
+		{
+			name: "duplicate dates in different formats",
+			input: "国民の祝日・休日月日,国民の祝日・休日名称\n" +
+				"2024/1/1,元日\n" +
+				"2024-01-01,元日\n",
+			wantErr: "2024-01-01 is duplicated with line 2",
+		},
+		{
+			name: "blank date",
+			input: "国民の祝日・休日月日,国民の祝日・休日名称\n" +
+				",元日\n",
+			wantErr: `syukujitsu.csv:2: invalid date ""`,
+		},
+		{
+			name: "malformed date",
+			input: "国民の祝日・休日月日,国民の祝日・休日名称\n" +
+				"2024年1月1日,元日\n",
+			wantErr: `syukujitsu.csv:2: invalid date "2024年1月1日"`,
+		},
+		{
+			name: "date out of range",
+			input: "国民の祝日・休日月日,国民の祝日・休日名称\n" +
+				"2024/2/30,元日\n",
+			wantErr: `invalid date "2024/2/30"`,
+		},
+		{
+			name:    "no header",
+			input:   "\n,\n",
+			wantErr: "syukujitsu.csv: no header",
+		},
+		{
+			name:    "no holidays",
+			input:   "国民の祝日・休日月日,国民の祝日・休日名称\n",
+			wantErr: "syukujitsu.csv: no holidays",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSyukujitsuCSV([]byte(tt.input))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("want error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("holidays mismatch (-want/+got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDecodeCSV(t *testing.T) {
+	// the first lines of syukujitsu.csv with 2024/1/1 元日, in each encoding.
+	const want = "国民の祝日・休日月日,国民の祝日・休日名称\r\n2024/1/1,元日\r\n"
+	const shiftJIS = "\x8d\x91\x96\xaf\x82\xcc\x8f\x6a\x93\xfa\x81\x45\x8b\x78\x93\xfa\x8c\x8e\x93\xfa," +
+		"\x8d\x91\x96\xaf\x82\xcc\x8f\x6a\x93\xfa\x81\x45\x8b\x78\x93\xfa\x96\xbc\x8f\xcc\r\n" +
+		"2024/1/1,\x8c\xb3\x93\xfa\r\n"
+
+	tests := []struct {
+		name    string
+		input   []byte
+		wantErr string
+	}{
+		{
+			name:  "UTF-8 with BOM",
+			input: []byte("\xef\xbb\xbf" + want),
+		},
+		{
+			name:  "UTF-8",
+			input: []byte(want),
+		},
+		{
+			name:  "Shift_JIS",
+			input: []byte(shiftJIS),
+		},
+		{
+			name:    "invalid UTF-8 after the BOM",
+			input:   []byte("\xef\xbb\xbf" + shiftJIS),
+			wantErr: "syukujitsu.csv: invalid UTF-8 after the BOM",
+		},
+		{
+			// 0x85 0x40 is not assigned in Shift_JIS, and it is decoded into U+FFFD.
+			name:    "unassigned Shift_JIS",
+			input:   []byte(shiftJIS + "2024/1/8,\x85\x40\r\n"),
+			wantErr: "syukujitsu.csv: neither UTF-8 nor Shift_JIS",
+		},
+		{
+			name:    "U+FFFD in UTF-8",
+			input:   []byte(want + "2024/1/8,\xef\xbf\xbd\xef\xbf\xbdの日\r\n"),
+			wantErr: "syukujitsu.csv: U+FFFD in the data decoded as UTF-8",
+		},
+		{
+			name:    "U+FFFD in UTF-8 with BOM",
+			input:   []byte("\xef\xbb\xbf" + want + "2024/1/8,\xef\xbf\xbd\r\n"),
+			wantErr: "syukujitsu.csv: U+FFFD in the data decoded as UTF-8 with BOM",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeCSV(tt.input)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("want error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != want {
+				t.Errorf("want %q, got %q", want, got)
+			}
+		})
+	}
+}
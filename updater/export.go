@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// exportDir is the directory of the holidays exported for other languages.
+var exportDir = filepath.Join("../", "generated")
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var exportTemplates = template.Must(template.New("").Funcs(template.FuncMap{
+	"quote": quote,
+	"kind":  kindName,
+}).ParseFS(templateFS, "templates/*.tmpl"))
+
+// exportData is the data passed to the templates.
+type exportData struct {
+	Version     string
+	SourceURL   string
+	GeneratedAt string
+	Holidays    []Holiday
+}
+
+// exportHolidays writes the holidays as the data of other languages, e.g. JavaScript, Python and Ruby.
+// Each file is generated from templates/<file name>.tmpl,
+// and its content follows the JSON Schema in holidays.schema.json.
+func exportHolidays(holidays []Holiday, version, generatedAt string) error {
+	if err := os.MkdirAll(exportDir, 0755); err != nil {
+		return err
+	}
+	data := exportData{
+		Version:     version,
+		SourceURL:   syukujitsuURL,
+		GeneratedAt: generatedAt,
+		Holidays:    holidays,
+	}
+	for _, tmpl := range exportTemplates.Templates() {
+		name := strings.TrimSuffix(tmpl.Name(), ".tmpl")
+		if name == "" {
+			continue
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(exportDir, name), buf.Bytes(), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// quote returns s as a double-quoted string literal.
+// The JSON string literals are also valid in JavaScript, Python and Ruby.
+func quote(s string) (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// kindName returns the name of the kind used by the API, e.g. "national" for "KindNational".
+func kindName(kind string) string {
+	return strings.ToLower(strings.TrimPrefix(kind, "Kind"))
+}
@@ -2,4 +2,11 @@ module github.com/shogo82148/holidays-jp/updater
 
 go 1.21
 
-require golang.org/x/text v0.14.0
+require (
+	github.com/google/go-cmp v0.6.0
+	github.com/shogo82148/holidays-jp/holidays-api v0.0.0
+	golang.org/x/text v0.14.0
+)
+
+// the holiday package is used to validate the downloaded data.
+replace github.com/shogo82148/holidays-jp/holidays-api => ../holidays-api
@@ -1,28 +1,38 @@
 // downloader for syukujitsu.csv
 // https://www8.cao.go.jp/chosei/shukujitsu/gaiyou.html
+//
+// Usage:
+//
+//	go run . [-out dir] [-package name]
+//
+// The -out and -package flags change the directory and the package name of the generated Go source,
+// e.g. for forks and vendored copies. The defaults are ../holidays-api/holiday and holiday.
 
 package main
 
 import (
 	"bytes"
 	"context"
-	"encoding/csv"
-	"errors"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
 	"fmt"
 	"go/format"
+	"hash"
 	"io"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
-	"golang.org/x/text/encoding/japanese"
-	"golang.org/x/text/transform"
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
 )
 
 // 内閣府ホーム  >  内閣府の政策  >  制度  >  国民の祝日について
@@ -31,7 +41,29 @@ const syukujitsuURL = "https://www8.cao.go.jp/chosei/shukujitsu/syukujitsu.csv"
 
 const rawDataPath = "../syukujitsu.csv"
 
+var (
+	// outDir is the directory of the generated Go source.
+	outDir = filepath.Join("../", "holidays-api", "holiday")
+
+	// packageName is the package name of the generated Go source.
+	packageName = "holiday"
+)
+
+// generatedPath is the path of the generated Go source that has the metadata.
+func generatedPath() string {
+	return filepath.Join(outDir, "holidays_generated.go")
+}
+
+// embeddedDataPath is the copy of the raw data embedded by the holidays_csv build.
+func embeddedDataPath() string {
+	return filepath.Join(outDir, "syukujitsu.csv")
+}
+
 func main() {
+	flag.StringVar(&outDir, "out", outDir, "the directory of the generated Go source")
+	flag.StringVar(&packageName, "package", packageName, "the package name of the generated Go source")
+	flag.Parse()
+
 	if err := _main(); err != nil {
 		log.Fatal(err)
 	}
@@ -80,85 +112,380 @@ func download(ctx context.Context) ([]byte, error) {
 	if err := os.WriteFile(rawDataPath, buf, 0644); err != nil {
 		return nil, err
 	}
+	if err := os.WriteFile(embeddedDataPath(), buf, 0644); err != nil {
+		return nil, err
+	}
 
 	return buf, nil
 }
 
+// Holiday is a holiday in syukujitsu.csv.
+type Holiday struct {
+	Date string
+	Name string
+
+	// Kind is the name of the holiday.Kind constant.
+	Kind string
+}
+
 func formatHolidays(rawData []byte) error {
-	type Holiday struct {
-		Date string
-		Name string
+	holidays, err := parseSyukujitsuCSV(rawData)
+	if err != nil {
+		return err
+	}
+
+	isHoliday := make(map[string]bool, len(holidays))
+	for _, h := range holidays {
+		isHoliday[h.Date] = true
+	}
+	for i := range holidays {
+		kind := holiday.KindOf(holiday.Holiday{Date: holidays[i].Date, Name: holidays[i].Name}, isHoliday)
+		holidays[i].Kind = kindConstant(kind)
+	}
+
+	if err := validateCoverage(holidays); err != nil {
+		return err
+	}
+	if err := validateHolidays(holidays); err != nil {
+		return err
+	}
+
+	version, generatedAt := dataVersion(rawData)
+	if err := writeGenerated(holidays, version, generatedAt); err != nil {
+		return err
+	}
+	return exportHolidays(holidays, version, generatedAt)
+}
+
+// minYearsBefore is the number of the years before the generation kept by the holidays_min build.
+// The holidays_min build keeps the whole decade that has the year, so it keeps a few more years.
+const minYearsBefore = 10
+
+// chunk is a range of years written into a generated file.
+type chunk struct {
+	name   string // the name of the variable, e.g. holidays2020s
+	suffix string // the suffix of the file name, e.g. 2020s
+
+	// the years in [from, to)
+	from, to int
+
+	// historical is true if the chunk is excluded by the holidays_min build.
+	historical bool
+}
+
+// chunksOf splits the years into chunks, one per decade.
+// The boundaries do not depend on the time of the generation,
+// so a yearly update does not rename the files of the past decades.
+// The decades that end before minStartYear are historical.
+func chunksOf(startYear, endYear, minStartYear int) []chunk {
+	var chunks []chunk
+	for d := decadeOf(startYear); d <= endYear; d += 10 {
+		chunks = append(chunks, chunk{
+			name:       fmt.Sprintf("holidays%ds", d),
+			suffix:     fmt.Sprintf("%ds", d),
+			from:       d,
+			to:         d + 10,
+			historical: d+10 <= minStartYear,
+		})
 	}
+	return chunks
+}
 
-	reader := transform.NewReader(bytes.NewReader(rawData), japanese.ShiftJIS.NewDecoder())
-	csvReader := csv.NewReader(reader)
+// writeGenerated writes the holidays into the Go source files, one file per decade.
+// The decades before the one that has the year minYearsBefore years before the generation
+// are excluded by the holidays_min build tag, and the holidays in them are calculated from the law instead.
+func writeGenerated(holidays []Holiday, version, generatedAt string) error {
+	startYear := yearOf(holidays[0].Date)
+	endYear := yearOf(holidays[len(holidays)-1].Date)
+	generated, err := time.Parse(time.RFC3339, generatedAt)
+	if err != nil {
+		return err
+	}
+	minStartYear := max(startYear, decadeOf(generated.Year()-minYearsBefore))
 
-	// skip 国民の祝日・休日月日,国民の祝日・休日名称 line
-	csvReader.Read()
+	years := map[int][]Holiday{}
+	for _, h := range holidays {
+		y := yearOf(h.Date)
+		years[y] = append(years[y], h)
+	}
 
-	holidays := []Holiday{}
-	for {
-		record, err := csvReader.Read()
-		if errors.Is(err, io.EOF) {
-			break
+	prevDigests, err := readDigests()
+	if err != nil {
+		return err
+	}
+	allDigests := map[int]string{}
+
+	var names, historical []string
+	written := map[string]bool{
+		generatedFilePath("full"): true,
+		generatedFilePath("min"):  true,
+	}
+	for _, c := range chunksOf(startYear, endYear, minStartYear) {
+		names = append(names, c.name)
+		tag := "!holidays_csv"
+		if c.historical {
+			tag = "!holidays_csv && !holidays_min"
+			historical = append(historical, c.name)
 		}
-		if err != nil {
+
+		var list []Holiday
+		for y := c.from; y < c.to; y++ {
+			list = append(list, years[y]...)
+		}
+		path := generatedFilePath(c.suffix)
+		written[path] = true
+		digests := yearDigests(list)
+		for y, digest := range digests {
+			allDigests[y] = digest
+		}
+
+		var buf bytes.Buffer
+		fmt.Fprintf(&buf, `// Code generated by internal/gen/gen.go; DO NOT EDIT.
+
+			//go:build %s
+
+			package %s
+
+			// The digests of the holidays in each year.
+			// The updater compares them with the previous ones to report the changed years.
+			//
+			`, tag, packageName)
+		for y := c.from; y < c.to; y++ {
+			if digest, ok := digests[y]; ok {
+				fmt.Fprintf(&buf, "//\t%d: %s\n", y, digest)
+			}
+		}
+		fmt.Fprintf(&buf, "\n// the holidays in the %ds\n", c.from)
+		fmt.Fprintf(&buf, "var %s = []Holiday{\n", c.name)
+		for _, holiday := range list {
+			if holiday.Kind == "KindNational" {
+				fmt.Fprintf(&buf, "{\nDate: %q,\nName: %q,\n},\n", holiday.Date, holiday.Name)
+			} else {
+				fmt.Fprintf(&buf, "{\nDate: %q,\nName: %q,\nKind: %s,\n},\n", holiday.Date, holiday.Name, holiday.Kind)
+			}
+		}
+		fmt.Fprintln(&buf, "}")
+		if err := writeSource(path, buf.Bytes()); err != nil {
 			return err
 		}
-		holidays = append(holidays, Holiday{
-			Date: formatDate(record[0]),
-			Name: record[1],
-		})
 	}
-	sort.Slice(holidays, func(i, j int) bool {
-		return holidays[i].Date < holidays[j].Date
-	})
+
+	reportChangedYears(prevDigests, allDigests)
 
 	var buf bytes.Buffer
 	fmt.Fprint(
 		&buf,
 		`// Code generated by internal/gen/gen.go; DO NOT EDIT.
 
-		package holiday
+		//go:build !holidays_csv
+
+		package `+packageName+`
 
-		// the year range of pre-calculated holidays
+		// the last year of pre-calculated holidays
+		const holidaysEndYear = `+strconv.Itoa(endYear)+`
+
+		// the metadata of the pre-calculated holidays
 		const (
-			holidaysStartYear = `+strings.Split(holidays[0].Date, "-")[0]+`
-			holidaysEndYear = `+strings.Split(holidays[len(holidays)-1].Date, "-")[0]+`
+			dataVersion = `+strconv.Quote(version)+`
+			dataSourceURL = `+strconv.Quote(syukujitsuURL)+`
+			dataGeneratedAt = `+strconv.Quote(generatedAt)+`
 		)
 
 		// 内閣府ホーム  >  内閣府の政策  >  制度  >  国民の祝日について
 		// https://www8.cao.go.jp/chosei/shukujitsu/gaiyou.html
 		// Based on `+syukujitsuURL+`
-		var holidays = []Holiday{
+		var holidays = joinHolidays(
 		`,
 	)
-	for _, holiday := range holidays {
-		fmt.Fprintf(&buf, "{\nDate: %q,\nName: %q,\n},\n", holiday.Date, holiday.Name)
+	for _, name := range names {
+		fmt.Fprintf(&buf, "%s,\n", name)
+	}
+	fmt.Fprint(&buf, `)
+
+		// validateData reports an error if the holidays are not sorted, not unique, or out of the year range.
+		// It guards against hand-edits to the generated files, and is checked by the tests.
+		func validateData() error {
+			return validateHolidays(holidays, holidaysStartYear, holidaysEndYear)
+		}
+		`)
+	if err := writeSource(generatedPath(), buf.Bytes()); err != nil {
+		return err
 	}
-	fmt.Fprintln(&buf, "}")
 
-	res, err := format.Source(buf.Bytes())
+	buf.Reset()
+	fmt.Fprint(
+		&buf,
+		`// Code generated by internal/gen/gen.go; DO NOT EDIT.
+
+		//go:build !holidays_csv && !holidays_min
+
+		package `+packageName+`
+
+		// the first year of pre-calculated holidays
+		const holidaysStartYear = `+strconv.Itoa(startYear)+`
+		`,
+	)
+	if err := writeSource(generatedFilePath("full"), buf.Bytes()); err != nil {
+		return err
+	}
+
+	buf.Reset()
+	fmt.Fprint(
+		&buf,
+		`// Code generated by internal/gen/gen.go; DO NOT EDIT.
+
+		//go:build !holidays_csv && holidays_min
+
+		package `+packageName+`
+
+		// the first year of pre-calculated holidays.
+		// The older holidays are calculated from the law.
+		const holidaysStartYear = `+strconv.Itoa(minStartYear)+`
+		`,
+	)
+	if len(historical) > 0 {
+		fmt.Fprintf(&buf, "\n// the years excluded by the holidays_min build\nvar %s []Holiday\n", strings.Join(historical, ", "))
+	}
+	if err := writeSource(generatedFilePath("min"), buf.Bytes()); err != nil {
+		return err
+	}
+	return removeStaleFiles(written)
+}
+
+// removeStaleFiles removes the generated files that are not written this time,
+// e.g. the files of the decades that are no longer in the data.
+func removeStaleFiles(written map[string]bool) error {
+	paths, err := filepath.Glob(generatedFilePath("*"))
 	if err != nil {
 		return err
 	}
-	return os.WriteFile(filepath.Join("../", "holidays-api", "holiday", "holidays_generated.go"), res, 0644)
+	for _, path := range paths {
+		if written[path] {
+			continue
+		}
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generatedFilePath returns the path of the generated file with the suffix, e.g. holidays_generated_1950s.go.
+func generatedFilePath(suffix string) string {
+	return filepath.Join(outDir, "holidays_generated_"+suffix+".go")
 }
 
-// 2021/1/1 -> 2021-01-01
-func formatDate(s string) string {
-	date := strings.Split(s, "/")
-	y, err := strconv.Atoi(date[0])
+// writeSource formats the Go source and writes it to path.
+// The file is not touched if the content is not changed.
+func writeSource(path string, src []byte) error {
+	res, err := format.Source(src)
 	if err != nil {
-		panic(err)
+		return err
+	}
+	if prev, err := os.ReadFile(path); err == nil && bytes.Equal(prev, res) {
+		return nil
 	}
-	m, err := strconv.Atoi(date[1])
+	return os.WriteFile(path, res, 0644)
+}
+
+var reYearDigest = regexp.MustCompile(`(?m)^//\s+(\d{4}): ([0-9a-f]+)$`)
+
+// yearDigests returns the digests of the holidays in each year.
+// A digest is a prefix of the SHA-256 hash of the dates, the names and the kinds of the holidays.
+func yearDigests(holidays []Holiday) map[int]string {
+	hashes := map[int]hash.Hash{}
+	for _, h := range holidays {
+		y := yearOf(h.Date)
+		if hashes[y] == nil {
+			hashes[y] = sha256.New()
+		}
+		fmt.Fprintf(hashes[y], "%s,%s,%s\n", h.Date, h.Name, h.Kind)
+	}
+	digests := make(map[int]string, len(hashes))
+	for y, h := range hashes {
+		digests[y] = hex.EncodeToString(h.Sum(nil)[:8])
+	}
+	return digests
+}
+
+// readDigests reads the digests of the holidays in each year from the previous generated files.
+func readDigests() (map[int]string, error) {
+	paths, err := filepath.Glob(generatedFilePath("*"))
 	if err != nil {
-		panic(err)
+		return nil, err
+	}
+	digests := map[int]string{}
+	for _, path := range paths {
+		prev, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range reYearDigest.FindAllSubmatch(prev, -1) {
+			y, _ := strconv.Atoi(string(m[1]))
+			digests[y] = string(m[2])
+		}
+	}
+	return digests, nil
+}
+
+// reportChangedYears logs the years whose digests are different from the previous ones.
+func reportChangedYears(prevDigests, digests map[int]string) {
+	var years []int
+	for y, digest := range digests {
+		if prevDigests[y] != digest {
+			years = append(years, y)
+		}
 	}
-	d, err := strconv.Atoi(date[2])
+	for y := range prevDigests {
+		if _, ok := digests[y]; !ok {
+			years = append(years, y)
+		}
+	}
+	sort.Ints(years)
+	for _, y := range years {
+		log.Printf("the holidays in %d are changed", y)
+	}
+}
+
+// yearOf returns the year of the date in the format of 2006-01-02.
+func yearOf(date string) int {
+	y, err := strconv.Atoi(strings.Split(date, "-")[0])
 	if err != nil {
 		panic(err)
 	}
-	return fmt.Sprintf("%04d-%02d-%02d", y, m, d)
+	return y
+}
+
+// decadeOf returns the first year of the decade, e.g. 2020 for 2024.
+func decadeOf(year int) int {
+	return year / 10 * 10
+}
+
+var (
+	reDataVersion     = regexp.MustCompile(`dataVersion\s*=\s*"([^"]*)"`)
+	reDataGeneratedAt = regexp.MustCompile(`dataGeneratedAt\s*=\s*"([^"]*)"`)
+)
+
+// dataVersion returns the version of the raw data and the time when it was generated.
+// The version is a prefix of the SHA-256 hash of the raw data.
+// If the data is not changed, the previous generated time is kept so that the generated file is not changed.
+func dataVersion(rawData []byte) (version, generatedAt string) {
+	sum := sha256.Sum256(rawData)
+	version = hex.EncodeToString(sum[:8])
+
+	prev, err := os.ReadFile(generatedPath())
+	if err == nil {
+		v := reDataVersion.FindSubmatch(prev)
+		t := reDataGeneratedAt.FindSubmatch(prev)
+		if v != nil && t != nil && string(v[1]) == version {
+			return version, string(t[1])
+		}
+	}
+	return version, time.Now().UTC().Format(time.RFC3339)
+}
+
+// kindConstant returns the name of the holiday.Kind constant, e.g. KindNational.
+func kindConstant(k holiday.Kind) string {
+	name := k.String()
+	return "Kind" + strings.ToUpper(name[:1]) + name[1:]
 }
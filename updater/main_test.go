@@ -0,0 +1,86 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// readTree returns the contents of the files in the directory, keyed by the names.
+func readTree(t *testing.T, dir string) map[string]string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree := map[string]string{}
+	for _, e := range entries {
+		data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tree[e.Name()] = string(data)
+	}
+	return tree
+}
+
+func TestFormatHolidays_Idempotent(t *testing.T) {
+	out, export := setOutputDirs(t)
+	rawData := []byte(readSyukujitsuCSV(t))
+
+	if err := formatHolidays(rawData); err != nil {
+		t.Fatal(err)
+	}
+	wantOut, wantExport := readTree(t, out), readTree(t, export)
+
+	// a file of the old chunks, which must be removed.
+	if err := os.WriteFile(filepath.Join(out, "holidays_generated_2010-2015.go"), []byte("package holiday\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := formatHolidays(rawData); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(wantOut, readTree(t, out)); diff != "" {
+		t.Errorf("generated files mismatch (-want/+got):\n%s", diff)
+	}
+	if diff := cmp.Diff(wantExport, readTree(t, export)); diff != "" {
+		t.Errorf("exported files mismatch (-want/+got):\n%s", diff)
+	}
+}
+
+func TestChunksOf(t *testing.T) {
+	// the names of the chunks do not depend on the year of the generation.
+	names := func(minStartYear int) []string {
+		var names []string
+		for _, c := range chunksOf(1955, 2027, minStartYear) {
+			names = append(names, c.name)
+		}
+		return names
+	}
+	want := []string{
+		"holidays1950s", "holidays1960s", "holidays1970s", "holidays1980s",
+		"holidays1990s", "holidays2000s", "holidays2010s", "holidays2020s",
+	}
+	for _, minStartYear := range []int{1955, 2010, 2020} {
+		if diff := cmp.Diff(want, names(minStartYear)); diff != "" {
+			t.Errorf("minStartYear = %d: names mismatch (-want/+got):\n%s", minStartYear, diff)
+		}
+	}
+
+	var historical []string
+	for _, c := range chunksOf(1955, 2027, 2010) {
+		if c.historical {
+			historical = append(historical, c.name)
+		}
+	}
+	wantHistorical := []string{
+		"holidays1950s", "holidays1960s", "holidays1970s", "holidays1980s",
+		"holidays1990s", "holidays2000s",
+	}
+	if diff := cmp.Diff(wantHistorical, historical); diff != "" {
+		t.Errorf("historical chunks mismatch (-want/+got):\n%s", diff)
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shogo82148/holidays-jp/holidays-api/holiday"
+)
+
+// validateHolidays compares the holidays with the ones calculated by the rule engine of the holiday package.
+// It returns an error that reports all mismatches, which means that the CSV is broken,
+// the law is amended, or the rule engine has a bug.
+func validateHolidays(holidays []Holiday) error {
+	if len(holidays) == 0 {
+		return fmt.Errorf("no holidays in %s", syukujitsuURL)
+	}
+
+	byYear := map[int][]Holiday{}
+	for _, h := range holidays {
+		y := yearOf(h.Date)
+		byYear[y] = append(byYear[y], h)
+	}
+
+	rules := holiday.CurrentRules()
+	var report []string
+	for year := yearOf(holidays[0].Date); year <= yearOf(holidays[len(holidays)-1].Date); year++ {
+		got := map[string]Holiday{}
+		for _, h := range byYear[year] {
+			got[h.Date] = h
+		}
+		want := map[string]holiday.Holiday{}
+		for _, w := range rules.HolidaysInYear(year) {
+			want[w.Date] = w
+			g, ok := got[w.Date]
+			if !ok {
+				report = append(report, fmt.Sprintf("- %s %s (%s): missing in the CSV", w.Date, w.Name, w.Kind))
+				continue
+			}
+			if g.Name != w.Name || kindName(g.Kind) != w.Kind.String() {
+				report = append(report, fmt.Sprintf("~ %s: the CSV has %s (%s), but the rules have %s (%s)", w.Date, g.Name, kindName(g.Kind), w.Name, w.Kind))
+			}
+		}
+		for _, g := range byYear[year] {
+			if _, ok := want[g.Date]; !ok {
+				report = append(report, fmt.Sprintf("+ %s %s (%s): not in the rules", g.Date, g.Name, kindName(g.Kind)))
+			}
+		}
+	}
+	if len(report) > 0 {
+		return fmt.Errorf("the holidays in the CSV disagree with the rules of the holiday package:\n%s", strings.Join(report, "\n"))
+	}
+	return nil
+}
+
+// validateCoverage checks that the holidays cover every year from holiday.EarliestOfficialYear,
+// so the historical holidays are not dropped silently when the CSV is truncated.
+func validateCoverage(holidays []Holiday) error {
+	if len(holidays) == 0 {
+		return fmt.Errorf("no holidays in %s", syukujitsuURL)
+	}
+
+	startYear := yearOf(holidays[0].Date)
+	if startYear > holiday.EarliestOfficialYear {
+		return fmt.Errorf("the holidays from %d to %d are missing in %s", holiday.EarliestOfficialYear, startYear-1, syukujitsuURL)
+	}
+	if startYear < holiday.EarliestOfficialYear {
+		return fmt.Errorf("%s has the holidays in %d; update holiday.EarliestOfficialYear", syukujitsuURL, startYear)
+	}
+
+	years := map[int]bool{}
+	for _, h := range holidays {
+		years[yearOf(h.Date)] = true
+	}
+	var missing []string
+	for year := startYear; year <= yearOf(holidays[len(holidays)-1].Date); year++ {
+		if !years[year] {
+			missing = append(missing, strconv.Itoa(year))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("no holidays in %s in %s", strings.Join(missing, ", "), syukujitsuURL)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/encoding/japanese"
+)
+
+// readSyukujitsuCSV returns ../syukujitsu.csv decoded into UTF-8.
+func readSyukujitsuCSV(t *testing.T) string {
+	t.Helper()
+	rawData, err := os.ReadFile(rawDataPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := japanese.ShiftJIS.NewDecoder().Bytes(rawData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+// setOutputDirs points the generated files and the exported files to temporary directories.
+func setOutputDirs(t *testing.T) (out, export string) {
+	t.Helper()
+	oldOutDir, oldExportDir := outDir, exportDir
+	t.Cleanup(func() {
+		outDir, exportDir = oldOutDir, oldExportDir
+	})
+	outDir, exportDir = t.TempDir(), t.TempDir()
+	return outDir, exportDir
+}
+
+func TestFormatHolidays_Mismatch(t *testing.T) {
+	csv := readSyukujitsuCSV(t)
+
+	tests := []struct {
+		name    string
+		old     string
+		new     string
+		wantErr string
+	}{
+		{
+			name:    "missing holiday",
+			old:     "2024/1/8,成人の日\r\n",
+			new:     "",
+			wantErr: "- 2024-01-08 成人の日 (national): missing in the CSV",
+		},
+		{
+			name:    "extra holiday",
+			old:     "2024/1/8,成人の日\r\n",
+			new:     "2024/1/8,成人の日\r\n2024/1/9,成人の日の翌日\r\n",
+			wantErr: "+ 2024-01-09 成人の日の翌日 (national): not in the rules",
+		},
+		{
+			name:    "wrong name",
+			old:     "2024/1/1,元日\r\n",
+			new:     "2024/1/1,元旦\r\n",
+			wantErr: "~ 2024-01-01: the CSV has 元旦 (national), but the rules have 元日 (national)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !strings.Contains(csv, tt.old) {
+				t.Fatalf("%q is not in syukujitsu.csv", tt.old)
+			}
+			out, export := setOutputDirs(t)
+
+			err := formatHolidays([]byte(strings.Replace(csv, tt.old, tt.new, 1)))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("want error %q, got %v", tt.wantErr, err)
+			}
+			for _, dir := range []string{out, export} {
+				entries, err := os.ReadDir(dir)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(entries) > 0 {
+					t.Errorf("want no files in %s, got %v", dir, entries)
+				}
+			}
+		})
+	}
+}
+
+func TestFormatHolidays(t *testing.T) {
+	out, export := setOutputDirs(t)
+	if err := formatHolidays([]byte(readSyukujitsuCSV(t))); err != nil {
+		t.Fatal(err)
+	}
+	for _, dir := range []string{out, export} {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) == 0 {
+			t.Errorf("want files in %s, got none", dir)
+		}
+	}
+}